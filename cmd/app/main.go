@@ -1,13 +1,19 @@
 package main
 
 import (
+	"context"
+
 	"github.com/nathakusuma/conference-backend/internal/infra/database"
 	"github.com/nathakusuma/conference-backend/internal/infra/env"
+	"github.com/nathakusuma/conference-backend/internal/infra/lifecycle"
 	"github.com/nathakusuma/conference-backend/internal/infra/redis"
 	"github.com/nathakusuma/conference-backend/internal/infra/server"
+	"github.com/nathakusuma/conference-backend/pkg/background"
 	"github.com/nathakusuma/conference-backend/pkg/log"
 )
 
+const defaultServerPort = "8080"
+
 func main() {
 	env.NewEnv()
 	log.NewLogger()
@@ -26,10 +32,26 @@ func main() {
 		env.GetEnv().RedisPass,
 		env.GetEnv().RedisDB,
 	)
-	defer postgresDB.Close()
-	defer redisClient.Close()
 
 	srv.MountMiddlewares()
 	srv.MountRoutes(postgresDB, redisClient)
-	srv.Start("8080")
+
+	// Stop taking requests first, then let the background jobs finish, then release the pools they use
+	manager := lifecycle.NewManager(env.GetEnv().ShutdownTimeout)
+	manager.OnShutdown("http server", srv.Shutdown)
+	manager.OnShutdown("background jobs", background.Wait)
+	manager.OnShutdown("postgres", func(_ context.Context) error {
+		return postgresDB.Close()
+	})
+	manager.OnShutdown("redis", func(_ context.Context) error {
+		return redisClient.Close()
+	})
+
+	port := env.GetEnv().ServerPort
+	if port == "" {
+		port = defaultServerPort
+	}
+	go srv.Start(port)
+
+	manager.Wait()
 }
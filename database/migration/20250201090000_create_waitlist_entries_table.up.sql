@@ -0,0 +1,10 @@
+CREATE TABLE waitlist_entries
+(
+    id            UUID PRIMARY KEY,
+    user_id       UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    conference_id UUID      NOT NULL REFERENCES conferences (id) ON DELETE CASCADE,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX waitlist_entries_user_id_conference_id_key ON waitlist_entries (user_id, conference_id);
+CREATE INDEX waitlist_entries_conference_id_idx ON waitlist_entries (conference_id);
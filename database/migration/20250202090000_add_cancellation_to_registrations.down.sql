@@ -0,0 +1,12 @@
+ALTER TABLE conferences
+    DROP COLUMN IF EXISTS cancellation_cutoff;
+
+DELETE FROM registrations WHERE cancelled_at IS NOT NULL;
+
+DROP INDEX IF EXISTS registrations_conference_id_idx;
+DROP INDEX IF EXISTS registrations_user_id_conference_id_active_key;
+
+ALTER TABLE registrations
+    DROP COLUMN IF EXISTS cancelled_by,
+    DROP COLUMN IF EXISTS cancelled_at,
+    ADD PRIMARY KEY (user_id, conference_id);
@@ -0,0 +1,12 @@
+ALTER TABLE registrations
+    ADD COLUMN cancelled_at TIMESTAMP,
+    ADD COLUMN cancelled_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    DROP CONSTRAINT registrations_pkey;
+
+-- A user may register again after cancelling, so uniqueness only applies to active registrations
+CREATE UNIQUE INDEX registrations_user_id_conference_id_active_key ON registrations (user_id, conference_id)
+    WHERE cancelled_at IS NULL;
+CREATE INDEX registrations_conference_id_idx ON registrations (conference_id);
+
+ALTER TABLE conferences
+    ADD COLUMN cancellation_cutoff TIMESTAMP;
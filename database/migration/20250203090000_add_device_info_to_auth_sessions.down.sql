@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS auth_sessions_user_id_idx;
+DROP INDEX IF EXISTS auth_sessions_token_key;
+
+-- Only the most recently used session of each user can be kept
+DELETE FROM auth_sessions a
+    USING auth_sessions b
+WHERE a.user_id = b.user_id
+  AND (a.last_used_at, a.id) < (b.last_used_at, b.id);
+
+ALTER TABLE auth_sessions
+    DROP CONSTRAINT auth_sessions_pkey,
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS ip_address,
+    DROP COLUMN IF EXISTS user_agent,
+    DROP COLUMN IF EXISTS id,
+    ADD PRIMARY KEY (token);
+
+CREATE UNIQUE INDEX auth_sessions_user_id_key ON auth_sessions (user_id);
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS auth_sessions_user_id_key;
+
+ALTER TABLE auth_sessions
+    ADD COLUMN id           UUID,
+    ADD COLUMN user_agent   VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN ip_address   VARCHAR(45)  NOT NULL DEFAULT '',
+    ADD COLUMN last_used_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE auth_sessions SET id = gen_random_uuid() WHERE id IS NULL;
+
+ALTER TABLE auth_sessions
+    ALTER COLUMN id SET NOT NULL,
+    DROP CONSTRAINT auth_sessions_pkey,
+    ADD PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX auth_sessions_token_key ON auth_sessions (token);
+CREATE INDEX auth_sessions_user_id_idx ON auth_sessions (user_id);
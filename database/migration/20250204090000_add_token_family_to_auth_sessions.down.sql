@@ -0,0 +1,7 @@
+DELETE FROM auth_sessions WHERE rotated_at IS NOT NULL;
+
+DROP INDEX IF EXISTS auth_sessions_family_id_idx;
+
+ALTER TABLE auth_sessions
+    DROP COLUMN IF EXISTS rotated_at,
+    DROP COLUMN IF EXISTS family_id;
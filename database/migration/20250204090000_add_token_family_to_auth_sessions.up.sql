@@ -0,0 +1,11 @@
+-- Every refresh creates a new row in the same family. Rotated rows are kept to detect token reuse.
+ALTER TABLE auth_sessions
+    ADD COLUMN family_id  UUID,
+    ADD COLUMN rotated_at TIMESTAMP;
+
+UPDATE auth_sessions SET family_id = id WHERE family_id IS NULL;
+
+ALTER TABLE auth_sessions
+    ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX auth_sessions_family_id_idx ON auth_sessions (family_id);
@@ -0,0 +1,20 @@
+CREATE TABLE email_outbox
+(
+    id              UUID PRIMARY KEY,
+    recipient       VARCHAR(320) NOT NULL,
+    subject         VARCHAR(255) NOT NULL,
+    template        VARCHAR(255) NOT NULL,
+    data            JSONB        NOT NULL DEFAULT '{}',
+    status          VARCHAR(50)  NOT NULL DEFAULT 'pending'
+        CHECK ( status IN ('pending', 'sent', 'failed') ),
+    attempts        INT          NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at         TIMESTAMP
+);
+
+-- Workers only ever look for pending emails that are due
+CREATE INDEX email_outbox_next_attempt_at_idx ON email_outbox (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX email_outbox_status_idx ON email_outbox (status);
@@ -0,0 +1,12 @@
+CREATE TABLE notifications
+(
+    id            UUID PRIMARY KEY,
+    user_id       UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type          VARCHAR(50)  NOT NULL,
+    title         VARCHAR(255) NOT NULL,
+    message       VARCHAR(1000) NOT NULL,
+    conference_id UUID         REFERENCES conferences (id) ON DELETE SET NULL,
+    created_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, id);
@@ -0,0 +1,17 @@
+-- One row per reminder sent, so a restarted scheduler never sends the same reminder twice
+CREATE TABLE reminder_deliveries
+(
+    conference_id UUID        NOT NULL REFERENCES conferences (id) ON DELETE CASCADE,
+    user_id       UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    reminder_type VARCHAR(20) NOT NULL,
+    sent_at       TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (conference_id, user_id, reminder_type)
+);
+
+CREATE TABLE reminder_opt_outs
+(
+    user_id       UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    reminder_type VARCHAR(20) NOT NULL,
+    created_at    TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, reminder_type)
+);
@@ -0,0 +1,14 @@
+CREATE TABLE conference_reviews
+(
+    id            UUID PRIMARY KEY,
+    conference_id UUID        NOT NULL REFERENCES conferences (id) ON DELETE CASCADE,
+    reviewer_id   UUID        NOT NULL REFERENCES users (id),
+    status        VARCHAR(50) NOT NULL
+        CHECK ( status IN ('pending', 'approved', 'rejected') ),
+    reason        VARCHAR(50)
+        CHECK ( reason IN ('off_topic', 'duplicate', 'insufficient_detail', 'schedule_conflict', 'other') ),
+    notes         VARCHAR(2000),
+    created_at    TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX conference_reviews_conference_id_idx ON conference_reviews (conference_id, id);
@@ -0,0 +1,13 @@
+CREATE TABLE conference_audit
+(
+    id              UUID PRIMARY KEY,
+    conference_id   UUID        NOT NULL REFERENCES conferences (id) ON DELETE CASCADE,
+    actor_id        UUID REFERENCES users (id) ON DELETE SET NULL,
+    action          VARCHAR(50) NOT NULL
+        CHECK ( action IN ('updated', 'status_changed', 'deleted') ),
+    previous_values JSONB       NOT NULL DEFAULT '{}',
+    new_values      JSONB       NOT NULL DEFAULT '{}',
+    created_at      TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX conference_audit_conference_id_idx ON conference_audit (conference_id, id);
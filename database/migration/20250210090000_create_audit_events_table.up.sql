@@ -0,0 +1,17 @@
+CREATE TABLE audit_events
+(
+    id          UUID PRIMARY KEY,
+    actor_id    UUID,
+    actor_role  VARCHAR(50),
+    ip_address  VARCHAR(45)  NOT NULL,
+    action      VARCHAR(100) NOT NULL,
+    target_type VARCHAR(50)  NOT NULL,
+    target_id   VARCHAR(320),
+    outcome     VARCHAR(20)  NOT NULL
+        CHECK ( outcome IN ('success', 'failure') ),
+    created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id, id);
+CREATE INDEX audit_events_target_idx ON audit_events (target_type, target_id, id);
+CREATE INDEX audit_events_action_idx ON audit_events (action, id);
@@ -0,0 +1,13 @@
+-- Before this status existed a cancelled conference was simply deleted
+UPDATE conferences SET status = 'approved', deleted_at = NOW() WHERE status = 'cancelled';
+DELETE FROM conference_reviews WHERE status = 'cancelled';
+
+ALTER TABLE conference_reviews
+    DROP CONSTRAINT conference_reviews_status_check,
+    ADD CONSTRAINT conference_reviews_status_check
+        CHECK ( status IN ('pending', 'approved', 'rejected') );
+
+ALTER TABLE conferences
+    DROP CONSTRAINT conferences_status_check,
+    ADD CONSTRAINT conferences_status_check
+        CHECK ( status IN ('pending', 'approved', 'rejected') );
@@ -0,0 +1,9 @@
+ALTER TABLE conferences
+    DROP CONSTRAINT conferences_status_check,
+    ADD CONSTRAINT conferences_status_check
+        CHECK ( status IN ('pending', 'approved', 'rejected', 'cancelled') );
+
+ALTER TABLE conference_reviews
+    DROP CONSTRAINT conference_reviews_status_check,
+    ADD CONSTRAINT conference_reviews_status_check
+        CHECK ( status IN ('pending', 'approved', 'rejected', 'cancelled') );
@@ -0,0 +1,4 @@
+ALTER TABLE registrations
+    DROP COLUMN IF EXISTS conflict_flagged_at;
+
+DROP TABLE IF EXISTS conference_reschedules;
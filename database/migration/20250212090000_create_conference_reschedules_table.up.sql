@@ -0,0 +1,25 @@
+CREATE TABLE conference_reschedules
+(
+    id                 UUID PRIMARY KEY,
+    conference_id      UUID        NOT NULL REFERENCES conferences (id) ON DELETE CASCADE,
+    requester_id       UUID        NOT NULL REFERENCES users (id),
+    starts_at          TIMESTAMP   NOT NULL,
+    ends_at            TIMESTAMP   NOT NULL,
+    previous_starts_at TIMESTAMP   NOT NULL,
+    previous_ends_at   TIMESTAMP   NOT NULL,
+    status             VARCHAR(50) NOT NULL DEFAULT 'pending'
+        CHECK ( status IN ('pending', 'approved', 'rejected') ),
+    reviewer_id        UUID REFERENCES users (id),
+    notes              VARCHAR(2000),
+    created_at         TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reviewed_at        TIMESTAMP
+);
+
+CREATE INDEX conference_reschedules_conference_id_idx ON conference_reschedules (conference_id, id);
+-- A conference waits for at most one reschedule at a time
+CREATE UNIQUE INDEX conference_reschedules_conference_id_pending_key ON conference_reschedules (conference_id)
+    WHERE status = 'pending';
+
+-- Set when a reschedule made the registration clash with another one of the same user, until they decide
+ALTER TABLE registrations
+    ADD COLUMN conflict_flagged_at TIMESTAMP;
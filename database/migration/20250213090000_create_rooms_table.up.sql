@@ -0,0 +1,27 @@
+CREATE TABLE rooms
+(
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(100) NOT NULL,
+    capacity   INT          NOT NULL CHECK ( capacity > 0 ),
+    track      VARCHAR(100),
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX rooms_name_key ON rooms (name) WHERE deleted_at IS NULL;
+CREATE INDEX rooms_track_idx ON rooms (track);
+
+-- Every conference so far shared one timeline, which is what a single room gives them
+INSERT INTO rooms (id, name, capacity)
+SELECT gen_random_uuid(), 'Main Hall', GREATEST(COALESCE(MAX(seats), 1), 1)
+FROM conferences;
+
+ALTER TABLE conferences
+    ADD COLUMN room_id UUID REFERENCES rooms (id);
+UPDATE conferences
+SET room_id = (SELECT id FROM rooms);
+ALTER TABLE conferences
+    ALTER COLUMN room_id SET NOT NULL;
+
+CREATE INDEX conferences_room_id_starts_at_idx ON conferences (room_id, starts_at);
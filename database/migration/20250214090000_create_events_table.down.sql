@@ -0,0 +1,5 @@
+ALTER TABLE conferences
+    DROP COLUMN IF EXISTS event_id;
+
+DROP TABLE IF EXISTS event_coordinators;
+DROP TABLE IF EXISTS events;
@@ -0,0 +1,56 @@
+CREATE TABLE events
+(
+    id            UUID PRIMARY KEY,
+    name          VARCHAR(100) NOT NULL,
+    description   VARCHAR(1000),
+    starts_at     TIMESTAMP    NOT NULL,
+    ends_at       TIMESTAMP    NOT NULL,
+    cfp_opens_at  TIMESTAMP    NOT NULL,
+    cfp_closes_at TIMESTAMP    NOT NULL,
+    created_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at    TIMESTAMP,
+    CHECK ( ends_at >= starts_at ),
+    CHECK ( cfp_closes_at >= cfp_opens_at )
+);
+
+CREATE INDEX events_starts_at_idx ON events (starts_at);
+CREATE INDEX events_deleted_at_idx ON events (deleted_at);
+
+CREATE TABLE event_coordinators
+(
+    event_id   UUID      NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id    UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX event_coordinators_user_id_idx ON event_coordinators (user_id);
+
+-- Existing conferences become one event spanning all of them, with its call for proposals still open
+-- until it ends
+INSERT INTO events (id, name, starts_at, ends_at, cfp_opens_at, cfp_closes_at)
+SELECT gen_random_uuid(),
+       'Conference',
+       COALESCE(MIN(starts_at), CURRENT_TIMESTAMP),
+       GREATEST(COALESCE(MAX(ends_at), CURRENT_TIMESTAMP), CURRENT_TIMESTAMP + INTERVAL '1 year'),
+       COALESCE(MIN(created_at), CURRENT_TIMESTAMP),
+       GREATEST(COALESCE(MAX(ends_at), CURRENT_TIMESTAMP), CURRENT_TIMESTAMP + INTERVAL '1 year')
+FROM conferences;
+
+-- Every coordinator used to manage every conference
+INSERT INTO event_coordinators (event_id, user_id)
+SELECT e.id, u.id
+FROM events e
+         CROSS JOIN users u
+WHERE u.role = 'event_coordinator'
+  AND u.deleted_at IS NULL;
+
+ALTER TABLE conferences
+    ADD COLUMN event_id UUID REFERENCES events (id);
+UPDATE conferences
+SET event_id = (SELECT id FROM events);
+ALTER TABLE conferences
+    ALTER COLUMN event_id SET NOT NULL;
+
+CREATE INDEX conferences_event_id_idx ON conferences (event_id);
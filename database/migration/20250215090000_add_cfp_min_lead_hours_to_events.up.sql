@@ -0,0 +1,3 @@
+-- Proposals must be submitted at least this many hours before the conference starts
+ALTER TABLE events
+    ADD COLUMN cfp_min_lead_hours INTEGER NOT NULL DEFAULT 0 CHECK ( cfp_min_lead_hours >= 0 );
@@ -0,0 +1,8 @@
+-- Overrides the role's quota of pending proposals for one user
+CREATE TABLE user_proposal_quotas
+(
+    user_id    UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    quota      INTEGER   NOT NULL CHECK ( quota >= 0 ),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
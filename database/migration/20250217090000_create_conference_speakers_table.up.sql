@@ -0,0 +1,23 @@
+-- A speaker is either a user of the system, whose name and bio come from their profile, or a guest
+-- described here
+CREATE TABLE conference_speakers
+(
+    conference_id UUID      NOT NULL REFERENCES conferences (id) ON DELETE CASCADE,
+    position      INT       NOT NULL CHECK ( position >= 0 ),
+    user_id       UUID REFERENCES users (id) ON DELETE CASCADE,
+    name          VARCHAR(100),
+    title         VARCHAR(100),
+    bio           VARCHAR(1000),
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (conference_id, position),
+    CHECK ( (user_id IS NULL) <> (name IS NULL) )
+);
+
+CREATE UNIQUE INDEX conference_speakers_conference_id_user_id_key ON conference_speakers (conference_id, user_id)
+    WHERE user_id IS NOT NULL;
+CREATE INDEX conference_speakers_user_id_idx ON conference_speakers (user_id);
+
+-- The speaker every conference had so far becomes its only guest speaker
+INSERT INTO conference_speakers (conference_id, position, name, title)
+SELECT id, 0, speaker_name, speaker_title
+FROM conferences;
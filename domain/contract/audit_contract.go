@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type IAuditRepository interface {
+	CreateEvent(ctx context.Context, event *entity.AuditEvent) error
+	GetEvents(ctx context.Context, query dto.GetAuditEventsQuery,
+		lazyReq dto.LazyLoadQuery) ([]entity.AuditEvent, dto.LazyLoadResponse, error)
+}
+
+type IAuditService interface {
+	Record(ctx context.Context, req dto.RecordAuditEventRequest) error
+	GetEvents(ctx context.Context, query dto.GetAuditEventsQuery,
+		lazyReq dto.LazyLoadQuery) ([]dto.AuditEventResponse, dto.LazyLoadResponse, error)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
+	"time"
 )
 
 type IAuthRepository interface {
@@ -14,21 +15,42 @@ type IAuthRepository interface {
 
 	CreateAuthSession(ctx context.Context, authSession *entity.AuthSession) error
 	GetAuthSessionByToken(ctx context.Context, token string) (*entity.AuthSession, error)
-	DeleteAuthSession(ctx context.Context, userID uuid.UUID) error
+	GetAuthSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]entity.AuthSession, error)
+	RotateAuthSession(ctx context.Context, oldSession, newSession *entity.AuthSession) error
+	DeleteAuthSession(ctx context.Context, familyID, userID uuid.UUID) error
+	DeleteAuthSessionsByUserID(ctx context.Context, userID uuid.UUID) error
+	// DeleteExpiredAuthSessions deletes the sessions past their expiry, rotated or not, and returns how many.
+	DeleteExpiredAuthSessions(ctx context.Context) (int64, error)
+	// RevokeAccessTokens marks the sessions as revoked for ttl, so their access tokens are rejected until they expire.
+	RevokeAccessTokens(ctx context.Context, familyIDs []uuid.UUID, ttl time.Duration) error
+	IsAccessTokenRevoked(ctx context.Context, familyID uuid.UUID) (bool, error)
 
 	SetOTPResetPassword(ctx context.Context, email, otp string) error
 	GetOTPResetPassword(ctx context.Context, email string) (string, error)
 	DeleteOTPResetPassword(ctx context.Context, email string) error
+
+	IncrementAttempts(ctx context.Context, scope, subject string, window time.Duration) (int64, time.Duration, error)
+	GetAttempts(ctx context.Context, scope, subject string) (int64, time.Duration, error)
+	DeleteAttempts(ctx context.Context, scope, subject string) error
 }
 
 type IAuthService interface {
 	RequestOTPRegisterUser(ctx context.Context, email string) error
-	CheckOTPRegisterUser(ctx context.Context, email, otp string) error
+	CheckOTPRegisterUser(ctx context.Context, req dto.CheckOTPRegisterUserRequest) error
 	RegisterUser(ctx context.Context, req dto.RegisterUserRequest) (dto.LoginResponse, error)
 	Login(ctx context.Context, req dto.LoginUserRequest) (dto.LoginResponse, error)
 
 	RefreshToken(ctx context.Context, refreshToken string) (dto.LoginResponse, error)
 	Logout(ctx context.Context) error
+	LogoutAll(ctx context.Context) error
+
+	GetSessions(ctx context.Context) ([]dto.AuthSessionResponse, error)
+	RevokeSession(ctx context.Context, sessionID uuid.UUID) error
+	// DeleteExpiredSessions removes the expired sessions. Rotated sessions are kept until they expire
+	// to detect the reuse of their refresh token.
+	DeleteExpiredSessions(ctx context.Context) error
+	// IsSessionRevoked reports whether the session of an access token was revoked before the token expired.
+	IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error)
 
 	RequestOTPResetPassword(ctx context.Context, email string) error
 	ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) (dto.LoginResponse, error)
@@ -2,12 +2,21 @@ package contract
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
-	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+var (
+	// ErrConferenceNotApproved is returned by IConferenceRepository.ReviewReschedule when the conference was
+	// cancelled or deleted before the move could be saved.
+	ErrConferenceNotApproved = errors.New("conference is no longer approved")
+	// ErrConferenceStarted is returned by IConferenceRepository.ReviewReschedule when the conference started
+	// before the move could be saved.
+	ErrConferenceStarted = errors.New("conference has already started")
 )
 
 type IConferenceService interface {
@@ -17,17 +26,61 @@ type IConferenceService interface {
 		query *dto.GetConferenceQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)
 	UpdateConference(ctx context.Context, id uuid.UUID, req dto.UpdateConferenceRequest) error
 	DeleteConference(ctx context.Context, id uuid.UUID) error
+	CancelConference(ctx context.Context, id uuid.UUID, req dto.CancelConferenceRequest) error
+
+	UpdateConferenceStatus(ctx context.Context, id uuid.UUID, req dto.UpdateConferenceStatusRequest) error
+	GetConferenceReviews(ctx context.Context, id uuid.UUID) ([]dto.ConferenceReviewResponse, error)
+	GetConferenceHistory(ctx context.Context, id uuid.UUID) ([]dto.ConferenceAuditResponse, error)
 
-	UpdateConferenceStatus(ctx context.Context, id uuid.UUID, status enum.ConferenceStatus) error
+	RequestReschedule(ctx context.Context, id uuid.UUID, req dto.RequestRescheduleRequest) (uuid.UUID, error)
+	GetReschedules(ctx context.Context, id uuid.UUID) ([]dto.ConferenceRescheduleResponse, error)
+	ReviewReschedule(ctx context.Context, id, rescheduleID uuid.UUID, req dto.ReviewRescheduleRequest) error
+
+	UpdateConferenceSpeakers(ctx context.Context, id uuid.UUID, req dto.UpdateConferenceSpeakersRequest) error
+	GetSpeakerConferences(ctx context.Context, userID uuid.UUID, includePast bool,
+		lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)
 }
 
 type IConferenceRepository interface {
 	CreateConference(ctx context.Context, conference *entity.Conference) error
 	GetConferenceByID(ctx context.Context, id uuid.UUID) (*entity.Conference, error)
+	// IsUserRegisteredToConference reports whether the user holds an active registration to the conference.
+	IsUserRegisteredToConference(ctx context.Context, conferenceID, userID uuid.UUID) (bool, error)
 	GetConferences(ctx context.Context, query *dto.GetConferenceQuery) ([]entity.Conference, dto.LazyLoadResponse, error)
-	UpdateConference(ctx context.Context, conference *entity.Conference) error
-	DeleteConference(ctx context.Context, id uuid.UUID) error
+	UpdateConference(ctx context.Context, conference *entity.Conference, audit *entity.ConferenceAudit) error
+	DeleteConference(ctx context.Context, id uuid.UUID, audit *entity.ConferenceAudit) error
 
-	GetConferencesConflictingWithTime(ctx context.Context, startsAt, endsAt time.Time,
+	// GetActiveProposalsByHost returns the pending proposals of the host that haven't ended, oldest first.
+	GetActiveProposalsByHost(ctx context.Context, hostID uuid.UUID) ([]entity.Conference, error)
+
+	// GetConferencesConflictingWithTime returns the approved conferences in the room that overlap the time window.
+	GetConferencesConflictingWithTime(ctx context.Context, roomID uuid.UUID, startsAt, endsAt time.Time,
 		excludeID uuid.UUID) ([]entity.Conference, error)
+
+	// ReviewConference saves the conference with its new status together with the review that set it.
+	ReviewConference(ctx context.Context, conference *entity.Conference, review *entity.ConferenceReview,
+		audit *entity.ConferenceAudit) error
+	GetLatestReview(ctx context.Context, conferenceID uuid.UUID) (*entity.ConferenceReview, error)
+	GetReviewsByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.ConferenceReview, error)
+
+	GetAuditsByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.ConferenceAudit, error)
+
+	CreateReschedule(ctx context.Context, reschedule *entity.ConferenceReschedule) error
+	GetRescheduleByID(ctx context.Context, id uuid.UUID) (*entity.ConferenceReschedule, error)
+	GetReschedulesByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.ConferenceReschedule, error)
+	// ReviewReschedule saves the reviewed request. When it was approved, the new time window of the conference
+	// and its audit are saved with it, after checking again under lock that the conference is still approved
+	// and not started and that nothing in its room took the window. Conflicting conferences are returned
+	// without saving anything.
+	ReviewReschedule(ctx context.Context, reschedule *entity.ConferenceReschedule, conference *entity.Conference,
+		audit *entity.ConferenceAudit) ([]entity.Conference, error)
+
+	// GetSpeakersByConferences returns the speakers of the conferences, in order within each conference.
+	GetSpeakersByConferences(ctx context.Context, conferenceIDs []uuid.UUID) ([]entity.ConferenceSpeaker, error)
+	// ReplaceSpeakers swaps the speakers of the conference for its Speakers, updating speaker_name and
+	// speaker_title along with them, and saves the audit in the same transaction.
+	ReplaceSpeakers(ctx context.Context, conference *entity.Conference, audit *entity.ConferenceAudit) error
+	// GetConferencesBySpeaker returns the approved conferences the user speaks at, soonest first.
+	GetConferencesBySpeaker(ctx context.Context, userID uuid.UUID, includePast bool,
+		lazyReq dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error)
 }
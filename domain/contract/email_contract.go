@@ -0,0 +1,35 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+type IEmailRepository interface {
+	CreateEmail(ctx context.Context, email *entity.Email) error
+	// ClaimDueEmails takes up to limit pending emails that are due and pushes their next attempt back by lease,
+	// so other workers skip them while they are being sent.
+	ClaimDueEmails(ctx context.Context, limit int, lease time.Duration) ([]entity.Email, error)
+	MarkEmailSent(ctx context.Context, id uuid.UUID) error
+	MarkEmailRetry(ctx context.Context, id uuid.UUID, retryIn time.Duration, lastError string) error
+	MarkEmailFailed(ctx context.Context, id uuid.UUID, lastError string) error
+	GetEmails(ctx context.Context, status enum.EmailStatus,
+		lazyReq dto.LazyLoadQuery) ([]entity.Email, dto.LazyLoadResponse, error)
+	CountEmailsByStatus(ctx context.Context) (map[enum.EmailStatus]int, error)
+	RetryFailedEmail(ctx context.Context, id uuid.UUID) error
+}
+
+type IEmailService interface {
+	// Enqueue stores the email in the outbox, the workers send it.
+	Enqueue(ctx context.Context, recipient, subject, templateName string, data map[string]any) error
+	ProcessDueEmails(ctx context.Context) (int, error)
+	GetEmails(ctx context.Context, status enum.EmailStatus,
+		lazyReq dto.LazyLoadQuery) ([]dto.EmailResponse, dto.LazyLoadResponse, error)
+	GetQueueStats(ctx context.Context) (dto.EmailQueueStats, error)
+	RetryEmail(ctx context.Context, id uuid.UUID) error
+}
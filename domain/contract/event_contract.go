@@ -0,0 +1,49 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type IEventRepository interface {
+	CreateEvent(ctx context.Context, event *entity.Event) error
+	GetEventByID(ctx context.Context, id uuid.UUID) (*entity.Event, error)
+	GetEvents(ctx context.Context, query dto.GetEventsQuery) ([]entity.Event, error)
+	UpdateEvent(ctx context.Context, event *entity.Event) error
+	DeleteEvent(ctx context.Context, id uuid.UUID) error
+	// UpdateCFP writes only the call for proposals of event.
+	UpdateCFP(ctx context.Context, event *entity.Event) error
+
+	HasConferences(ctx context.Context, eventID uuid.UUID) (bool, error)
+	// HasConferencesOutside tells whether a pending or approved conference of the event doesn't fit
+	// between startsAt and endsAt.
+	HasConferencesOutside(ctx context.Context, eventID uuid.UUID, startsAt, endsAt time.Time) (bool, error)
+
+	AddCoordinator(ctx context.Context, eventID, userID uuid.UUID) error
+	RemoveCoordinator(ctx context.Context, eventID, userID uuid.UUID) error
+	GetCoordinators(ctx context.Context, eventID uuid.UUID) ([]entity.User, error)
+	IsCoordinator(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+}
+
+type IEventService interface {
+	CreateEvent(ctx context.Context, req dto.CreateEventRequest) (uuid.UUID, error)
+	GetEventByID(ctx context.Context, id uuid.UUID) (*dto.EventResponse, error)
+	GetEvents(ctx context.Context, query dto.GetEventsQuery) ([]dto.EventResponse, error)
+	UpdateEvent(ctx context.Context, id uuid.UUID, req dto.UpdateEventRequest) error
+	DeleteEvent(ctx context.Context, id uuid.UUID) error
+
+	GetCFP(ctx context.Context, eventID uuid.UUID) (*dto.CFPResponse, error)
+	UpdateCFP(ctx context.Context, eventID uuid.UUID, req dto.UpdateCFPRequest) error
+	OpenCFP(ctx context.Context, eventID uuid.UUID, req dto.OpenCFPRequest) error
+	CloseCFP(ctx context.Context, eventID uuid.UUID) error
+	ExtendCFP(ctx context.Context, eventID uuid.UUID, req dto.ExtendCFPRequest) error
+
+	AddCoordinator(ctx context.Context, eventID, userID uuid.UUID) error
+	RemoveCoordinator(ctx context.Context, eventID, userID uuid.UUID) error
+	GetCoordinators(ctx context.Context, eventID uuid.UUID) ([]dto.UserResponse, error)
+	IsEventCoordinator(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+}
@@ -11,6 +11,7 @@ type IFeedbackRepository interface {
 	CreateFeedback(ctx context.Context, feedback *entity.Feedback) error
 	GetFeedbacksByConferenceID(ctx context.Context, conferenceID uuid.UUID,
 		lazyReq dto.LazyLoadQuery) ([]entity.Feedback, dto.LazyLoadResponse, error)
+	GetFeedbackByID(ctx context.Context, id uuid.UUID) (*entity.Feedback, error)
 	DeleteFeedback(ctx context.Context, id uuid.UUID) error
 	IsFeedbackGiven(ctx context.Context, userID, conferenceID uuid.UUID) (bool, error)
 }
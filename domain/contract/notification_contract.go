@@ -0,0 +1,23 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/event"
+)
+
+type INotificationRepository interface {
+	CreateNotifications(ctx context.Context, notifications []entity.Notification) error
+	GetNotificationsByUser(ctx context.Context, userID uuid.UUID,
+		lazyReq dto.LazyLoadQuery) ([]entity.Notification, dto.LazyLoadResponse, error)
+}
+
+type INotificationService interface {
+	// HandleEvent records a notification for everyone the event concerns and emails them.
+	HandleEvent(ctx context.Context, e event.Event) error
+	GetNotificationsByUser(ctx context.Context, userID uuid.UUID,
+		lazyReq dto.LazyLoadQuery) ([]dto.NotificationResponse, dto.LazyLoadResponse, error)
+}
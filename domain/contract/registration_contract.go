@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/event"
 )
 
 type IRegistrationRepository interface {
@@ -14,13 +15,30 @@ type IRegistrationRepository interface {
 
 	GetRegisteredUsersByConference(ctx context.Context, conferenceID uuid.UUID,
 		lazyReq dto.LazyLoadQuery) ([]entity.User, dto.LazyLoadResponse, error)
-	GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID, includePast bool,
+	GetAttendeesByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.User, error)
+	GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID, includePast bool, eventID *uuid.UUID,
 		lazyReq dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error)
 
 	IsUserRegisteredToConference(ctx context.Context, conferenceID, userID uuid.UUID) (bool, error)
 	GetConflictingRegistrations(ctx context.Context, userID uuid.UUID, startsAt,
 		endsAt time.Time) ([]entity.Conference, error)
 	CountRegistrationsByConference(ctx context.Context, conferenceID uuid.UUID) (int, error)
+
+	CancelRegistration(ctx context.Context, conferenceID, userID, cancelledBy uuid.UUID) error
+
+	GetRegistration(ctx context.Context, conferenceID, userID uuid.UUID) (*entity.Registration, error)
+	// FlagScheduleConflicts flags the active registrations of the given users and clears the flag of
+	// every other registration of the conference.
+	FlagScheduleConflicts(ctx context.Context, conferenceID uuid.UUID, userIDs []uuid.UUID) error
+	ClearScheduleConflict(ctx context.Context, conferenceID, userID uuid.UUID) error
+
+	CreateWaitlistEntry(ctx context.Context, entry *entity.WaitlistEntry) error
+	GetWaitlistByConference(ctx context.Context, conferenceID uuid.UUID,
+		lazyReq dto.LazyLoadQuery) ([]entity.WaitlistEntry, dto.LazyLoadResponse, error)
+	GetFirstWaitlistEntry(ctx context.Context, conferenceID uuid.UUID) (*entity.WaitlistEntry, error)
+	IsUserInWaitlist(ctx context.Context, conferenceID, userID uuid.UUID) (bool, error)
+	DeleteWaitlistEntry(ctx context.Context, conferenceID, userID uuid.UUID) error
+	PromoteWaitlistEntry(ctx context.Context, entry *entity.WaitlistEntry) error
 }
 
 type IRegistrationService interface {
@@ -28,8 +46,23 @@ type IRegistrationService interface {
 
 	GetRegisteredUsersByConference(ctx context.Context, conferenceID uuid.UUID,
 		lazyReq dto.LazyLoadQuery) ([]dto.UserResponse, dto.LazyLoadResponse, error)
+	// GetAttendeesByConference returns every active registrant with their email, for notifying them.
+	GetAttendeesByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.User, error)
 	GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID,
-		includePast bool, lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)
+		includePast bool, eventID *uuid.UUID, lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)
 
 	IsUserRegisteredToConference(ctx context.Context, conferenceID, userID uuid.UUID) (bool, error)
+
+	CancelRegistration(ctx context.Context, conferenceID, userID uuid.UUID) error
+	// KeepRegistration tells a registrant flagged by a reschedule keeps their seat despite the conflict.
+	KeepRegistration(ctx context.Context, conferenceID, userID uuid.UUID) error
+
+	// HandleEvent flags the registrants a rescheduled conference now clashes for.
+	HandleEvent(ctx context.Context, e event.Event) error
+
+	JoinWaitlist(ctx context.Context, conferenceID, userID uuid.UUID) error
+	LeaveWaitlist(ctx context.Context, conferenceID, userID uuid.UUID) error
+	GetWaitlistByConference(ctx context.Context, conferenceID uuid.UUID,
+		lazyReq dto.LazyLoadQuery) ([]dto.WaitlistEntryResponse, dto.LazyLoadResponse, error)
+	PromoteFromWaitlist(ctx context.Context, conferenceID uuid.UUID) error
 }
@@ -0,0 +1,27 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+)
+
+type IReminderRepository interface {
+	// ClaimReminder records the reminder as sent, unless it already was or the user opted out of it.
+	// It returns whether the caller should send it.
+	ClaimReminder(ctx context.Context, conferenceID, userID uuid.UUID, reminderType string) (bool, error)
+	ReleaseReminder(ctx context.Context, conferenceID, userID uuid.UUID, reminderType string) error
+
+	GetOptOutsByUser(ctx context.Context, userID uuid.UUID) ([]string, error)
+	CreateOptOut(ctx context.Context, userID uuid.UUID, reminderType string) error
+	DeleteOptOut(ctx context.Context, userID uuid.UUID, reminderType string) error
+}
+
+type IReminderService interface {
+	// SendDueReminders queues a reminder for every registrant of the conferences entering a reminder window,
+	// and returns how many were queued.
+	SendDueReminders(ctx context.Context) (int, error)
+	GetPreferences(ctx context.Context, userID uuid.UUID) ([]dto.ReminderPreference, error)
+	UpdatePreference(ctx context.Context, userID uuid.UUID, reminderType string, enabled bool) error
+}
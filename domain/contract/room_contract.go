@@ -0,0 +1,30 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type IRoomRepository interface {
+	CreateRoom(ctx context.Context, room *entity.Room) error
+	GetRoomByID(ctx context.Context, id uuid.UUID) (*entity.Room, error)
+	GetRooms(ctx context.Context, query dto.GetRoomsQuery) ([]entity.Room, error)
+	UpdateRoom(ctx context.Context, room *entity.Room) error
+	DeleteRoom(ctx context.Context, id uuid.UUID) error
+
+	// GetLargestUpcomingSeats returns the most seats of a pending or approved conference in the room that
+	// hasn't ended yet, or zero when there is none.
+	GetLargestUpcomingSeats(ctx context.Context, roomID uuid.UUID) (int, error)
+	HasUpcomingConferences(ctx context.Context, roomID uuid.UUID) (bool, error)
+}
+
+type IRoomService interface {
+	CreateRoom(ctx context.Context, req dto.CreateRoomRequest) (uuid.UUID, error)
+	GetRoomByID(ctx context.Context, id uuid.UUID) (*dto.RoomResponse, error)
+	GetRooms(ctx context.Context, query dto.GetRoomsQuery) ([]dto.RoomResponse, error)
+	UpdateRoom(ctx context.Context, id uuid.UUID, req dto.UpdateRoomRequest) error
+	DeleteRoom(ctx context.Context, id uuid.UUID) error
+}
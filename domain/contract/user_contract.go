@@ -13,6 +13,12 @@ type IUserRepository interface {
 	GetUserByField(ctx context.Context, field, value string) (*entity.User, error)
 	UpdateUser(ctx context.Context, user *entity.User) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+
+	// GetProposalQuota returns the admin override of the user's proposal quota, or sql.ErrNoRows when
+	// the role's quota applies.
+	GetProposalQuota(ctx context.Context, userID uuid.UUID) (int, error)
+	SetProposalQuota(ctx context.Context, userID uuid.UUID, quota int) error
+	DeleteProposalQuota(ctx context.Context, userID uuid.UUID) error
 }
 
 type IUserService interface {
@@ -22,4 +28,8 @@ type IUserService interface {
 	UpdatePassword(ctx context.Context, email, newPassword string) error
 	UpdateUser(ctx context.Context, id uuid.UUID, req dto.UpdateUserRequest) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+
+	GetProposalQuota(ctx context.Context, userID uuid.UUID) (*dto.ProposalQuotaResponse, error)
+	SetProposalQuota(ctx context.Context, userID uuid.UUID, req dto.SetProposalQuotaRequest) error
+	ResetProposalQuota(ctx context.Context, userID uuid.UUID) error
 }
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+type RecordAuditEventRequest struct {
+	ActorID    *uuid.UUID
+	ActorRole  *enum.UserRole
+	IPAddress  string
+	Action     enum.AuditAction
+	TargetType string
+	TargetID   *string
+	Outcome    enum.AuditOutcome
+}
+
+type GetAuditEventsQuery struct {
+	ActorID    *uuid.UUID        `query:"actor_id"`
+	Action     enum.AuditAction  `query:"action" validate:"omitempty,max=100"`
+	TargetType string            `query:"target_type" validate:"omitempty,max=50"`
+	TargetID   string            `query:"target_id" validate:"omitempty,max=320"`
+	Outcome    enum.AuditOutcome `query:"outcome" validate:"omitempty,oneof=success failure"`
+}
+
+type AuditEventResponse struct {
+	ID         uuid.UUID         `json:"id"`
+	ActorID    *uuid.UUID        `json:"actor_id"`
+	ActorRole  *enum.UserRole    `json:"actor_role"`
+	IPAddress  string            `json:"ip_address"`
+	Action     enum.AuditAction  `json:"action"`
+	TargetType string            `json:"target_type"`
+	TargetID   *string           `json:"target_id"`
+	Outcome    enum.AuditOutcome `json:"outcome"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+func (a *AuditEventResponse) PopulateFromEntity(event *entity.AuditEvent) *AuditEventResponse {
+	a.ID = event.ID
+	a.ActorID = event.ActorID
+	a.ActorRole = event.ActorRole
+	a.IPAddress = event.IPAddress
+	a.Action = event.Action
+	a.TargetType = event.TargetType
+	a.TargetID = event.TargetID
+	a.Outcome = event.Outcome
+	a.CreatedAt = event.CreatedAt
+	return a
+}
@@ -1,5 +1,12 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
 type RequestOTPRegisterUserRequest struct {
 	Email string `json:"email" validate:"required,email,max=320"`
 }
@@ -7,6 +14,8 @@ type RequestOTPRegisterUserRequest struct {
 type CheckOTPRegisterUserRequest struct {
 	Email string `json:"email" validate:"required,email"`
 	OTP   string `json:"otp" validate:"required"`
+
+	IPAddress string `json:"-"`
 }
 
 type RegisterUserRequest struct {
@@ -14,11 +23,17 @@ type RegisterUserRequest struct {
 	OTP      string `json:"otp" validate:"required"`
 	Name     string `json:"name" validate:"required,min=3,max=100,ascii"`
 	Password string `json:"password" validate:"required,min=8,max=72,ascii"`
+
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
 }
 
 type LoginUserRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,ascii"`
+
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
 }
 
 type LoginResponse struct {
@@ -39,4 +54,27 @@ type ResetPasswordRequest struct {
 	Email       string `json:"email" validate:"required,email"`
 	OTP         string `json:"otp" validate:"required"`
 	NewPassword string `json:"new_password" validate:"required,min=8,max=72,ascii"`
+
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
+
+type AuthSessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	IsCurrent  bool      `json:"is_current"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+func (s *AuthSessionResponse) PopulateFromEntity(session *entity.AuthSession) *AuthSessionResponse {
+	s.ID = session.FamilyID
+	s.UserAgent = session.UserAgent
+	s.IPAddress = session.IPAddress
+	s.LastUsedAt = session.LastUsedAt
+	s.CreatedAt = session.CreatedAt
+	s.ExpiresAt = session.ExpiresAt
+	return s
 }
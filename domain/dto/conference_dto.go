@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,21 +10,29 @@ import (
 )
 
 type ConferenceResponse struct {
-	ID             uuid.UUID             `json:"id"`
-	Title          string                `json:"title,omitempty"`
-	Description    string                `json:"description,omitempty"`
-	SpeakerName    string                `json:"speaker_name,omitempty"`
-	SpeakerTitle   string                `json:"speaker_title,omitempty"`
-	TargetAudience string                `json:"target_audience,omitempty"`
-	Prerequisites  *string               `json:"prerequisites,omitempty"`
-	Seats          int                   `json:"seats,omitempty"`
-	StartsAt       *time.Time            `json:"starts_at,omitempty"`
-	EndsAt         *time.Time            `json:"ends_at,omitempty"`
-	Host           *UserResponse         `json:"host,omitempty"`
-	Status         enum.ConferenceStatus `json:"status,omitempty"`
-	CreatedAt      *time.Time            `json:"created_at,omitempty"`
-	UpdatedAt      *time.Time            `json:"updated_at,omitempty"`
-	SeatsTaken     *int                  `json:"seats_taken,omitempty"`
+	ID                 uuid.UUID                   `json:"id"`
+	Title              string                      `json:"title,omitempty"`
+	Description        string                      `json:"description,omitempty"`
+	SpeakerName        string                      `json:"speaker_name,omitempty"`
+	SpeakerTitle       string                      `json:"speaker_title,omitempty"`
+	TargetAudience     string                      `json:"target_audience,omitempty"`
+	Prerequisites      *string                     `json:"prerequisites,omitempty"`
+	Seats              int                         `json:"seats,omitempty"`
+	StartsAt           *time.Time                  `json:"starts_at,omitempty"`
+	EndsAt             *time.Time                  `json:"ends_at,omitempty"`
+	CancellationCutoff *time.Time                  `json:"cancellation_cutoff,omitempty"`
+	Host               *UserResponse               `json:"host,omitempty"`
+	Room               *RoomResponse               `json:"room,omitempty"`
+	Event              *EventResponse              `json:"event,omitempty"`
+	Speakers           []ConferenceSpeakerResponse `json:"speakers,omitempty"`
+	Status             enum.ConferenceStatus       `json:"status,omitempty"`
+	CreatedAt          *time.Time                  `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time                  `json:"updated_at,omitempty"`
+	SeatsTaken         *int                        `json:"seats_taken,omitempty"`
+	WaitlistLength     *int                        `json:"waitlist_length,omitempty"`
+	LatestReview       *ConferenceReviewResponse   `json:"latest_review,omitempty"`
+	CancellationReason *string                     `json:"cancellation_reason,omitempty"`
+	ScheduleConflict   bool                        `json:"schedule_conflict,omitempty"`
 }
 
 func (c *ConferenceResponse) PopulateFromEntity(conference *entity.Conference) *ConferenceResponse {
@@ -37,25 +46,88 @@ func (c *ConferenceResponse) PopulateFromEntity(conference *entity.Conference) *
 	c.Seats = conference.Seats
 	c.StartsAt = &conference.StartsAt
 	c.EndsAt = &conference.EndsAt
+	c.CancellationCutoff = conference.CancellationCutoff
 	c.Status = conference.Status
 	c.CreatedAt = &conference.CreatedAt
 	c.UpdatedAt = &conference.UpdatedAt
 
+	c.ScheduleConflict = conference.ScheduleConflict
+
 	c.SeatsTaken = &conference.RegistrationCount
 	c.Host = new(UserResponse).PopulateMinimalFromEntity(&conference.Host)
+	if conference.RoomID != uuid.Nil {
+		c.Room = new(RoomResponse).PopulateMinimalFromEntity(&conference.Room)
+	}
+	if conference.EventID != uuid.Nil {
+		c.Event = new(EventResponse).PopulateMinimalFromEntity(&conference.Event)
+	}
+	if conference.Speakers != nil {
+		c.Speakers = make([]ConferenceSpeakerResponse, len(conference.Speakers))
+		for i, speaker := range conference.Speakers {
+			c.Speakers[i].PopulateFromEntity(&speaker)
+		}
+	}
+
+	// speaker_name and speaker_title are kept for older clients and always show the first speaker
+	if len(c.Speakers) > 0 {
+		c.SpeakerName = c.Speakers[0].Name
+		c.SpeakerTitle = ""
+		if c.Speakers[0].Title != nil {
+			c.SpeakerTitle = *c.Speakers[0].Title
+		}
+	}
 	return c
 }
 
+type ConferenceSpeakerResponse struct {
+	User  *UserResponse `json:"user,omitempty"`
+	Name  string        `json:"name"`
+	Title *string       `json:"title,omitempty"`
+	Bio   *string       `json:"bio,omitempty"`
+	Order int           `json:"order"`
+}
+
+func (s *ConferenceSpeakerResponse) PopulateFromEntity(
+	speaker *entity.ConferenceSpeaker) *ConferenceSpeakerResponse {
+
+	s.Title = speaker.Title
+	s.Bio = speaker.Bio
+	s.Order = speaker.Position
+
+	// A linked speaker is shown as their profile says, unless a bio was written for this conference
+	if speaker.User != nil {
+		s.User = &UserResponse{ID: speaker.User.ID, Name: speaker.User.Name}
+		s.Name = speaker.User.Name
+		if s.Bio == nil {
+			s.Bio = speaker.User.Bio
+		}
+	} else if speaker.Name != nil {
+		s.Name = *speaker.Name
+	}
+	return s
+}
+
+type ConferenceSpeakerRequest struct {
+	UserID *uuid.UUID
+	Name   *string
+	Title  *string
+	Bio    *string
+}
+
 type CreateConferenceProposalRequest struct {
-	Title          string
-	Description    string
-	SpeakerName    string
-	SpeakerTitle   string
-	TargetAudience string
-	Prerequisites  *string
-	Seats          int
-	StartsAt       time.Time
-	EndsAt         time.Time
+	Title              string
+	Description        string
+	SpeakerName        string
+	SpeakerTitle       string
+	TargetAudience     string
+	Prerequisites      *string
+	Seats              int
+	StartsAt           time.Time
+	EndsAt             time.Time
+	CancellationCutoff *time.Time
+	RoomID             uuid.UUID
+	EventID            uuid.UUID
+	Speakers           []ConferenceSpeakerRequest
 }
 
 type GetConferenceQuery struct {
@@ -70,17 +142,24 @@ type GetConferenceQuery struct {
 	OrderBy      string
 	Order        string
 	Title        *string
+	RoomID       *uuid.UUID
+	Track        *string
+	EventID      *uuid.UUID
+	// HostOrRegistrantID limits the results to conferences the user hosts or is registered to
+	HostOrRegistrantID *uuid.UUID
 }
 
 type UpdateConferenceRequest struct {
-	Title          *string
-	Description    *string
-	SpeakerName    *string
-	SpeakerTitle   *string
-	TargetAudience *string
-	Prerequisites  *string
-	StartsAt       *time.Time
-	EndsAt         *time.Time
+	Title              *string
+	Description        *string
+	TargetAudience     *string
+	Prerequisites      *string
+	StartsAt           *time.Time
+	EndsAt             *time.Time
+	CancellationCutoff *time.Time
+	// ClearCancellationCutoff removes the cutoff, letting registrants cancel until the conference starts
+	ClearCancellationCutoff bool
+	RoomID                  *uuid.UUID
 }
 
 func (p *UpdateConferenceRequest) GenerateUpdateEntity(original *entity.Conference) *entity.Conference {
@@ -90,12 +169,6 @@ func (p *UpdateConferenceRequest) GenerateUpdateEntity(original *entity.Conferen
 	if p.Description != nil {
 		original.Description = *p.Description
 	}
-	if p.SpeakerName != nil {
-		original.SpeakerName = *p.SpeakerName
-	}
-	if p.SpeakerTitle != nil {
-		original.SpeakerTitle = *p.SpeakerTitle
-	}
 	if p.TargetAudience != nil {
 		original.TargetAudience = *p.TargetAudience
 	}
@@ -108,6 +181,109 @@ func (p *UpdateConferenceRequest) GenerateUpdateEntity(original *entity.Conferen
 	if p.EndsAt != nil {
 		original.EndsAt = *p.EndsAt
 	}
+	if p.ClearCancellationCutoff {
+		original.CancellationCutoff = nil
+	} else if p.CancellationCutoff != nil {
+		original.CancellationCutoff = p.CancellationCutoff
+	}
+	if p.RoomID != nil {
+		original.RoomID = *p.RoomID
+	}
 
 	return original
 }
+
+type UpdateConferenceStatusRequest struct {
+	Status enum.ConferenceStatus
+	Reason *enum.RejectionReason
+	Notes  *string
+}
+
+type UpdateConferenceSpeakersRequest struct {
+	Speakers []ConferenceSpeakerRequest
+}
+
+type CancelConferenceRequest struct {
+	Reason string
+}
+
+type RequestRescheduleRequest struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+type ReviewRescheduleRequest struct {
+	Status enum.RescheduleStatus
+	Notes  *string
+}
+
+type ConferenceRescheduleResponse struct {
+	ID               uuid.UUID             `json:"id"`
+	Status           enum.RescheduleStatus `json:"status,omitempty"`
+	StartsAt         *time.Time            `json:"starts_at,omitempty"`
+	EndsAt           *time.Time            `json:"ends_at,omitempty"`
+	PreviousStartsAt *time.Time            `json:"previous_starts_at,omitempty"`
+	PreviousEndsAt   *time.Time            `json:"previous_ends_at,omitempty"`
+	Notes            *string               `json:"notes,omitempty"`
+	CreatedAt        *time.Time            `json:"created_at,omitempty"`
+	ReviewedAt       *time.Time            `json:"reviewed_at,omitempty"`
+}
+
+func (r *ConferenceRescheduleResponse) PopulateFromEntity(
+	reschedule *entity.ConferenceReschedule) *ConferenceRescheduleResponse {
+
+	r.ID = reschedule.ID
+	r.Status = reschedule.Status
+	r.StartsAt = &reschedule.StartsAt
+	r.EndsAt = &reschedule.EndsAt
+	r.PreviousStartsAt = &reschedule.PreviousStartsAt
+	r.PreviousEndsAt = &reschedule.PreviousEndsAt
+	r.Notes = reschedule.Notes
+	r.CreatedAt = &reschedule.CreatedAt
+	r.ReviewedAt = reschedule.ReviewedAt
+	return r
+}
+
+type ConferenceReviewResponse struct {
+	ID        uuid.UUID             `json:"id"`
+	Status    enum.ConferenceStatus `json:"status"`
+	Reason    *enum.RejectionReason `json:"reason"`
+	Notes     *string               `json:"notes"`
+	Reviewer  *UserResponse         `json:"reviewer,omitempty"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+func (r *ConferenceReviewResponse) PopulateFromEntity(review *entity.ConferenceReview) *ConferenceReviewResponse {
+	r.ID = review.ID
+	r.Status = review.Status
+	r.Reason = review.Reason
+	r.Notes = review.Notes
+	r.CreatedAt = review.CreatedAt
+
+	if review.Reviewer != nil {
+		r.Reviewer = new(UserResponse).PopulateMinimalFromEntity(review.Reviewer)
+	}
+	return r
+}
+
+type ConferenceAuditResponse struct {
+	ID             uuid.UUID                  `json:"id"`
+	Action         enum.ConferenceAuditAction `json:"action"`
+	Actor          *UserResponse              `json:"actor"`
+	PreviousValues json.RawMessage            `json:"previous_values"`
+	NewValues      json.RawMessage            `json:"new_values"`
+	CreatedAt      time.Time                  `json:"created_at"`
+}
+
+func (r *ConferenceAuditResponse) PopulateFromEntity(audit *entity.ConferenceAudit) *ConferenceAuditResponse {
+	r.ID = audit.ID
+	r.Action = audit.Action
+	r.PreviousValues = json.RawMessage(audit.PreviousValues)
+	r.NewValues = json.RawMessage(audit.NewValues)
+	r.CreatedAt = audit.CreatedAt
+
+	if audit.Actor != nil {
+		r.Actor = new(UserResponse).PopulateMinimalFromEntity(audit.Actor)
+	}
+	return r
+}
@@ -9,45 +9,98 @@ import (
 )
 
 type ConferenceJoinUserRow struct {
-	ID             uuid.UUID             `db:"id"`
-	Title          string                `db:"title"`
-	Description    string                `db:"description"`
-	SpeakerName    string                `db:"speaker_name"`
-	SpeakerTitle   string                `db:"speaker_title"`
-	TargetAudience string                `db:"target_audience"`
-	Prerequisites  *string               `db:"prerequisites"`
-	Seats          int                   `db:"seats"`
-	StartsAt       time.Time             `db:"starts_at"`
-	EndsAt         time.Time             `db:"ends_at"`
-	HostID         uuid.UUID             `db:"host_id"`
-	Status         enum.ConferenceStatus `db:"status"`
-	CreatedAt      time.Time             `db:"created_at"`
-	UpdatedAt      time.Time             `db:"updated_at"`
-
-	HostName          string `db:"host_name"`
-	RegistrationCount int    `db:"registration_count"`
+	ID                 uuid.UUID             `db:"id"`
+	Title              string                `db:"title"`
+	Description        string                `db:"description"`
+	SpeakerName        string                `db:"speaker_name"`
+	SpeakerTitle       string                `db:"speaker_title"`
+	TargetAudience     string                `db:"target_audience"`
+	Prerequisites      *string               `db:"prerequisites"`
+	Seats              int                   `db:"seats"`
+	StartsAt           time.Time             `db:"starts_at"`
+	EndsAt             time.Time             `db:"ends_at"`
+	CancellationCutoff *time.Time            `db:"cancellation_cutoff"`
+	HostID             uuid.UUID             `db:"host_id"`
+	RoomID             uuid.UUID             `db:"room_id"`
+	EventID            uuid.UUID             `db:"event_id"`
+	Status             enum.ConferenceStatus `db:"status"`
+	CreatedAt          time.Time             `db:"created_at"`
+	UpdatedAt          time.Time             `db:"updated_at"`
+
+	HostName          string  `db:"host_name"`
+	RoomName          string  `db:"room_name"`
+	RoomTrack         *string `db:"room_track"`
+	EventName         string  `db:"event_name"`
+	RegistrationCount int     `db:"registration_count"`
+	WaitlistCount     int     `db:"waitlist_count"`
 }
 
 func (r *ConferenceJoinUserRow) ToEntity() entity.Conference {
 	return entity.Conference{
-		ID:             r.ID,
-		Title:          r.Title,
-		Description:    r.Description,
-		SpeakerName:    r.SpeakerName,
-		SpeakerTitle:   r.SpeakerTitle,
-		TargetAudience: r.TargetAudience,
-		Prerequisites:  r.Prerequisites,
-		Seats:          r.Seats,
-		StartsAt:       r.StartsAt,
-		EndsAt:         r.EndsAt,
-		HostID:         r.HostID,
-		Status:         r.Status,
-		CreatedAt:      r.CreatedAt,
-		UpdatedAt:      r.UpdatedAt,
+		ID:                 r.ID,
+		Title:              r.Title,
+		Description:        r.Description,
+		SpeakerName:        r.SpeakerName,
+		SpeakerTitle:       r.SpeakerTitle,
+		TargetAudience:     r.TargetAudience,
+		Prerequisites:      r.Prerequisites,
+		Seats:              r.Seats,
+		StartsAt:           r.StartsAt,
+		EndsAt:             r.EndsAt,
+		CancellationCutoff: r.CancellationCutoff,
+		HostID:             r.HostID,
+		RoomID:             r.RoomID,
+		EventID:            r.EventID,
+		Status:             r.Status,
+		CreatedAt:          r.CreatedAt,
+		UpdatedAt:          r.UpdatedAt,
 		Host: entity.User{
 			ID:   r.HostID,
 			Name: r.HostName,
 		},
+		Room: entity.Room{
+			ID:    r.RoomID,
+			Name:  r.RoomName,
+			Track: r.RoomTrack,
+		},
+		Event: entity.Event{
+			ID:   r.EventID,
+			Name: r.EventName,
+		},
 		RegistrationCount: r.RegistrationCount,
+		WaitlistCount:     r.WaitlistCount,
+	}
+}
+
+type ConferenceSpeakerJoinUserRow struct {
+	ConferenceID uuid.UUID  `db:"conference_id"`
+	Position     int        `db:"position"`
+	UserID       *uuid.UUID `db:"user_id"`
+	Name         *string    `db:"name"`
+	Title        *string    `db:"title"`
+	Bio          *string    `db:"bio"`
+
+	UserName *string `db:"user_name"`
+	UserBio  *string `db:"user_bio"`
+}
+
+func (r *ConferenceSpeakerJoinUserRow) ToEntity() entity.ConferenceSpeaker {
+	speaker := entity.ConferenceSpeaker{
+		ConferenceID: r.ConferenceID,
+		Position:     r.Position,
+		UserID:       r.UserID,
+		Name:         r.Name,
+		Title:        r.Title,
+		Bio:          r.Bio,
 	}
+
+	if r.UserID != nil && r.UserName != nil {
+		speaker.User = &entity.User{
+			ID:   *r.UserID,
+			Name: *r.UserName,
+			Bio:  r.UserBio,
+		}
+	}
+
+	return speaker
 }
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+// EmailResponse leaves out the template data, since it can hold secrets such as OTPs.
+type EmailResponse struct {
+	ID            uuid.UUID        `json:"id"`
+	Recipient     string           `json:"recipient"`
+	Subject       string           `json:"subject"`
+	Template      string           `json:"template"`
+	Status        enum.EmailStatus `json:"status"`
+	Attempts      int              `json:"attempts"`
+	LastError     *string          `json:"last_error"`
+	NextAttemptAt time.Time        `json:"next_attempt_at"`
+	CreatedAt     time.Time        `json:"created_at"`
+	SentAt        *time.Time       `json:"sent_at"`
+}
+
+func (e *EmailResponse) PopulateFromEntity(email *entity.Email) *EmailResponse {
+	e.ID = email.ID
+	e.Recipient = email.Recipient
+	e.Subject = email.Subject
+	e.Template = email.Template
+	e.Status = email.Status
+	e.Attempts = email.Attempts
+	e.LastError = email.LastError
+	e.NextAttemptAt = email.NextAttemptAt
+	e.CreatedAt = email.CreatedAt
+	e.SentAt = email.SentAt
+	return e
+}
+
+type EmailQueueStats struct {
+	Pending int `json:"pending"`
+	Sent    int `json:"sent"`
+	Failed  int `json:"failed"`
+}
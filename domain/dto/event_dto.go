@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+type EventResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	Name            string     `json:"name,omitempty"`
+	Description     *string    `json:"description,omitempty"`
+	StartsAt        *time.Time `json:"starts_at,omitempty"`
+	EndsAt          *time.Time `json:"ends_at,omitempty"`
+	CFPOpensAt      *time.Time `json:"cfp_opens_at,omitempty"`
+	CFPClosesAt     *time.Time `json:"cfp_closes_at,omitempty"`
+	CFPMinLeadHours *int       `json:"cfp_min_lead_hours,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+func (e *EventResponse) PopulateFromEntity(event *entity.Event) *EventResponse {
+	e.ID = event.ID
+	e.Name = event.Name
+	e.Description = event.Description
+	e.StartsAt = &event.StartsAt
+	e.EndsAt = &event.EndsAt
+	e.CFPOpensAt = &event.CFPOpensAt
+	e.CFPClosesAt = &event.CFPClosesAt
+	e.CFPMinLeadHours = &event.CFPMinLeadHours
+	e.CreatedAt = &event.CreatedAt
+	e.UpdatedAt = &event.UpdatedAt
+	return e
+}
+
+func (e *EventResponse) PopulateMinimalFromEntity(event *entity.Event) *EventResponse {
+	e.ID = event.ID
+	e.Name = event.Name
+	return e
+}
+
+type CreateEventRequest struct {
+	Name            string    `json:"name" validate:"required,min=3,max=100"`
+	Description     *string   `json:"description" validate:"omitempty,max=1000"`
+	StartsAt        time.Time `json:"starts_at" validate:"required"`
+	EndsAt          time.Time `json:"ends_at" validate:"required"`
+	CFPOpensAt      time.Time `json:"cfp_opens_at" validate:"required"`
+	CFPClosesAt     time.Time `json:"cfp_closes_at" validate:"required"`
+	CFPMinLeadHours int       `json:"cfp_min_lead_hours" validate:"min=0,max=8760"`
+}
+
+type UpdateEventRequest struct {
+	Name            *string    `json:"name" validate:"omitempty,min=3,max=100"`
+	Description     *string    `json:"description" validate:"omitempty,max=1000"`
+	StartsAt        *time.Time `json:"starts_at"`
+	EndsAt          *time.Time `json:"ends_at"`
+	CFPOpensAt      *time.Time `json:"cfp_opens_at"`
+	CFPClosesAt     *time.Time `json:"cfp_closes_at"`
+	CFPMinLeadHours *int       `json:"cfp_min_lead_hours" validate:"omitempty,min=0,max=8760"`
+}
+
+type GetEventsQuery struct {
+	IncludePast bool `query:"include_past"`
+}
+
+type CFPResponse struct {
+	EventID          uuid.UUID      `json:"event_id"`
+	Status           enum.CFPStatus `json:"status"`
+	OpensAt          time.Time      `json:"opens_at"`
+	ClosesAt         time.Time      `json:"closes_at"`
+	MinLeadHours     int            `json:"min_lead_hours"`
+	EarliestStartsAt *time.Time     `json:"earliest_starts_at,omitempty"`
+}
+
+type UpdateCFPRequest struct {
+	OpensAt      *time.Time `json:"opens_at"`
+	ClosesAt     *time.Time `json:"closes_at"`
+	MinLeadHours *int       `json:"min_lead_hours" validate:"omitempty,min=0,max=8760"`
+}
+
+type OpenCFPRequest struct {
+	ClosesAt *time.Time `json:"closes_at"`
+}
+
+type ExtendCFPRequest struct {
+	ClosesAt time.Time `json:"closes_at" validate:"required"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type NotificationResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	Type         string     `json:"type"`
+	Title        string     `json:"title"`
+	Message      string     `json:"message"`
+	ConferenceID *uuid.UUID `json:"conference_id"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+func (n *NotificationResponse) PopulateFromEntity(notification *entity.Notification) *NotificationResponse {
+	n.ID = notification.ID
+	n.Type = notification.Type
+	n.Title = notification.Title
+	n.Message = notification.Message
+	n.ConferenceID = notification.ConferenceID
+	n.CreatedAt = notification.CreatedAt
+	return n
+}
@@ -0,0 +1,10 @@
+package dto
+
+type ReminderPreference struct {
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+type UpdateReminderPreferenceRequest struct {
+	Enabled *bool `json:"enabled" validate:"required"`
+}
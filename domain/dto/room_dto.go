@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type RoomResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name,omitempty"`
+	Capacity  int        `json:"capacity,omitempty"`
+	Track     *string    `json:"track,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func (r *RoomResponse) PopulateFromEntity(room *entity.Room) *RoomResponse {
+	r.ID = room.ID
+	r.Name = room.Name
+	r.Capacity = room.Capacity
+	r.Track = room.Track
+	r.CreatedAt = &room.CreatedAt
+	r.UpdatedAt = &room.UpdatedAt
+	return r
+}
+
+func (r *RoomResponse) PopulateMinimalFromEntity(room *entity.Room) *RoomResponse {
+	r.ID = room.ID
+	r.Name = room.Name
+	r.Track = room.Track
+	return r
+}
+
+type CreateRoomRequest struct {
+	Name     string  `json:"name" validate:"required,min=1,max=100"`
+	Capacity int     `json:"capacity" validate:"required,min=1"`
+	Track    *string `json:"track" validate:"omitempty,min=1,max=100"`
+}
+
+type UpdateRoomRequest struct {
+	Name     *string `json:"name" validate:"omitempty,min=1,max=100"`
+	Capacity *int    `json:"capacity" validate:"omitempty,min=1"`
+	Track    *string `json:"track" validate:"omitempty,min=1,max=100"`
+}
+
+type GetRoomsQuery struct {
+	Track *string `query:"track" validate:"omitempty,max=100"`
+}
@@ -47,3 +47,13 @@ type UpdateUserRequest struct {
 	Name *string `json:"name" validate:"omitempty,min=3,max=100,ascii"`
 	Bio  *string `json:"bio" validate:"omitempty,max=500"`
 }
+
+type ProposalQuotaResponse struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Quota      int       `json:"quota"`
+	Overridden bool      `json:"overridden"`
+}
+
+type SetProposalQuotaRequest struct {
+	Quota *int `json:"quota" validate:"required,min=0,max=100"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type WaitlistEntryResponse struct {
+	ID        uuid.UUID     `json:"id"`
+	CreatedAt *time.Time    `json:"created_at,omitempty"`
+	User      *UserResponse `json:"user,omitempty"`
+}
+
+func (w *WaitlistEntryResponse) PopulateFromEntity(entry *entity.WaitlistEntry) *WaitlistEntryResponse {
+	w.ID = entry.ID
+	w.CreatedAt = &entry.CreatedAt
+	w.User = &UserResponse{
+		ID: entry.UserID,
+	}
+	if entry.User != nil {
+		w.User.Name = entry.User.Name
+	}
+	return w
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+// AuditEvent is one privileged or security relevant action. The actor is empty for anonymous requests
+// such as logins, the target then identifies the account the request was about.
+type AuditEvent struct {
+	ID         uuid.UUID         `json:"id" db:"id"`
+	ActorID    *uuid.UUID        `json:"actor_id" db:"actor_id"`
+	ActorRole  *enum.UserRole    `json:"actor_role" db:"actor_role"`
+	IPAddress  string            `json:"ip_address" db:"ip_address"`
+	Action     enum.AuditAction  `json:"action" db:"action"`
+	TargetType string            `json:"target_type" db:"target_type"`
+	TargetID   *string           `json:"target_id" db:"target_id"`
+	Outcome    enum.AuditOutcome `json:"outcome" db:"outcome"`
+	CreatedAt  time.Time         `json:"created_at" db:"created_at"`
+}
@@ -6,8 +6,14 @@ import (
 )
 
 type AuthSession struct {
-	Token     string    `json:"token"`
-	UserID    uuid.UUID `json:"user_id" db:"user_id"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
+	ID         uuid.UUID  `json:"id" db:"id"`
+	FamilyID   uuid.UUID  `json:"family_id" db:"family_id"`
+	Token      string     `json:"token"`
+	UserID     uuid.UUID  `json:"user_id" db:"user_id"`
+	UserAgent  string     `json:"user_agent" db:"user_agent"`
+	IPAddress  string     `json:"ip_address" db:"ip_address"`
+	LastUsedAt time.Time  `json:"last_used_at" db:"last_used_at"`
+	RotatedAt  *time.Time `json:"rotated_at" db:"rotated_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at"`
 }
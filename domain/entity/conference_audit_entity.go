@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+// ConferenceAudit is one change made to a conference. PreviousValues and NewValues only hold the fields
+// that changed, keyed by their JSON name.
+type ConferenceAudit struct {
+	ID             uuid.UUID                  `json:"id" db:"id"`
+	ConferenceID   uuid.UUID                  `json:"conference_id" db:"conference_id"`
+	ActorID        *uuid.UUID                 `json:"actor_id" db:"actor_id"`
+	Action         enum.ConferenceAuditAction `json:"action" db:"action"`
+	PreviousValues types.JSONText             `json:"previous_values" db:"previous_values"`
+	NewValues      types.JSONText             `json:"new_values" db:"new_values"`
+	CreatedAt      time.Time                  `json:"created_at" db:"created_at"`
+
+	Actor *User `json:"-" db:"-"`
+}
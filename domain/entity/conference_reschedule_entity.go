@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+// ConferenceReschedule is a host's request to move an approved conference, waiting for a coordinator.
+type ConferenceReschedule struct {
+	ID               uuid.UUID             `json:"id" db:"id"`
+	ConferenceID     uuid.UUID             `json:"conference_id" db:"conference_id"`
+	RequesterID      uuid.UUID             `json:"requester_id" db:"requester_id"`
+	StartsAt         time.Time             `json:"starts_at" db:"starts_at"`
+	EndsAt           time.Time             `json:"ends_at" db:"ends_at"`
+	PreviousStartsAt time.Time             `json:"previous_starts_at" db:"previous_starts_at"`
+	PreviousEndsAt   time.Time             `json:"previous_ends_at" db:"previous_ends_at"`
+	Status           enum.RescheduleStatus `json:"status" db:"status"`
+	ReviewerID       *uuid.UUID            `json:"reviewer_id" db:"reviewer_id"`
+	Notes            *string               `json:"notes" db:"notes"`
+	CreatedAt        time.Time             `json:"created_at" db:"created_at"`
+	ReviewedAt       *time.Time            `json:"reviewed_at" db:"reviewed_at"`
+}
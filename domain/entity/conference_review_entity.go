@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+type ConferenceReview struct {
+	ID           uuid.UUID             `json:"id" db:"id"`
+	ConferenceID uuid.UUID             `json:"conference_id" db:"conference_id"`
+	ReviewerID   uuid.UUID             `json:"reviewer_id" db:"reviewer_id"`
+	Status       enum.ConferenceStatus `json:"status" db:"status"`
+	Reason       *enum.RejectionReason `json:"reason" db:"reason"`
+	Notes        *string               `json:"notes" db:"notes"`
+	CreatedAt    time.Time             `json:"created_at" db:"created_at"`
+
+	Reviewer *User `json:"-" db:"-"`
+}
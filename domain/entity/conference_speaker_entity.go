@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+)
+
+// ConferenceSpeaker is one speaker of a conference. Speakers with a UserID are linked to an account
+// and take their name from it, the others are guests described by Name, Title and Bio.
+type ConferenceSpeaker struct {
+	ConferenceID uuid.UUID  `json:"conference_id" db:"conference_id"`
+	Position     int        `json:"position" db:"position"`
+	UserID       *uuid.UUID `json:"user_id" db:"user_id"`
+	Name         *string    `json:"name" db:"name"`
+	Title        *string    `json:"title" db:"title"`
+	Bio          *string    `json:"bio" db:"bio"`
+
+	User *User `json:"-" db:"-"`
+}
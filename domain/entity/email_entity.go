@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+type Email struct {
+	ID            uuid.UUID        `json:"id" db:"id"`
+	Recipient     string           `json:"recipient" db:"recipient"`
+	Subject       string           `json:"subject" db:"subject"`
+	Template      string           `json:"template" db:"template"`
+	Data          types.JSONText   `json:"-" db:"data"`
+	Status        enum.EmailStatus `json:"status" db:"status"`
+	Attempts      int              `json:"attempts" db:"attempts"`
+	LastError     *string          `json:"last_error" db:"last_error"`
+	NextAttemptAt time.Time        `json:"next_attempt_at" db:"next_attempt_at"`
+	CreatedAt     time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at" db:"updated_at"`
+	SentAt        *time.Time       `json:"sent_at" db:"sent_at"`
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Event struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	Name            string     `json:"name" db:"name"`
+	Description     *string    `json:"description" db:"description"`
+	StartsAt        time.Time  `json:"starts_at" db:"starts_at"`
+	EndsAt          time.Time  `json:"ends_at" db:"ends_at"`
+	CFPOpensAt      time.Time  `json:"cfp_opens_at" db:"cfp_opens_at"`
+	CFPClosesAt     time.Time  `json:"cfp_closes_at" db:"cfp_closes_at"`
+	CFPMinLeadHours int        `json:"cfp_min_lead_hours" db:"cfp_min_lead_hours"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt       *time.Time `json:"deleted_at" db:"deleted_at"`
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Notification struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	UserID       uuid.UUID  `json:"user_id" db:"user_id"`
+	Type         string     `json:"type" db:"type"`
+	Title        string     `json:"title" db:"title"`
+	Message      string     `json:"message" db:"message"`
+	ConferenceID *uuid.UUID `json:"conference_id" db:"conference_id"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+}
@@ -7,9 +7,12 @@ import (
 )
 
 type Registration struct {
-	UserID       uuid.UUID `json:"user_id" db:"user_id"`
-	ConferenceID uuid.UUID `json:"conference_id" db:"conference_id"`
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UserID            uuid.UUID  `json:"user_id" db:"user_id"`
+	ConferenceID      uuid.UUID  `json:"conference_id" db:"conference_id"`
+	CreatedAt         time.Time  `json:"created_at" db:"created_at"`
+	CancelledAt       *time.Time `json:"cancelled_at" db:"cancelled_at"`
+	CancelledBy       *uuid.UUID `json:"cancelled_by" db:"cancelled_by"`
+	ConflictFlaggedAt *time.Time `json:"conflict_flagged_at" db:"conflict_flagged_at"`
 
 	User       *User       `json:"-" db:"-"`
 	Conference *Conference `json:"-" db:"-"`
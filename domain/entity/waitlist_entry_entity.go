@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WaitlistEntry struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	UserID       uuid.UUID `json:"user_id" db:"user_id"`
+	ConferenceID uuid.UUID `json:"conference_id" db:"conference_id"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+
+	User *User `json:"-" db:"-"`
+}
@@ -0,0 +1,48 @@
+package enum
+
+type AuditAction string
+
+const (
+	AuditActionUserCreated             AuditAction = "user.created"
+	AuditActionUserDeleted             AuditAction = "user.deleted"
+	AuditActionProposalQuotaSet        AuditAction = "user.proposal_quota_set"
+	AuditActionProposalQuotaReset      AuditAction = "user.proposal_quota_reset"
+	AuditActionFeedbackDeleted         AuditAction = "feedback.deleted"
+	AuditActionConferenceDeleted       AuditAction = "conference.deleted"
+	AuditActionConferenceStatusUpdated AuditAction = "conference.status_updated"
+	AuditActionConferenceCancelled     AuditAction = "conference.cancelled"
+	AuditActionRescheduleReviewed      AuditAction = "conference.reschedule_reviewed"
+	AuditActionRoomCreated             AuditAction = "room.created"
+	AuditActionRoomUpdated             AuditAction = "room.updated"
+	AuditActionRoomDeleted             AuditAction = "room.deleted"
+	AuditActionEventCreated            AuditAction = "event.created"
+	AuditActionEventUpdated            AuditAction = "event.updated"
+	AuditActionEventDeleted            AuditAction = "event.deleted"
+	AuditActionCoordinatorAdded        AuditAction = "event.coordinator_added"
+	AuditActionCoordinatorRemoved      AuditAction = "event.coordinator_removed"
+	AuditActionCFPUpdated              AuditAction = "event.cfp_updated"
+	AuditActionCFPOpened               AuditAction = "event.cfp_opened"
+	AuditActionCFPClosed               AuditAction = "event.cfp_closed"
+	AuditActionCFPExtended             AuditAction = "event.cfp_extended"
+	AuditActionEmailRetried            AuditAction = "email.retried"
+	AuditActionRegister                AuditAction = "auth.register"
+	AuditActionLogin                   AuditAction = "auth.login"
+	AuditActionPasswordReset           AuditAction = "auth.password_reset"
+	AuditActionSessionRevoked          AuditAction = "auth.session_revoked"
+	AuditActionAllSessionsRevoked      AuditAction = "auth.all_sessions_revoked"
+)
+
+func (a AuditAction) String() string {
+	return string(a)
+}
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+func (o AuditOutcome) String() string {
+	return string(o)
+}
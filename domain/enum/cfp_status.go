@@ -0,0 +1,13 @@
+package enum
+
+type CFPStatus string
+
+const (
+	CFPUpcoming CFPStatus = "upcoming"
+	CFPOpen     CFPStatus = "open"
+	CFPClosed   CFPStatus = "closed"
+)
+
+func (s CFPStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,13 @@
+package enum
+
+type ConferenceAuditAction string
+
+const (
+	AuditConferenceUpdated       ConferenceAuditAction = "updated"
+	AuditConferenceStatusChanged ConferenceAuditAction = "status_changed"
+	AuditConferenceDeleted       ConferenceAuditAction = "deleted"
+)
+
+func (a ConferenceAuditAction) String() string {
+	return string(a)
+}
@@ -3,9 +3,10 @@ package enum
 type ConferenceStatus string
 
 const (
-	ConferencePending  ConferenceStatus = "pending"
-	ConferenceApproved ConferenceStatus = "approved"
-	ConferenceRejected ConferenceStatus = "rejected"
+	ConferencePending   ConferenceStatus = "pending"
+	ConferenceApproved  ConferenceStatus = "approved"
+	ConferenceRejected  ConferenceStatus = "rejected"
+	ConferenceCancelled ConferenceStatus = "cancelled"
 )
 
 func (s ConferenceStatus) String() string {
@@ -0,0 +1,13 @@
+package enum
+
+type EmailStatus string
+
+const (
+	EmailPending EmailStatus = "pending"
+	EmailSent    EmailStatus = "sent"
+	EmailFailed  EmailStatus = "failed"
+)
+
+func (s EmailStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,31 @@
+package enum
+
+type RejectionReason string
+
+const (
+	RejectionOffTopic           RejectionReason = "off_topic"
+	RejectionDuplicate          RejectionReason = "duplicate"
+	RejectionInsufficientDetail RejectionReason = "insufficient_detail"
+	RejectionScheduleConflict   RejectionReason = "schedule_conflict"
+	RejectionOther              RejectionReason = "other"
+)
+
+var rejectionReasonDescriptions = map[RejectionReason]string{
+	RejectionOffTopic:           "The topic doesn't fit the conference",
+	RejectionDuplicate:          "A similar session is already scheduled",
+	RejectionInsufficientDetail: "The proposal lacks detail",
+	RejectionScheduleConflict:   "The schedule is already full at that time",
+	RejectionOther:              "Other",
+}
+
+func (r RejectionReason) String() string {
+	return string(r)
+}
+
+// Description is the reason in words, as shown to the host.
+func (r RejectionReason) Description() string {
+	if description, ok := rejectionReasonDescriptions[r]; ok {
+		return description
+	}
+	return string(r)
+}
@@ -0,0 +1,13 @@
+package enum
+
+type RescheduleStatus string
+
+const (
+	ReschedulePending  RescheduleStatus = "pending"
+	RescheduleApproved RescheduleStatus = "approved"
+	RescheduleRejected RescheduleStatus = "rejected"
+)
+
+func (s RescheduleStatus) String() string {
+	return string(s)
+}
@@ -9,6 +9,50 @@ var (
 		WithErrorCode("INTERNAL_SERVER_ERROR").
 		WithMessage("Something went wrong in our server. Please try again later.")
 
+	ErrCFPAlreadyOpen = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CFP_ALREADY_OPEN").
+		WithMessage("The call for proposals is already open.")
+
+	ErrCFPClosed = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CFP_CLOSED").
+		WithMessage("The event's call for proposals has closed.")
+
+	ErrCFPClosesBeforeOpens = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CFP_CLOSES_BEFORE_OPENS").
+		WithMessage("The call for proposals must close after it opens.")
+
+	ErrCFPExtendNotLater = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CFP_EXTEND_NOT_LATER").
+		WithMessage("The new closing time must be later than the current one.")
+
+	ErrCFPLeadTimeTooShort = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CFP_LEAD_TIME_TOO_SHORT").
+		WithMessage("The conference starts too soon. Proposals for this event must be submitted further ahead.")
+
+	ErrCFPNotOpenYet = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CFP_NOT_OPEN_YET").
+		WithMessage("The event's call for proposals hasn't opened yet.")
+
+	ErrCancelNotApprovedConference = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CANCEL_NOT_APPROVED_CONFERENCE").
+		WithMessage("Only approved conferences can be cancelled. Delete the proposal instead.")
+
+	ErrCancelStartedConference = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CANCEL_STARTED_CONFERENCE").
+		WithMessage("Conference has started. You're not allowed to cancel it anymore.")
+
+	ErrCancellationCutoffAfterStart = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CANCELLATION_CUTOFF_AFTER_START").
+		WithMessage("Cancellation cutoff must not be after the conference start time.")
+
+	ErrCancellationCutoffPassed = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CANCELLATION_CUTOFF_PASSED").
+		WithMessage("Cancellation cutoff has passed. You're not allowed to cancel your registration anymore.")
+
+	ErrConferenceCancelled = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CONFERENCE_CANCELLED").
+		WithMessage("Conference has been cancelled by its host.")
+
 	ErrConferenceEnded = NewError(http.StatusUnprocessableEntity).
 		WithErrorCode("CONFERENCE_ENDED").
 		WithMessage("Conference has ended. You're not allowed to register anymore.")
@@ -21,6 +65,14 @@ var (
 		WithErrorCode("CONFERENCE_NOT_ENDED").
 		WithMessage("Conference has not ended yet. You're not allowed to give feedback.")
 
+	ErrConferenceNotFull = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CONFERENCE_NOT_FULL").
+		WithMessage("Conference still has available seats. Please register directly.")
+
+	ErrConferenceStarted = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("CONFERENCE_STARTED").
+		WithMessage("Conference has started. You're not allowed to cancel your registration anymore.")
+
 	ErrConflictingRegistrations = NewError(http.StatusConflict).
 		WithErrorCode("CONFLICTING_REGISTRATIONS").
 		WithMessage("You have conflicting registrations. Please check your schedule.")
@@ -29,6 +81,10 @@ var (
 		WithErrorCode("CREDENTIALS_NOT_MATCH").
 		WithMessage("Credentials do not match. Please try again.")
 
+	ErrDeleteApprovedConference = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("DELETE_APPROVED_CONFERENCE").
+		WithMessage("You're not allowed to delete a conference that has been approved. Cancel it instead.")
+
 	ErrEmailAlreadyRegistered = NewError(http.StatusConflict).
 		WithErrorCode("EMAIL_ALREADY_REGISTERED").
 		WithMessage("Email already registered. Please login or use another email.")
@@ -37,6 +93,14 @@ var (
 		WithErrorCode("END_TIME_BEFORE_START").
 		WithMessage("End time is before start time. Please use correct time.")
 
+	ErrEventDatesExcludeConferences = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("EVENT_DATES_EXCLUDE_CONFERENCES").
+		WithMessage("Some conferences of this event fall outside the new dates.")
+
+	ErrEventInUse = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("EVENT_IN_USE").
+		WithMessage("This event still has conferences, so it can't be deleted.")
+
 	ErrFailParseRequest = NewError(http.StatusBadRequest).
 		WithErrorCode("FAIL_PARSE_REQUEST").
 		WithMessage("Failed to parse request. Please check your request format.")
@@ -77,21 +141,85 @@ var (
 		WithErrorCode("INVALID_REFRESH_TOKEN").
 		WithMessage("Auth session is invalid. Please login again.")
 
+	ErrInvalidSpeaker = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("INVALID_SPEAKER").
+		WithMessage("Each speaker must be either a user or a guest with a name, not both.")
+
 	ErrNoBearerToken = NewError(http.StatusUnauthorized).
 		WithErrorCode("NO_BEARER_TOKEN").
 		WithMessage("You're not logged in. Please login first.")
 
+	ErrNoScheduleConflict = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("NO_SCHEDULE_CONFLICT").
+		WithMessage("Your registration has no schedule conflict to resolve.")
+
+	ErrNotEventCoordinator = NewError(http.StatusForbidden).
+		WithErrorCode("NOT_EVENT_COORDINATOR").
+		WithMessage("You're not a coordinator of this event.")
+
 	ErrNotFound = NewError(http.StatusNotFound).
 		WithErrorCode("NOT_FOUND").
 		WithMessage("Data not found.")
 
+	ErrOutsideEventDates = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("OUTSIDE_EVENT_DATES").
+		WithMessage("The conference must take place within the dates of its event.")
+
+	ErrRateLimited = NewError(http.StatusTooManyRequests).
+		WithErrorCode("RATE_LIMITED").
+		WithMessage("Too many requests. Please slow down and try again later.")
+
+	ErrRescheduleAlreadyRequested = NewError(http.StatusConflict).
+		WithErrorCode("RESCHEDULE_ALREADY_REQUESTED").
+		WithMessage("There is already a pending reschedule request for this conference.")
+
+	ErrRescheduleNotApprovedConference = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("RESCHEDULE_NOT_APPROVED_CONFERENCE").
+		WithMessage("Only approved conferences can be rescheduled. Update the proposal instead.")
+
+	ErrRescheduleNotPending = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("RESCHEDULE_NOT_PENDING").
+		WithMessage("This reschedule request has already been reviewed.")
+
+	ErrRescheduleStartedConference = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("RESCHEDULE_STARTED_CONFERENCE").
+		WithMessage("Conference has started. You're not allowed to reschedule it anymore.")
+
+	ErrRoomCapacityBelowSeats = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("ROOM_CAPACITY_BELOW_SEATS").
+		WithMessage("An upcoming conference in this room has more seats than the new capacity.")
+
+	ErrRoomInUse = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("ROOM_IN_USE").
+		WithMessage("This room still has upcoming conferences. Move them to another room first.")
+
+	ErrRoomNameTaken = NewError(http.StatusConflict).
+		WithErrorCode("ROOM_NAME_TAKEN").
+		WithMessage("There is already a room with this name.")
+
+	ErrSeatsExceedRoomCapacity = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("SEATS_EXCEED_ROOM_CAPACITY").
+		WithMessage("The room can't hold that many seats. Please choose a bigger room or fewer seats.")
+
+	ErrSpeakerListedTwice = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("SPEAKER_LISTED_TWICE").
+		WithMessage("The same user is listed as a speaker more than once.")
+
+	ErrSpeakerUserNotFound = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("SPEAKER_USER_NOT_FOUND").
+		WithMessage("One of the speakers isn't a user of the system. Add them as a guest speaker instead.")
+
 	ErrTimeAlreadyPassed = NewError(http.StatusUnprocessableEntity).
 		WithErrorCode("TIME_ALREADY_PASSED").
 		WithMessage("Time has already passed. Please use future time.")
 
 	ErrTimeWindowConflict = NewError(http.StatusConflict).
 		WithErrorCode("TIME_WINDOW_CONFLICT").
-		WithMessage("There's already a conference in the same time window. Please choose another time window.")
+		WithMessage("There's already a conference in the same room and time window. Please choose another time window or room.")
+
+	ErrTooManyAttempts = NewError(http.StatusTooManyRequests).
+		WithErrorCode("TOO_MANY_ATTEMPTS").
+		WithMessage("Too many failed attempts. Please try again later.")
 
 	ErrUpdatePastConference = NewError(http.StatusUnprocessableEntity).
 		WithErrorCode("UPDATE_PAST_CONFERENCE").
@@ -105,13 +233,25 @@ var (
 		WithErrorCode("UPDATE_PAST_CONFERENCE_STATUS").
 		WithMessage("You're only allowed to change past conference status from pending to rejected.")
 
+	ErrUserAlreadyInWaitlist = NewError(http.StatusConflict).
+		WithErrorCode("USER_ALREADY_IN_WAITLIST").
+		WithMessage("You're already in the waitlist of this conference.")
+
 	ErrUserAlreadyRegisteredToConference = NewError(http.StatusConflict).
 		WithErrorCode("USER_ALREADY_REGISTERED_TO_CONFERENCE").
 		WithMessage("You're already registered to this conference.")
 
 	ErrUserHasActiveProposal = NewError(http.StatusConflict).
 		WithErrorCode("USER_HAS_ACTIVE_PROPOSAL").
-		WithMessage("You already have an active proposal. Please wait until it's accepted or delete it.")
+		WithMessage("You've reached your limit of active proposals. Please wait until one is accepted or delete one.")
+
+	ErrUserNotCoordinator = NewError(http.StatusUnprocessableEntity).
+		WithErrorCode("USER_NOT_COORDINATOR").
+		WithMessage("Only users with the event coordinator role can coordinate an event.")
+
+	ErrUserNotInWaitlist = NewError(http.StatusNotFound).
+		WithErrorCode("USER_NOT_IN_WAITLIST").
+		WithMessage("You're not in the waitlist of this conference.")
 
 	ErrUserNotRegisteredToConference = NewError(http.StatusForbidden).
 		WithErrorCode("USER_NOT_REGISTERED_TO_CONFERENCE").
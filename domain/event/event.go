@@ -0,0 +1,98 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+const (
+	NameConferenceApproved    = "conference.approved"
+	NameConferenceRejected    = "conference.rejected"
+	NameConferenceCancelled   = "conference.cancelled"
+	NameConferenceRescheduled = "conference.rescheduled"
+	NameRescheduleReviewed    = "conference.reschedule_reviewed"
+	NameRegistrationConfirmed = "registration.confirmed"
+	NameRegistrationConflict  = "registration.conflict"
+)
+
+// Event is something that has happened in the domain. Subscribers react to it after the fact,
+// so an event must carry everything they need without reading the state back.
+type Event interface {
+	EventName() string
+}
+
+type ConferenceApproved struct {
+	Conference entity.Conference
+	Review     entity.ConferenceReview
+}
+
+func (ConferenceApproved) EventName() string {
+	return NameConferenceApproved
+}
+
+type ConferenceRejected struct {
+	Conference entity.Conference
+	Review     entity.ConferenceReview
+}
+
+func (ConferenceRejected) EventName() string {
+	return NameConferenceRejected
+}
+
+type ConferenceCancelled struct {
+	Conference entity.Conference
+	Reason     string
+}
+
+func (ConferenceCancelled) EventName() string {
+	return NameConferenceCancelled
+}
+
+type ConferenceRescheduled struct {
+	Conference       entity.Conference
+	PreviousStartsAt time.Time
+	PreviousEndsAt   time.Time
+}
+
+func (ConferenceRescheduled) EventName() string {
+	return NameConferenceRescheduled
+}
+
+type RescheduleReviewed struct {
+	Conference entity.Conference
+	Reschedule entity.ConferenceReschedule
+}
+
+func (RescheduleReviewed) EventName() string {
+	return NameRescheduleReviewed
+}
+
+type RegistrationConfirmed struct {
+	ConferenceID    uuid.UUID
+	ConferenceTitle string
+	StartsAt        time.Time
+	UserID          uuid.UUID
+	// FromWaitlist tells the user got the seat by being promoted from the waitlist
+	FromWaitlist bool
+}
+
+func (RegistrationConfirmed) EventName() string {
+	return NameRegistrationConfirmed
+}
+
+// RegistrationConflict tells a registrant that a rescheduled conference now clashes with their other
+// registrations, so they can keep or drop their seat.
+type RegistrationConflict struct {
+	ConferenceID    uuid.UUID
+	ConferenceTitle string
+	StartsAt        time.Time
+	UserID          uuid.UUID
+	// ConflictingTitles are the titles of the other conferences the user registered to
+	ConflictingTitles []string
+}
+
+func (RegistrationConflict) EventName() string {
+	return NameRegistrationConflict
+}
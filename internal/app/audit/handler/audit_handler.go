@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/middleware"
+	"github.com/nathakusuma/conference-backend/pkg/validator"
+)
+
+type auditHandler struct {
+	svc contract.IAuditService
+	val validator.IValidator
+}
+
+func InitAuditHandler(
+	router fiber.Router,
+	midw *middleware.Middleware,
+	val validator.IValidator,
+	auditSvc contract.IAuditService,
+) {
+	handler := auditHandler{
+		svc: auditSvc,
+		val: val,
+	}
+
+	auditGroup := router.Group("/admin/audit")
+	auditGroup.Use(midw.RequireAuthenticated())
+	auditGroup.Use(midw.RequireOneOfRoles(enum.RoleAdmin))
+
+	auditGroup.Get("",
+		handler.getEvents(),
+	)
+}
+
+func (h *auditHandler) getEvents() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var query dto.GetAuditEventsQuery
+		if err := c.QueryParser(&query); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var lazyReq dto.LazyLoadQuery
+		if err := c.QueryParser(&lazyReq); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err := h.val.ValidateStruct(query); err != nil {
+			return err
+		}
+
+		if err := h.val.ValidateStruct(lazyReq); err != nil {
+			return err
+		}
+
+		events, lazyResp, err := h.svc.GetEvents(c.Context(), query, lazyReq)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(map[string]interface{}{
+			"events":     events,
+			"pagination": lazyResp,
+		})
+	}
+}
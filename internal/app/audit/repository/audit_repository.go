@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+const auditEventColumns = `id, actor_id, actor_role, ip_address, action, target_type, target_id, outcome, created_at`
+
+type auditRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuditRepository(db *sqlx.DB) contract.IAuditRepository {
+	return &auditRepository{
+		db: db,
+	}
+}
+
+func (r *auditRepository) createEvent(ctx context.Context, tx sqlx.ExtContext, event *entity.AuditEvent) error {
+	query := `INSERT INTO audit_events (id, actor_id, actor_role, ip_address, action, target_type, target_id, outcome)
+		VALUES (:id, :actor_id, :actor_role, :ip_address, :action, :target_type, :target_id, :outcome)`
+	_, err := sqlx.NamedExecContext(ctx, tx, query, event)
+	return err
+}
+
+func (r *auditRepository) CreateEvent(ctx context.Context, event *entity.AuditEvent) error {
+	return r.createEvent(ctx, r.db, event)
+}
+
+func (r *auditRepository) GetEvents(ctx context.Context, query dto.GetAuditEventsQuery,
+	lazy dto.LazyLoadQuery) ([]entity.AuditEvent, dto.LazyLoadResponse, error) {
+
+	var events []entity.AuditEvent
+	var args []interface{}
+	argCount := 0
+
+	statement := `SELECT ` + auditEventColumns + ` FROM audit_events WHERE TRUE`
+
+	// Add filters
+	if query.ActorID != nil {
+		statement += fmt.Sprintf(" AND actor_id = $%d", argCount+1)
+		args = append(args, query.ActorID)
+		argCount++
+	}
+	if query.Action != "" {
+		statement += fmt.Sprintf(" AND action = $%d", argCount+1)
+		args = append(args, query.Action)
+		argCount++
+	}
+	if query.TargetType != "" {
+		statement += fmt.Sprintf(" AND target_type = $%d", argCount+1)
+		args = append(args, query.TargetType)
+		argCount++
+	}
+	if query.TargetID != "" {
+		statement += fmt.Sprintf(" AND target_id = $%d", argCount+1)
+		args = append(args, query.TargetID)
+		argCount++
+	}
+	if query.Outcome != "" {
+		statement += fmt.Sprintf(" AND outcome = $%d", argCount+1)
+		args = append(args, query.Outcome)
+		argCount++
+	}
+
+	// Add pagination filters
+	if lazy.AfterID != uuid.Nil {
+		statement += fmt.Sprintf(" AND id > $%d", argCount+1)
+		args = append(args, lazy.AfterID)
+		argCount++
+	}
+	if lazy.BeforeID != uuid.Nil {
+		statement += fmt.Sprintf(" AND id < $%d", argCount+1)
+		args = append(args, lazy.BeforeID)
+		argCount++
+	}
+
+	// Add ordering and limit
+	if lazy.BeforeID != uuid.Nil {
+		statement += " ORDER BY id DESC"
+	} else {
+		statement += " ORDER BY id ASC"
+	}
+	statement += fmt.Sprintf(" LIMIT $%d", argCount+1)
+	args = append(args, lazy.Limit+1) // Request one extra record to determine if there are more results
+
+	if err := r.db.SelectContext(ctx, &events, statement, args...); err != nil {
+		return nil, dto.LazyLoadResponse{}, fmt.Errorf("failed to query audit events: %w", err)
+	}
+
+	// Prepare response
+	lazyResp := dto.LazyLoadResponse{
+		HasMore: false,
+		FirstID: nil,
+		LastID:  nil,
+	}
+
+	if len(events) > 0 {
+		// Check if we got an extra record. It's the last one in query order, whichever way we paginate
+		if len(events) > lazy.Limit {
+			lazyResp.HasMore = true
+			events = events[:lazy.Limit]
+		}
+
+		// For BeforeID, reverse the final result set to maintain ascending order
+		if lazy.BeforeID != uuid.Nil {
+			for i := 0; i < len(events)/2; i++ {
+				j := len(events) - 1 - i
+				events[i], events[j] = events[j], events[i]
+			}
+		}
+
+		lazyResp.FirstID = events[0].ID
+		lazyResp.LastID = events[len(events)-1].ID
+	}
+
+	return events, lazyResp, nil
+}
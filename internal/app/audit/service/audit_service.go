@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
+)
+
+type auditService struct {
+	repo contract.IAuditRepository
+	uuid uuidpkg.IUUID
+}
+
+func NewAuditService(
+	auditRepository contract.IAuditRepository,
+	uuid uuidpkg.IUUID,
+) contract.IAuditService {
+	return &auditService{
+		repo: auditRepository,
+		uuid: uuid,
+	}
+}
+
+func (s *auditService) Record(ctx context.Context, req dto.RecordAuditEventRequest) error {
+	id, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":  err.Error(),
+			"action": req.Action,
+		}, "[AuditService][Record] Failed to generate UUID")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	event := entity.AuditEvent{
+		ID:         id,
+		ActorID:    req.ActorID,
+		ActorRole:  req.ActorRole,
+		IPAddress:  req.IPAddress,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Outcome:    req.Outcome,
+		CreatedAt:  time.Now(),
+	}
+
+	if err = s.repo.CreateEvent(ctx, &event); err != nil {
+		// The event is logged in full, so it isn't lost when the database is down
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error": err.Error(),
+			"event": event,
+		}, "[AuditService][Record] Failed to create audit event")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return nil
+}
+
+func (s *auditService) GetEvents(ctx context.Context, query dto.GetAuditEventsQuery,
+	lazyReq dto.LazyLoadQuery) ([]dto.AuditEventResponse, dto.LazyLoadResponse, error) {
+
+	if lazyReq.AfterID != uuid.Nil && lazyReq.BeforeID != uuid.Nil {
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInvalidPagination
+	}
+
+	events, lazyResp, err := s.repo.GetEvents(ctx, query, lazyReq)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error": err.Error(),
+			"query": query,
+		}, "[AuditService][GetEvents] Failed to get audit events")
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.AuditEventResponse, len(events))
+	for i, event := range events {
+		resp[i].PopulateFromEntity(&event)
+	}
+
+	return resp, lazyResp, nil
+}
@@ -2,12 +2,15 @@ package handler
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/nathakusuma/conference-backend/domain/contract"
 	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
 	"github.com/nathakusuma/conference-backend/internal/middleware"
 	"github.com/nathakusuma/conference-backend/pkg/validator"
 	"net/http"
+	"time"
 )
 
 type authHandler struct {
@@ -27,15 +30,60 @@ func InitAuthHandler(
 		val: validator,
 	}
 
+	// OTP requests send emails, so they're limited per recipient as well to prevent mailbox spamming
+	otpEmailLimit := middlewareInstance.RateLimit(middleware.RateLimitConfig{
+		Name:   "auth_otp_email",
+		Max:    3,
+		Window: 10 * time.Minute,
+		KeyBy:  middleware.KeyByBodyField("email"),
+	})
+	otpIPLimit := middlewareInstance.RateLimit(middleware.RateLimitConfig{
+		Name:   "auth_otp_ip",
+		Max:    10,
+		Window: 10 * time.Minute,
+		KeyBy:  middleware.KeyByIP(),
+	})
+	credentialsIPLimit := middlewareInstance.RateLimit(middleware.RateLimitConfig{
+		Name:   "auth_credentials_ip",
+		Max:    30,
+		Window: time.Minute,
+		KeyBy:  middleware.KeyByIP(),
+	})
+
+	// Account changes and sign-ins are audited against the account's email, the requester is anonymous
+	auditByEmail := func(action enum.AuditAction) fiber.Handler {
+		return middlewareInstance.Audit(middleware.AuditConfig{
+			Action:     action,
+			TargetType: "user",
+			TargetBy:   middleware.TargetByBodyField("email"),
+		})
+	}
+
 	authGroup := router.Group("/auth")
-	authGroup.Post("/register/otp", handler.requestOTPRegisterUser())
-	authGroup.Post("/register/otp/check", handler.checkOTPRegisterUser())
-	authGroup.Post("/register", handler.registerUser())
-	authGroup.Post("/login", handler.loginUser())
-	authGroup.Post("/refresh", handler.refreshToken())
+	authGroup.Post("/register/otp", otpIPLimit, otpEmailLimit, handler.requestOTPRegisterUser())
+	authGroup.Post("/register/otp/check", credentialsIPLimit, handler.checkOTPRegisterUser())
+	authGroup.Post("/register", credentialsIPLimit, auditByEmail(enum.AuditActionRegister), handler.registerUser())
+	authGroup.Post("/login", credentialsIPLimit, auditByEmail(enum.AuditActionLogin), handler.loginUser())
+	authGroup.Post("/refresh", credentialsIPLimit, handler.refreshToken())
 	authGroup.Post("/logout", middlewareInstance.RequireAuthenticated(), handler.logout())
-	authGroup.Post("/reset-password/otp", handler.requestOTPResetPassword())
-	authGroup.Post("/reset-password", handler.resetPassword())
+	authGroup.Get("/sessions", middlewareInstance.RequireAuthenticated(), handler.getSessions())
+	authGroup.Delete("/sessions", middlewareInstance.RequireAuthenticated(),
+		middlewareInstance.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionAllSessionsRevoked,
+			TargetType: "user",
+			TargetBy:   middleware.TargetByUserID(),
+		}),
+		handler.logoutAll())
+	authGroup.Delete("/sessions/:id", middlewareInstance.RequireAuthenticated(),
+		middlewareInstance.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionSessionRevoked,
+			TargetType: "session",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		handler.revokeSession())
+	authGroup.Post("/reset-password/otp", otpIPLimit, otpEmailLimit, handler.requestOTPResetPassword())
+	authGroup.Post("/reset-password", credentialsIPLimit, auditByEmail(enum.AuditActionPasswordReset),
+		handler.resetPassword())
 }
 
 func (c *authHandler) requestOTPRegisterUser() fiber.Handler {
@@ -69,7 +117,9 @@ func (c *authHandler) checkOTPRegisterUser() fiber.Handler {
 			return err
 		}
 
-		err := c.svc.CheckOTPRegisterUser(ctx.Context(), req.Email, req.OTP)
+		req.IPAddress = ctx.IP()
+
+		err := c.svc.CheckOTPRegisterUser(ctx.Context(), req)
 		if err != nil {
 			return err
 		}
@@ -89,6 +139,9 @@ func (c *authHandler) registerUser() fiber.Handler {
 			return err
 		}
 
+		req.UserAgent = ctx.Get(fiber.HeaderUserAgent)
+		req.IPAddress = ctx.IP()
+
 		resp, err := c.svc.RegisterUser(ctx.Context(), req)
 		if err != nil {
 			return err
@@ -109,6 +162,9 @@ func (c *authHandler) loginUser() fiber.Handler {
 			return err
 		}
 
+		req.UserAgent = ctx.Get(fiber.HeaderUserAgent)
+		req.IPAddress = ctx.IP()
+
 		resp, err := c.svc.Login(ctx.Context(), req)
 		if err != nil {
 			return err
@@ -149,6 +205,45 @@ func (c *authHandler) logout() fiber.Handler {
 	}
 }
 
+func (c *authHandler) logoutAll() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		err := c.svc.LogoutAll(ctx.Context())
+		if err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(http.StatusNoContent)
+	}
+}
+
+func (c *authHandler) getSessions() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		sessions, err := c.svc.GetSessions(ctx.Context())
+		if err != nil {
+			return err
+		}
+
+		return ctx.Status(http.StatusOK).JSON(map[string]interface{}{
+			"sessions": sessions,
+		})
+	}
+}
+
+func (c *authHandler) revokeSession() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		sessionID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = c.svc.RevokeSession(ctx.Context(), sessionID); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(http.StatusNoContent)
+	}
+}
+
 func (c *authHandler) requestOTPResetPassword() fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		var req dto.RequestOTPResetPasswordRequest
@@ -180,6 +275,9 @@ func (c *authHandler) resetPassword() fiber.Handler {
 			return err
 		}
 
+		req.UserAgent = ctx.Get(fiber.HeaderUserAgent)
+		req.IPAddress = ctx.IP()
+
 		resp, err := c.svc.ResetPassword(ctx.Context(), req)
 		if err != nil {
 			return err
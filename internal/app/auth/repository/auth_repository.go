@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/nathakusuma/conference-backend/domain/contract"
@@ -40,9 +41,8 @@ func (r *authRepository) CreateAuthSession(ctx context.Context, session *entity.
 }
 
 func (r *authRepository) createAuthSession(ctx context.Context, tx sqlx.ExtContext, authSession *entity.AuthSession) error {
-	query := `INSERT INTO auth_sessions (token, user_id, expires_at)
-				VALUES (:token, :user_id, :expires_at)
-				ON CONFLICT (user_id) DO UPDATE SET token = :token, expires_at = :expires_at`
+	query := `INSERT INTO auth_sessions (id, family_id, token, user_id, user_agent, ip_address, expires_at)
+				VALUES (:id, :family_id, :token, :user_id, :user_agent, :ip_address, :expires_at)`
 
 	_, err := sqlx.NamedExecContext(ctx, tx, query, authSession)
 	if err != nil {
@@ -56,8 +56,15 @@ func (r *authRepository) GetAuthSessionByToken(ctx context.Context, token string
 	var authSession entity.AuthSession
 
 	statement := `SELECT
+			id,
+			family_id,
     		token,
 			user_id,
+			user_agent,
+			ip_address,
+			last_used_at,
+			rotated_at,
+			created_at,
 			expires_at
 		FROM auth_sessions
 		WHERE token = $1
@@ -71,7 +78,102 @@ func (r *authRepository) GetAuthSessionByToken(ctx context.Context, token string
 	return &authSession, nil
 }
 
-func (r *authRepository) deleteAuthSession(ctx context.Context, tx sqlx.ExtContext, userID uuid.UUID) error {
+func (r *authRepository) GetAuthSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]entity.AuthSession, error) {
+	var authSessions []entity.AuthSession
+
+	statement := `SELECT
+			id,
+			family_id,
+			user_id,
+			user_agent,
+			ip_address,
+			last_used_at,
+			created_at,
+			expires_at
+		FROM auth_sessions
+		WHERE user_id = $1
+		AND rotated_at IS NULL
+		AND expires_at > now()
+		ORDER BY last_used_at DESC
+		`
+
+	err := r.db.SelectContext(ctx, &authSessions, statement, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return authSessions, nil
+}
+
+func (r *authRepository) RotateAuthSession(ctx context.Context, oldSession,
+	newSession *entity.AuthSession) error {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// Only one rotation may win. The loser gets sql.ErrNoRows.
+	res, err := tx.ExecContext(ctx,
+		`UPDATE auth_sessions SET rotated_at = now() WHERE id = $1 AND rotated_at IS NULL`, oldSession.ID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	query := `INSERT INTO auth_sessions (
+				id, family_id, token, user_id, user_agent, ip_address, last_used_at, created_at, expires_at
+			) VALUES (
+				:id, :family_id, :token, :user_id, :user_agent, :ip_address, :last_used_at, :created_at, :expires_at
+			)`
+
+	if _, err = sqlx.NamedExecContext(ctx, tx, query, newSession); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *authRepository) deleteAuthSession(ctx context.Context, tx sqlx.ExtContext, familyID,
+	userID uuid.UUID) error {
+
+	query := `DELETE FROM auth_sessions WHERE family_id = $1 AND user_id = $2`
+
+	res, err := tx.ExecContext(ctx, query, familyID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *authRepository) DeleteAuthSession(ctx context.Context, familyID, userID uuid.UUID) error {
+	return r.deleteAuthSession(ctx, r.db, familyID, userID)
+}
+
+func (r *authRepository) deleteAuthSessionsByUserID(ctx context.Context, tx sqlx.ExtContext,
+	userID uuid.UUID) error {
+
 	query := `DELETE FROM auth_sessions WHERE user_id = $1`
 
 	res, err := tx.ExecContext(ctx, query, userID)
@@ -91,8 +193,40 @@ func (r *authRepository) deleteAuthSession(ctx context.Context, tx sqlx.ExtConte
 	return nil
 }
 
-func (r *authRepository) DeleteAuthSession(ctx context.Context, userID uuid.UUID) error {
-	return r.deleteAuthSession(ctx, r.db, userID)
+func (r *authRepository) DeleteAuthSessionsByUserID(ctx context.Context, userID uuid.UUID) error {
+	return r.deleteAuthSessionsByUserID(ctx, r.db, userID)
+}
+
+func (r *authRepository) DeleteExpiredAuthSessions(ctx context.Context) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM auth_sessions WHERE expires_at <= now()`)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *authRepository) RevokeAccessTokens(ctx context.Context, familyIDs []uuid.UUID, ttl time.Duration) error {
+	if len(familyIDs) == 0 {
+		return nil
+	}
+
+	pipe := r.rds.Pipeline()
+	for _, familyID := range familyIDs {
+		pipe.Set(ctx, "auth:revoked_session:"+familyID.String(), 1, ttl)
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *authRepository) IsAccessTokenRevoked(ctx context.Context, familyID uuid.UUID) (bool, error) {
+	count, err := r.rds.Exists(ctx, "auth:revoked_session:"+familyID.String()).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
 }
 
 func (r *authRepository) SetOTPResetPassword(ctx context.Context, email, otp string) error {
@@ -106,3 +240,49 @@ func (r *authRepository) GetOTPResetPassword(ctx context.Context, email string)
 func (r *authRepository) DeleteOTPResetPassword(ctx context.Context, email string) error {
 	return r.rds.Del(ctx, "auth:"+email+":reset_password_otp").Err()
 }
+
+// incrementAttemptsScript increments the counter and starts its window on the first attempt only,
+// so repeated failures can't keep extending the window.
+var incrementAttemptsScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return {count, redis.call("PTTL", KEYS[1])}
+`)
+
+func (r *authRepository) IncrementAttempts(ctx context.Context, scope, subject string,
+	window time.Duration) (int64, time.Duration, error) {
+	res, err := incrementAttemptsScript.Run(ctx, r.rds, []string{"auth:attempts:" + scope + ":" + subject},
+		window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return res[0], time.Duration(res[1]) * time.Millisecond, nil
+}
+
+func (r *authRepository) GetAttempts(ctx context.Context, scope, subject string) (int64, time.Duration, error) {
+	key := "auth:attempts:" + scope + ":" + subject
+
+	pipe := r.rds.Pipeline()
+	countCmd := pipe.Get(ctx, key)
+	ttlCmd := pipe.PTTL(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, 0, err
+	}
+
+	count, err := countCmd.Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+
+	return count, ttlCmd.Val(), nil
+}
+
+func (r *authRepository) DeleteAttempts(ctx context.Context, scope, subject string) error {
+	return r.rds.Del(ctx, "auth:attempts:"+scope+":"+subject).Err()
+}
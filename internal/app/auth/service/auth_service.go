@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"math"
 	"strconv"
 	"time"
 
@@ -17,19 +18,32 @@ import (
 	"github.com/nathakusuma/conference-backend/pkg/bcrypt"
 	"github.com/nathakusuma/conference-backend/pkg/jwt"
 	"github.com/nathakusuma/conference-backend/pkg/log"
-	"github.com/nathakusuma/conference-backend/pkg/mail"
 	"github.com/nathakusuma/conference-backend/pkg/randgen"
 	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
 	"github.com/redis/go-redis/v9"
 )
 
+const (
+	attemptScopeLogin         = "login"
+	attemptScopeRegisterOTP   = "register_otp"
+	attemptScopeResetPassword = "reset_password_otp"
+
+	maxLoginAttempts = 5
+	maxOTPAttempts   = 5
+	maxIPAttempts    = 20
+
+	loginAttemptWindow = 15 * time.Minute
+	otpAttemptWindow   = 10 * time.Minute
+)
+
 type authService struct {
-	repo    contract.IAuthRepository
-	userSvc contract.IUserService
-	bcrypt  bcrypt.IBcrypt
-	jwt     jwt.IJwt
-	mailer  mail.IMailer
-	uuid    uuidpkg.IUUID
+	repo     contract.IAuthRepository
+	userSvc  contract.IUserService
+	bcrypt   bcrypt.IBcrypt
+	jwt      jwt.IJwt
+	emailSvc contract.IEmailService
+	uuid     uuidpkg.IUUID
+	randGen  randgen.IRandGen
 }
 
 func NewAuthService(
@@ -37,16 +51,18 @@ func NewAuthService(
 	userSvc contract.IUserService,
 	bcrypt bcrypt.IBcrypt,
 	jwt jwt.IJwt,
-	mailer mail.IMailer,
+	emailSvc contract.IEmailService,
 	uuid uuidpkg.IUUID,
+	randGen randgen.IRandGen,
 ) contract.IAuthService {
 	return &authService{
-		repo:    authRepo,
-		userSvc: userSvc,
-		bcrypt:  bcrypt,
-		jwt:     jwt,
-		mailer:  mailer,
-		uuid:    uuid,
+		repo:     authRepo,
+		userSvc:  userSvc,
+		bcrypt:   bcrypt,
+		jwt:      jwt,
+		emailSvc: emailSvc,
+		uuid:     uuid,
+		randGen:  randGen,
 	}
 }
 
@@ -67,7 +83,16 @@ func (s *authService) RequestOTPRegisterUser(ctx context.Context, email string)
 	}
 
 	// generate otp
-	otp := strconv.Itoa(randgen.RandomNumber(6))
+	otpNumber, err := s.randGen.RandomNumber(6)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.email": email,
+		}, "[AuthService][RequestOTPRegisterUser] failed to generate otp")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+	otp := strconv.Itoa(otpNumber)
 
 	// save otp
 	err = s.repo.SetOTPRegisterUser(ctx, email, otp)
@@ -80,22 +105,17 @@ func (s *authService) RequestOTPRegisterUser(ctx context.Context, email string)
 		return errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
-	// send otp to email
-	go func() {
-		err = s.mailer.Send(
-			email,
-			"[Conference App] Verify Your Account",
-			"otp_register_user.html",
-			map[string]interface{}{
-				"otp": otp,
-			})
-
-		if err != nil {
-			log.Error(map[string]interface{}{
-				"error": err.Error(),
-			}, "[AuthService][RequestOTPRegisterUser] failed to send email")
-		}
-	}()
+	// queue otp email
+	err = s.emailSvc.Enqueue(ctx,
+		email,
+		"[Conference App] Verify Your Account",
+		"otp_register_user.html",
+		map[string]interface{}{
+			"otp": otp,
+		})
+	if err != nil {
+		return err
+	}
 
 	log.Info(map[string]interface{}{
 		"user.email": email,
@@ -104,8 +124,22 @@ func (s *authService) RequestOTPRegisterUser(ctx context.Context, email string)
 	return nil
 }
 
-func (s *authService) CheckOTPRegisterUser(ctx context.Context, email, otp string) error {
-	savedOtp, err := s.repo.GetOTPRegisterUser(ctx, email)
+func (s *authService) CheckOTPRegisterUser(ctx context.Context, req dto.CheckOTPRegisterUserRequest) error {
+	retryAfter, err := s.checkAttempts(ctx, attemptScopeRegisterOTP, req.Email, req.IPAddress, maxOTPAttempts)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.email": req.Email,
+		}, "[AuthService][CheckOTPRegisterUser] failed to check otp attempts")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if retryAfter > 0 {
+		return tooManyAttempts(retryAfter)
+	}
+
+	savedOtp, err := s.repo.GetOTPRegisterUser(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
 			return errorpkg.ErrInvalidOTP
@@ -113,14 +147,14 @@ func (s *authService) CheckOTPRegisterUser(ctx context.Context, email, otp strin
 
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":      err.Error(),
-			"user.email": email,
+			"user.email": req.Email,
 		}, "[AuthService][CheckOTPRegisterUser] failed to get otp")
 
 		return errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
-	if savedOtp != otp {
-		return errorpkg.ErrInvalidOTP
+	if savedOtp != req.OTP {
+		return s.failOTPAttempt(ctx, attemptScopeRegisterOTP, req.Email, req.IPAddress, s.repo.DeleteOTPRegisterUser)
 	}
 
 	return nil
@@ -136,6 +170,20 @@ func (s *authService) RegisterUser(ctx context.Context,
 	loggableReq.Password = ""
 	loggableReq.OTP = ""
 
+	retryAfter, err := s.checkAttempts(ctx, attemptScopeRegisterOTP, req.Email, req.IPAddress, maxOTPAttempts)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":   err.Error(),
+			"request": loggableReq,
+		}, "[AuthService][RegisterUser] failed to check otp attempts")
+
+		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if retryAfter > 0 {
+		return resp, tooManyAttempts(retryAfter)
+	}
+
 	// get otp
 	savedOtp, err := s.repo.GetOTPRegisterUser(ctx, req.Email)
 	if err != nil {
@@ -152,7 +200,8 @@ func (s *authService) RegisterUser(ctx context.Context,
 	}
 
 	if savedOtp != req.OTP {
-		return resp, errorpkg.ErrInvalidOTP
+		return resp, s.failOTPAttempt(ctx, attemptScopeRegisterOTP, req.Email, req.IPAddress,
+			s.repo.DeleteOTPRegisterUser)
 	}
 
 	// delete otp
@@ -166,6 +215,8 @@ func (s *authService) RegisterUser(ctx context.Context,
 		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	s.resetAttempts(ctx, attemptScopeRegisterOTP, req.Email)
+
 	// save user
 	_, err = s.userSvc.CreateUser(ctx, &dto.CreateUserRequest{
 		Name:     req.Name,
@@ -188,19 +239,36 @@ func (s *authService) RegisterUser(ctx context.Context,
 
 	// login user
 	return s.Login(ctx, dto.LoginUserRequest{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		UserAgent: req.UserAgent,
+		IPAddress: req.IPAddress,
 	})
 }
 
 func (s *authService) Login(ctx context.Context, req dto.LoginUserRequest) (dto.LoginResponse, error) {
 	var resp dto.LoginResponse
 
+	retryAfter, err := s.checkAttempts(ctx, attemptScopeLogin, req.Email, req.IPAddress, maxLoginAttempts)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.email": req.Email,
+		}, "[AuthService][Login] failed to check login attempts")
+
+		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if retryAfter > 0 {
+		return resp, tooManyAttempts(retryAfter)
+	}
+
 	// get user by email
 	user, err := s.userSvc.GetUserByEmail(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, errorpkg.ErrNotFound) {
-			return resp, errorpkg.ErrNotFound.WithMessage("User not found. Please register first.")
+			return resp, s.failLoginAttempt(ctx, req,
+				errorpkg.ErrNotFound.WithMessage("User not found. Please register first."))
 		}
 
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
@@ -214,11 +282,22 @@ func (s *authService) Login(ctx context.Context, req dto.LoginUserRequest) (dto.
 	// check password
 	ok := s.bcrypt.Compare(req.Password, user.PasswordHash)
 	if !ok {
-		return resp, errorpkg.ErrCredentialsNotMatch
+		return resp, s.failLoginAttempt(ctx, req, errorpkg.ErrCredentialsNotMatch)
+	}
+
+	s.resetAttempts(ctx, attemptScopeLogin, req.Email)
+
+	sessionID, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.email": req.Email,
+		}, "[AuthService][Login] failed to generate session ID")
+		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
 	// Generate access token first
-	accessToken, err := s.jwt.Create(user.ID, user.Role)
+	accessToken, err := s.jwt.Create(user.ID, user.Role, sessionID)
 	if err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":      err.Error(),
@@ -228,10 +307,22 @@ func (s *authService) Login(ctx context.Context, req dto.LoginUserRequest) (dto.
 	}
 
 	// Generate and store refresh token
-	refreshToken := randgen.RandomString(32)
+	refreshToken, err := s.randGen.RandomString(32)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.email": req.Email,
+		}, "[AuthService][Login] failed to generate refresh token")
+		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
 	err = s.repo.CreateAuthSession(ctx, &entity.AuthSession{
+		ID:        sessionID,
+		FamilyID:  sessionID,
 		Token:     refreshToken,
 		UserID:    user.ID,
+		UserAgent: req.UserAgent,
+		IPAddress: req.IPAddress,
 		ExpiresAt: time.Now().Add(env.GetEnv().JwtRefreshExpireDuration),
 	})
 	if err != nil {
@@ -273,6 +364,11 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (dt
 		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	// A rotated token is presented again. Either the client or an attacker holds a stolen copy.
+	if authSession.RotatedAt != nil {
+		return resp, s.revokeReusedTokenFamily(ctx, authSession)
+	}
+
 	if authSession.ExpiresAt.Before(time.Now()) {
 		return resp, errorpkg.ErrInvalidRefreshToken
 	}
@@ -292,7 +388,7 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (dt
 		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
-	accessToken, err := s.jwt.Create(user.ID, user.Role)
+	accessToken, err := s.jwt.Create(user.ID, user.Role, authSession.FamilyID)
 	if err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":   err.Error(),
@@ -302,11 +398,60 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (dt
 		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	// Rotate refresh token
+	newSessionID, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":   err.Error(),
+			"user.id": user.ID,
+		}, "[AuthService][RefreshToken] failed to generate session ID")
+
+		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	newRefreshToken, err := s.randGen.RandomString(32)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":   err.Error(),
+			"user.id": user.ID,
+		}, "[AuthService][RefreshToken] failed to generate refresh token")
+
+		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	now := time.Now()
+	newSession := &entity.AuthSession{
+		ID:         newSessionID,
+		FamilyID:   authSession.FamilyID,
+		Token:      newRefreshToken,
+		UserID:     authSession.UserID,
+		UserAgent:  authSession.UserAgent,
+		IPAddress:  authSession.IPAddress,
+		LastUsedAt: now,
+		CreatedAt:  authSession.CreatedAt,
+		ExpiresAt:  now.Add(env.GetEnv().JwtRefreshExpireDuration),
+	}
+
+	if err = s.repo.RotateAuthSession(ctx, authSession, newSession); err != nil {
+		// Another request has rotated the same token first
+		if errors.Is(err, sql.ErrNoRows) {
+			return resp, s.revokeReusedTokenFamily(ctx, authSession)
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.id":    user.ID,
+			"session.id": authSession.FamilyID,
+		}, "[AuthService][RefreshToken] failed to rotate auth session")
+
+		return resp, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
 	userResp := dto.UserResponse{}
 	userResp.PopulateFromEntity(user)
 	resp = dto.LoginResponse{
 		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		RefreshToken: newSession.Token,
 		User:         &userResp,
 	}
 
@@ -318,30 +463,220 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (dt
 	return resp, nil
 }
 
+func (s *authService) revokeReusedTokenFamily(ctx context.Context, authSession *entity.AuthSession) error {
+	log.Warn(map[string]interface{}{
+		"user.id":    authSession.UserID,
+		"session.id": authSession.FamilyID,
+		"rotated_at": authSession.RotatedAt,
+	}, "[AuthService][RefreshToken] refresh token reuse detected, revoking token family")
+
+	err := s.repo.RevokeAccessTokens(ctx, []uuid.UUID{authSession.FamilyID}, env.GetEnv().JwtAccessExpireDuration)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.id":    authSession.UserID,
+			"session.id": authSession.FamilyID,
+		}, "[AuthService][RefreshToken] failed to revoke access tokens of token family")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	err = s.repo.DeleteAuthSession(ctx, authSession.FamilyID, authSession.UserID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.id":    authSession.UserID,
+			"session.id": authSession.FamilyID,
+		}, "[AuthService][RefreshToken] failed to revoke token family")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return errorpkg.ErrInvalidRefreshToken
+}
+
 func (s *authService) Logout(ctx context.Context) error {
 	userID := ctx.Value("user.id").(uuid.UUID)
+	sessionID, _ := ctx.Value("session.id").(uuid.UUID)
 
-	err := s.repo.DeleteAuthSession(ctx, userID)
+	err := s.repo.RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.id":    userID,
+			"session.id": sessionID,
+		}, "[AuthService][Logout] failed to revoke access tokens")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	err = s.repo.DeleteAuthSession(ctx, sessionID, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return errorpkg.ErrInvalidBearerToken
 		}
 
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.id":    userID,
+			"session.id": sessionID,
+		}, "[AuthService][Logout] failed to delete auth session")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"user.id":    userID,
+		"session.id": sessionID,
+	}, "[AuthService][Logout] user logged out")
+
+	return nil
+}
+
+func (s *authService) LogoutAll(ctx context.Context) error {
+	userID := ctx.Value("user.id").(uuid.UUID)
+
+	sessions, err := s.repo.GetAuthSessionsByUserID(ctx, userID)
+	if err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":   err.Error(),
 			"user.id": userID,
-		}, "[AuthService][Logout] failed to delete auth session")
+		}, "[AuthService][LogoutAll] failed to get auth sessions")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	familyIDs := make([]uuid.UUID, len(sessions))
+	for i, session := range sessions {
+		familyIDs[i] = session.FamilyID
+	}
+
+	err = s.repo.RevokeAccessTokens(ctx, familyIDs, env.GetEnv().JwtAccessExpireDuration)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":   err.Error(),
+			"user.id": userID,
+		}, "[AuthService][LogoutAll] failed to revoke access tokens")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	err = s.repo.DeleteAuthSessionsByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrInvalidBearerToken
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":   err.Error(),
+			"user.id": userID,
+		}, "[AuthService][LogoutAll] failed to delete auth sessions")
 
 		return errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
 	log.Info(map[string]interface{}{
 		"user.id": userID,
-	}, "[AuthService][Logout] user logged out")
+	}, "[AuthService][LogoutAll] user logged out from all sessions")
 
 	return nil
 }
 
+func (s *authService) GetSessions(ctx context.Context) ([]dto.AuthSessionResponse, error) {
+	userID := ctx.Value("user.id").(uuid.UUID)
+	sessionID, _ := ctx.Value("session.id").(uuid.UUID)
+
+	sessions, err := s.repo.GetAuthSessionsByUserID(ctx, userID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":   err.Error(),
+			"user.id": userID,
+		}, "[AuthService][GetSessions] failed to get auth sessions")
+
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.AuthSessionResponse, len(sessions))
+	for i, session := range sessions {
+		resp[i].PopulateFromEntity(&session)
+		resp[i].IsCurrent = session.FamilyID == sessionID
+	}
+
+	return resp, nil
+}
+
+func (s *authService) RevokeSession(ctx context.Context, sessionID uuid.UUID) error {
+	userID := ctx.Value("user.id").(uuid.UUID)
+
+	// Marking a session of another user as revoked gives nothing away, its ID is random
+	err := s.repo.RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.id":    userID,
+			"session.id": sessionID,
+		}, "[AuthService][RevokeSession] failed to revoke access tokens")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	// Sessions of other users are treated as not found
+	err = s.repo.DeleteAuthSession(ctx, sessionID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.id":    userID,
+			"session.id": sessionID,
+		}, "[AuthService][RevokeSession] failed to delete auth session")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"user.id":    userID,
+		"session.id": sessionID,
+	}, "[AuthService][RevokeSession] auth session revoked")
+
+	return nil
+}
+
+func (s *authService) DeleteExpiredSessions(ctx context.Context) error {
+	deleted, err := s.repo.DeleteExpiredAuthSessions(ctx)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error": err.Error(),
+		}, "[AuthService][DeleteExpiredSessions] failed to delete expired auth sessions")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if deleted > 0 {
+		log.Info(map[string]interface{}{
+			"deleted": deleted,
+		}, "[AuthService][DeleteExpiredSessions] expired auth sessions deleted")
+	}
+
+	return nil
+}
+
+func (s *authService) IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	revoked, err := s.repo.IsAccessTokenRevoked(ctx, sessionID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"session.id": sessionID,
+		}, "[AuthService][IsSessionRevoked] failed to check session revocation")
+
+		return false, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return revoked, nil
+}
+
 func (s *authService) RequestOTPResetPassword(ctx context.Context, email string) error {
 	// check if email is registered
 	_, err := s.userSvc.GetUserByEmail(ctx, email)
@@ -359,7 +694,16 @@ func (s *authService) RequestOTPResetPassword(ctx context.Context, email string)
 	}
 
 	// generate otp
-	otp := strconv.Itoa(randgen.RandomNumber(6))
+	otpNumber, err := s.randGen.RandomNumber(6)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.email": email,
+		}, "[AuthService][RequestOTPResetPassword] failed to generate otp")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+	otp := strconv.Itoa(otpNumber)
 
 	// save otp
 	err = s.repo.SetOTPResetPassword(ctx, email, otp)
@@ -372,22 +716,17 @@ func (s *authService) RequestOTPResetPassword(ctx context.Context, email string)
 		return errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
-	// send otp to email
-	go func() {
-		err = s.mailer.Send(
-			email,
-			"[Conference App] Reset Password",
-			"otp_reset_password.html",
-			map[string]interface{}{
-				"otp": otp,
-			})
-
-		if err != nil {
-			log.Error(map[string]interface{}{
-				"error": err.Error(),
-			}, "[AuthService][RequestOTPResetPassword] failed to send email")
-		}
-	}()
+	// queue otp email
+	err = s.emailSvc.Enqueue(ctx,
+		email,
+		"[Conference App] Reset Password",
+		"otp_reset_password.html",
+		map[string]interface{}{
+			"otp": otp,
+		})
+	if err != nil {
+		return err
+	}
 
 	log.Info(map[string]interface{}{
 		"user.email": email,
@@ -397,6 +736,20 @@ func (s *authService) RequestOTPResetPassword(ctx context.Context, email string)
 }
 
 func (s *authService) ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) (dto.LoginResponse, error) {
+	retryAfter, err := s.checkAttempts(ctx, attemptScopeResetPassword, req.Email, req.IPAddress, maxOTPAttempts)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.email": req.Email,
+		}, "[AuthService][ResetPassword] failed to check otp attempts")
+
+		return dto.LoginResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if retryAfter > 0 {
+		return dto.LoginResponse{}, tooManyAttempts(retryAfter)
+	}
+
 	// get otp
 	savedOtp, err := s.repo.GetOTPResetPassword(ctx, req.Email)
 	if err != nil {
@@ -413,11 +766,12 @@ func (s *authService) ResetPassword(ctx context.Context, req dto.ResetPasswordRe
 	}
 
 	if savedOtp != req.OTP {
-		return dto.LoginResponse{}, errorpkg.ErrInvalidOTP
+		return dto.LoginResponse{}, s.failOTPAttempt(ctx, attemptScopeResetPassword, req.Email, req.IPAddress,
+			s.repo.DeleteOTPResetPassword)
 	}
 
 	// delete otp
-	err = s.repo.SetOTPResetPassword(ctx, req.Email, "")
+	err = s.repo.DeleteOTPResetPassword(ctx, req.Email)
 	if err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":      err.Error(),
@@ -442,12 +796,147 @@ func (s *authService) ResetPassword(ctx context.Context, req dto.ResetPasswordRe
 		return dto.LoginResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	// the account owner has proven themselves, so lift any lockout on the account
+	s.resetAttempts(ctx, attemptScopeResetPassword, req.Email)
+	s.resetAttempts(ctx, attemptScopeLogin, req.Email)
+
 	log.Info(map[string]interface{}{
 		"user.email": req.Email,
 	}, "[AuthService][ResetPassword] password reset")
 
 	return s.Login(ctx, dto.LoginUserRequest{
-		Email:    req.Email,
-		Password: req.NewPassword,
+		Email:     req.Email,
+		Password:  req.NewPassword,
+		UserAgent: req.UserAgent,
+		IPAddress: req.IPAddress,
+	})
+}
+
+type attemptLimit struct {
+	subject     string
+	maxAttempts int64
+}
+
+// attemptLimits lists the counters guarding an attempt. The IP counter is skipped when the IP is unknown.
+func attemptLimits(email, ipAddress string, maxAttempts int64) []attemptLimit {
+	limits := []attemptLimit{{subject: "email:" + email, maxAttempts: maxAttempts}}
+	if ipAddress != "" {
+		limits = append(limits, attemptLimit{subject: "ip:" + ipAddress, maxAttempts: maxIPAttempts})
+	}
+
+	return limits
+}
+
+// checkAttempts returns how long the caller must wait before trying again, or zero if attempts are still allowed.
+func (s *authService) checkAttempts(ctx context.Context, scope, email, ipAddress string,
+	maxAttempts int64) (time.Duration, error) {
+	for _, limit := range attemptLimits(email, ipAddress, maxAttempts) {
+		count, ttl, err := s.repo.GetAttempts(ctx, scope, limit.subject)
+		if err != nil {
+			return 0, err
+		}
+
+		if count >= limit.maxAttempts {
+			return max(ttl, time.Second), nil
+		}
+	}
+
+	return 0, nil
+}
+
+// recordFailedAttempt counts a failure and returns how long the caller is locked out, or zero if not locked out.
+func (s *authService) recordFailedAttempt(ctx context.Context, scope, email, ipAddress string,
+	maxAttempts int64, window time.Duration) (time.Duration, error) {
+	var retryAfter time.Duration
+	for _, limit := range attemptLimits(email, ipAddress, maxAttempts) {
+		count, ttl, err := s.repo.IncrementAttempts(ctx, scope, limit.subject, window)
+		if err != nil {
+			return 0, err
+		}
+
+		if count >= limit.maxAttempts {
+			retryAfter = max(retryAfter, ttl, time.Second)
+		}
+	}
+
+	return retryAfter, nil
+}
+
+func (s *authService) resetAttempts(ctx context.Context, scope, email string) {
+	if err := s.repo.DeleteAttempts(ctx, scope, "email:"+email); err != nil {
+		log.Error(map[string]interface{}{
+			"error":      err.Error(),
+			"scope":      scope,
+			"user.email": email,
+		}, "[AuthService][resetAttempts] failed to reset attempts")
+	}
+}
+
+func (s *authService) failLoginAttempt(ctx context.Context, req dto.LoginUserRequest, cause error) error {
+	retryAfter, err := s.recordFailedAttempt(ctx, attemptScopeLogin, req.Email, req.IPAddress,
+		maxLoginAttempts, loginAttemptWindow)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"user.email": req.Email,
+		}, "[AuthService][Login] failed to record failed attempt")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if retryAfter > 0 {
+		log.Warn(map[string]interface{}{
+			"user.email":  req.Email,
+			"ip_address":  req.IPAddress,
+			"retry_after": retryAfter.String(),
+		}, "[AuthService][Login] too many failed login attempts, locking out")
+
+		return tooManyAttempts(retryAfter)
+	}
+
+	return cause
+}
+
+// failOTPAttempt records a wrong OTP. Once the limit is reached, the OTP is invalidated so it can't be guessed further.
+func (s *authService) failOTPAttempt(ctx context.Context, scope, email, ipAddress string,
+	invalidateOTP func(ctx context.Context, email string) error) error {
+	retryAfter, err := s.recordFailedAttempt(ctx, scope, email, ipAddress, maxOTPAttempts, otpAttemptWindow)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"scope":      scope,
+			"user.email": email,
+		}, "[AuthService][failOTPAttempt] failed to record failed attempt")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if retryAfter == 0 {
+		return errorpkg.ErrInvalidOTP
+	}
+
+	if err = invalidateOTP(ctx, email); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":      err.Error(),
+			"scope":      scope,
+			"user.email": email,
+		}, "[AuthService][failOTPAttempt] failed to invalidate otp")
+
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Warn(map[string]interface{}{
+		"scope":       scope,
+		"user.email":  email,
+		"ip_address":  ipAddress,
+		"retry_after": retryAfter.String(),
+	}, "[AuthService][failOTPAttempt] too many failed otp attempts, otp invalidated")
+
+	return tooManyAttempts(retryAfter)
+}
+
+func tooManyAttempts(retryAfter time.Duration) error {
+	return errorpkg.ErrTooManyAttempts.WithDetail(map[string]interface{}{
+		"retry_after": int(math.Ceil(retryAfter.Seconds())),
 	})
 }
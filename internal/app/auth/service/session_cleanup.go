@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/pkg/background"
+)
+
+const sessionCleanupInterval = time.Hour
+
+// StartSessionCleanup starts the job that deletes the expired auth sessions every hour, so the rows every
+// refresh leaves behind don't pile up. It stops once ctx is done.
+func StartSessionCleanup(ctx context.Context, svc contract.IAuthService) {
+	background.Go("auth.session_cleanup", func() {
+		runSessionCleanup(ctx, svc)
+	})
+}
+
+func runSessionCleanup(ctx context.Context, svc contract.IAuthService) {
+	ticker := time.NewTicker(sessionCleanupInterval)
+	defer ticker.Stop()
+
+	// A cleanup that has started is finished even during shutdown
+	runCtx := context.WithoutCancel(ctx)
+
+	for {
+		// Errors are already logged by the service, the cleanup is simply retried on the next tick
+		_ = svc.DeleteExpiredSessions(runCtx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -13,6 +13,32 @@ import (
 	"github.com/nathakusuma/conference-backend/pkg/validator"
 )
 
+// speakerRequest is one speaker in a request body: either a user of the system or a named guest.
+type speakerRequest struct {
+	UserID *uuid.UUID `json:"user_id" validate:"omitempty,uuid"`
+	Name   *string    `json:"name" validate:"omitempty,min=3,max=100"`
+	Title  *string    `json:"title" validate:"omitempty,min=3,max=100"`
+	Bio    *string    `json:"bio" validate:"omitempty,max=1000"`
+}
+
+func toSpeakerRequests(speakers []speakerRequest) []dto.ConferenceSpeakerRequest {
+	if speakers == nil {
+		return nil
+	}
+
+	reqs := make([]dto.ConferenceSpeakerRequest, len(speakers))
+	for i, speaker := range speakers {
+		reqs[i] = dto.ConferenceSpeakerRequest{
+			UserID: speaker.UserID,
+			Name:   speaker.Name,
+			Title:  speaker.Title,
+			Bio:    speaker.Bio,
+		}
+	}
+
+	return reqs
+}
+
 type conferenceHandler struct {
 	val validator.IValidator
 	svc contract.IConferenceService
@@ -47,27 +73,84 @@ func InitConferenceHandler(
 		handler.updateConference(),
 	)
 	conferenceGroup.Delete("/:id",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionConferenceDeleted,
+			TargetType: "conference",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
 		midw.RequireOneOfRoles(enum.RoleUser, enum.RoleEventCoordinator),
 		handler.deleteConference(),
 	)
+	conferenceGroup.Post("/:id/cancel",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionConferenceCancelled,
+			TargetType: "conference",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleUser, enum.RoleEventCoordinator),
+		handler.cancelConference(),
+	)
 	conferenceGroup.Patch("/:id/status",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionConferenceStatusUpdated,
+			TargetType: "conference",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
 		midw.RequireOneOfRoles(enum.RoleEventCoordinator),
 		handler.updateConferenceStatus(),
 	)
+	conferenceGroup.Get("/:id/reviews",
+		midw.RequireOneOfRoles(enum.RoleEventCoordinator, enum.RoleAdmin),
+		handler.getConferenceReviews(),
+	)
+	conferenceGroup.Get("/:id/history",
+		handler.getConferenceHistory(),
+	)
+	conferenceGroup.Post("/:id/reschedules",
+		midw.RequireOneOfRoles(enum.RoleUser),
+		handler.requestReschedule(),
+	)
+	conferenceGroup.Get("/:id/reschedules",
+		handler.getReschedules(),
+	)
+	conferenceGroup.Patch("/:id/reschedules/:rescheduleId",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionRescheduleReviewed,
+			TargetType: "conference_reschedule",
+			TargetBy:   middleware.TargetByParam("rescheduleId"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleEventCoordinator),
+		handler.reviewReschedule(),
+	)
+	conferenceGroup.Put("/:id/speakers",
+		midw.RequireOneOfRoles(enum.RoleUser),
+		handler.updateConferenceSpeakers(),
+	)
+
+	speakerGroup := router.Group("/speakers")
+	speakerGroup.Use(midw.RequireAuthenticated())
+
+	speakerGroup.Get("/:id/conferences",
+		handler.getSpeakerConferences(),
+	)
 }
 
 func (c *conferenceHandler) createConferenceProposal() fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		type request struct {
-			Title          string  `json:"title" validate:"required,min=3,max=100"`
-			Description    string  `json:"description" validate:"required,min=3,max=1000"`
-			SpeakerName    string  `json:"speaker_name" validate:"required,min=3,max=100"`
-			SpeakerTitle   string  `json:"speaker_title" validate:"required,min=3,max=100"`
-			TargetAudience string  `json:"target_audience" validate:"required,min=3,max=255"`
-			Prerequisites  *string `json:"prerequisites" validate:"omitempty,max=255"`
-			Seats          int     `json:"seats" validate:"required,min=1"`
-			StartsAt       string  `json:"starts_at" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
-			EndsAt         string  `json:"ends_at" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+			Title              string           `json:"title" validate:"required,min=3,max=100"`
+			Description        string           `json:"description" validate:"required,min=3,max=1000"`
+			SpeakerName        string           `json:"speaker_name" validate:"required_without=Speakers,omitempty,min=3,max=100"`
+			SpeakerTitle       string           `json:"speaker_title" validate:"required_without=Speakers,omitempty,min=3,max=100"`
+			TargetAudience     string           `json:"target_audience" validate:"required,min=3,max=255"`
+			Prerequisites      *string          `json:"prerequisites" validate:"omitempty,max=255"`
+			Seats              int              `json:"seats" validate:"required,min=1"`
+			StartsAt           string           `json:"starts_at" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+			EndsAt             string           `json:"ends_at" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+			CancellationCutoff *string          `json:"cancellation_cutoff" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+			RoomID             uuid.UUID        `json:"room_id" validate:"required,uuid"`
+			EventID            uuid.UUID        `json:"event_id" validate:"required,uuid"`
+			Speakers           []speakerRequest `json:"speakers" validate:"omitempty,min=1,max=10,dive"`
 		}
 
 		var req request
@@ -85,16 +168,29 @@ func (c *conferenceHandler) createConferenceProposal() fiber.Handler {
 			return errorpkg.ErrFailParseRequest
 		}
 
+		var cancellationCutoff *time.Time
+		if req.CancellationCutoff != nil {
+			cancellationCutoffValue, err3 := time.Parse(time.RFC3339, *req.CancellationCutoff)
+			if err3 != nil {
+				return errorpkg.ErrFailParseRequest
+			}
+			cancellationCutoff = &cancellationCutoffValue
+		}
+
 		proposal := dto.CreateConferenceProposalRequest{
-			Title:          req.Title,
-			Description:    req.Description,
-			SpeakerName:    req.SpeakerName,
-			SpeakerTitle:   req.SpeakerTitle,
-			TargetAudience: req.TargetAudience,
-			Prerequisites:  req.Prerequisites,
-			Seats:          req.Seats,
-			StartsAt:       startsAt,
-			EndsAt:         endsAt,
+			Title:              req.Title,
+			Description:        req.Description,
+			SpeakerName:        req.SpeakerName,
+			SpeakerTitle:       req.SpeakerTitle,
+			TargetAudience:     req.TargetAudience,
+			Prerequisites:      req.Prerequisites,
+			Seats:              req.Seats,
+			StartsAt:           startsAt,
+			EndsAt:             endsAt,
+			CancellationCutoff: cancellationCutoff,
+			RoomID:             req.RoomID,
+			EventID:            req.EventID,
+			Speakers:           toSpeakerRequests(req.Speakers),
 		}
 
 		conferenceID, err := c.svc.CreateConferenceProposal(ctx.Context(), &proposal)
@@ -133,13 +229,16 @@ func (c *conferenceHandler) getConferences() fiber.Handler {
 			BeforeID     *uuid.UUID            `query:"before_id" validate:"omitempty,uuid"`
 			Limit        int                   `query:"limit" validate:"required,min=1,max=20"`
 			HostID       *uuid.UUID            `query:"host_id" validate:"omitempty,uuid"`
-			Status       enum.ConferenceStatus `query:"status" validate:"required,oneof=pending approved rejected"`
+			Status       enum.ConferenceStatus `query:"status" validate:"required,oneof=pending approved rejected cancelled"`
 			StartsBefore *string               `query:"starts_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 			StartsAfter  *string               `query:"starts_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 			IncludePast  bool                  `query:"include_past" validate:"omitempty"`
 			OrderBy      string                `query:"order_by" validate:"required,oneof=created_at starts_at"`
 			Order        string                `query:"order" validate:"required,oneof=asc desc"`
 			Title        *string               `query:"title" validate:"omitempty"`
+			RoomID       *uuid.UUID            `query:"room_id" validate:"omitempty,uuid"`
+			Track        *string               `query:"track" validate:"omitempty,max=100"`
+			EventID      *uuid.UUID            `query:"event_id" validate:"omitempty,uuid"`
 		}
 
 		var req request
@@ -180,6 +279,9 @@ func (c *conferenceHandler) getConferences() fiber.Handler {
 			OrderBy:      req.OrderBy,
 			Order:        req.Order,
 			Title:        req.Title,
+			RoomID:       req.RoomID,
+			Track:        req.Track,
+			EventID:      req.EventID,
 		}
 
 		conferences, lazy, err := c.svc.GetConferences(ctx.Context(), &query)
@@ -197,14 +299,15 @@ func (c *conferenceHandler) getConferences() fiber.Handler {
 func (c *conferenceHandler) updateConference() fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		type request struct {
-			Title          *string `json:"title" validate:"omitempty,min=3,max=100"`
-			Description    *string `json:"description" validate:"omitempty,min=3,max=1000"`
-			SpeakerName    *string `json:"speaker_name" validate:"omitempty,min=3,max=100"`
-			SpeakerTitle   *string `json:"speaker_title" validate:"omitempty,min=3,max=100"`
-			TargetAudience *string `json:"target_audience" validate:"omitempty,min=3,max=255"`
-			Prerequisites  *string `json:"prerequisites" validate:"omitempty,max=255"`
-			StartsAt       *string `json:"starts_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
-			EndsAt         *string `json:"ends_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+			Title                   *string    `json:"title" validate:"omitempty,min=3,max=100"`
+			Description             *string    `json:"description" validate:"omitempty,min=3,max=1000"`
+			TargetAudience          *string    `json:"target_audience" validate:"omitempty,min=3,max=255"`
+			Prerequisites           *string    `json:"prerequisites" validate:"omitempty,max=255"`
+			StartsAt                *string    `json:"starts_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+			EndsAt                  *string    `json:"ends_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+			CancellationCutoff      *string    `json:"cancellation_cutoff" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+			ClearCancellationCutoff bool       `json:"clear_cancellation_cutoff" validate:"excluded_with=CancellationCutoff"`
+			RoomID                  *uuid.UUID `json:"room_id" validate:"omitempty,uuid"`
 		}
 
 		conferenceID, err := uuid.Parse(ctx.Params("id"))
@@ -218,7 +321,7 @@ func (c *conferenceHandler) updateConference() fiber.Handler {
 		}
 
 		if err2 := c.val.ValidateStruct(req); err2 != nil {
-			return err
+			return err2
 		}
 
 		var startsAt, endsAt *time.Time
@@ -238,15 +341,25 @@ func (c *conferenceHandler) updateConference() fiber.Handler {
 			endsAt = &endsAtValue
 		}
 
+		var cancellationCutoff *time.Time
+		if req.CancellationCutoff != nil {
+			cancellationCutoffValue, err2 := time.Parse(time.RFC3339, *req.CancellationCutoff)
+			if err2 != nil {
+				return errorpkg.ErrFailParseRequest
+			}
+			cancellationCutoff = &cancellationCutoffValue
+		}
+
 		conference := dto.UpdateConferenceRequest{
-			Title:          req.Title,
-			Description:    req.Description,
-			SpeakerName:    req.SpeakerName,
-			SpeakerTitle:   req.SpeakerTitle,
-			TargetAudience: req.TargetAudience,
-			Prerequisites:  req.Prerequisites,
-			StartsAt:       startsAt,
-			EndsAt:         endsAt,
+			Title:                   req.Title,
+			Description:             req.Description,
+			TargetAudience:          req.TargetAudience,
+			Prerequisites:           req.Prerequisites,
+			StartsAt:                startsAt,
+			EndsAt:                  endsAt,
+			CancellationCutoff:      cancellationCutoff,
+			ClearCancellationCutoff: req.ClearCancellationCutoff,
+			RoomID:                  req.RoomID,
 		}
 
 		if err = c.svc.UpdateConference(ctx.Context(), conferenceID, conference); err != nil {
@@ -272,10 +385,42 @@ func (c *conferenceHandler) deleteConference() fiber.Handler {
 	}
 }
 
+func (c *conferenceHandler) cancelConference() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		type request struct {
+			Reason string `json:"reason" validate:"required,min=3,max=2000"`
+		}
+
+		conferenceID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req request
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = c.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err = c.svc.CancelConference(ctx.Context(), conferenceID, dto.CancelConferenceRequest{
+			Reason: req.Reason,
+		}); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
 func (c *conferenceHandler) updateConferenceStatus() fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		type request struct {
 			Status enum.ConferenceStatus `json:"status" validate:"required,oneof=pending approved rejected"`
+			Reason *enum.RejectionReason `json:"reason" validate:"required_if=Status rejected,omitempty,oneof=off_topic duplicate insufficient_detail schedule_conflict other"`
+			Notes  *string               `json:"notes" validate:"omitempty,max=2000"`
 		}
 
 		conferenceID, err := uuid.Parse(ctx.Params("id"))
@@ -292,10 +437,213 @@ func (c *conferenceHandler) updateConferenceStatus() fiber.Handler {
 			return err
 		}
 
-		if err = c.svc.UpdateConferenceStatus(ctx.Context(), conferenceID, req.Status); err != nil {
+		if err = c.svc.UpdateConferenceStatus(ctx.Context(), conferenceID, dto.UpdateConferenceStatusRequest{
+			Status: req.Status,
+			Reason: req.Reason,
+			Notes:  req.Notes,
+		}); err != nil {
 			return err
 		}
 
 		return ctx.SendStatus(fiber.StatusNoContent)
 	}
 }
+
+func (c *conferenceHandler) getConferenceReviews() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		conferenceID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		reviews, err := c.svc.GetConferenceReviews(ctx.Context(), conferenceID)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"reviews": reviews,
+		})
+	}
+}
+
+func (c *conferenceHandler) getConferenceHistory() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		conferenceID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		history, err := c.svc.GetConferenceHistory(ctx.Context(), conferenceID)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"history": history,
+		})
+	}
+}
+
+func (c *conferenceHandler) requestReschedule() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		type request struct {
+			StartsAt string `json:"starts_at" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+			EndsAt   string `json:"ends_at" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+		}
+
+		conferenceID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req request
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = c.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+		endsAt, err2 := time.Parse(time.RFC3339, req.EndsAt)
+		if err != nil || err2 != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		rescheduleID, err := c.svc.RequestReschedule(ctx.Context(), conferenceID, dto.RequestRescheduleRequest{
+			StartsAt: startsAt,
+			EndsAt:   endsAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		return ctx.Status(fiber.StatusCreated).JSON(map[string]interface{}{
+			"reschedule": dto.ConferenceRescheduleResponse{ID: rescheduleID},
+		})
+	}
+}
+
+func (c *conferenceHandler) getReschedules() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		conferenceID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		reschedules, err := c.svc.GetReschedules(ctx.Context(), conferenceID)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"reschedules": reschedules,
+		})
+	}
+}
+
+func (c *conferenceHandler) reviewReschedule() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		type request struct {
+			Status enum.RescheduleStatus `json:"status" validate:"required,oneof=approved rejected"`
+			Notes  *string               `json:"notes" validate:"omitempty,max=2000"`
+		}
+
+		conferenceID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		rescheduleID, err := uuid.Parse(ctx.Params("rescheduleId"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req request
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = c.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err = c.svc.ReviewReschedule(ctx.Context(), conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: req.Status,
+			Notes:  req.Notes,
+		}); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (c *conferenceHandler) updateConferenceSpeakers() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		type request struct {
+			Speakers []speakerRequest `json:"speakers" validate:"required,min=1,max=10,dive"`
+		}
+
+		conferenceID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req request
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = c.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err = c.svc.UpdateConferenceSpeakers(ctx.Context(), conferenceID, dto.UpdateConferenceSpeakersRequest{
+			Speakers: toSpeakerRequests(req.Speakers),
+		}); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (c *conferenceHandler) getSpeakerConferences() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		type request struct {
+			IncludePast bool `query:"include_past" validate:"omitempty"`
+		}
+
+		userID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req request
+		if err = ctx.QueryParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var lazyReq dto.LazyLoadQuery
+		if err = ctx.QueryParser(&lazyReq); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = c.val.ValidateStruct(lazyReq); err != nil {
+			return err
+		}
+
+		conferences, lazyResp, err := c.svc.GetSpeakerConferences(ctx.Context(), userID, req.IncludePast, lazyReq)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"conferences": conferences,
+			"pagination":  lazyResp,
+		})
+	}
+}
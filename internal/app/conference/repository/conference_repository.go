@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/nathakusuma/conference-backend/domain/contract"
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
 )
 
 type conferenceRepository struct {
@@ -33,11 +35,11 @@ func (r *conferenceRepository) createConference(ctx context.Context, tx sqlx.Ext
 		`INSERT INTO conferences (
                          id, title, description, speaker_name, speaker_title,
                          target_audience, prerequisites, seats, starts_at, ends_at,
-                         host_id, status
+                         cancellation_cutoff, host_id, room_id, event_id, status
 					) VALUES (
 					          :id, :title, :description, :speaker_name, :speaker_title,
 					          :target_audience, :prerequisites, :seats, :starts_at, :ends_at,
-					          :host_id, :status)`,
+					          :cancellation_cutoff, :host_id, :room_id, :event_id, :status)`,
 		conference,
 	)
 	if err != nil {
@@ -48,7 +50,23 @@ func (r *conferenceRepository) createConference(ctx context.Context, tx sqlx.Ext
 }
 
 func (r *conferenceRepository) CreateConference(ctx context.Context, conference *entity.Conference) error {
-	return r.createConference(ctx, r.db, conference)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err = r.createConference(ctx, tx, conference); err != nil {
+		return err
+	}
+
+	if err = r.createSpeakers(ctx, tx, conference.Speakers); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *conferenceRepository) GetConferenceByID(ctx context.Context, id uuid.UUID) (*entity.Conference, error) {
@@ -56,18 +74,23 @@ func (r *conferenceRepository) GetConferenceByID(ctx context.Context, id uuid.UU
 
 	statement := `SELECT
 						c.id, c.title, c.description, c.speaker_name, c.speaker_title,
-						c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at,
-						c.host_id, c.status, c.created_at, c.updated_at, u.name AS host_name,
-						COUNT(r.user_id) AS registration_count
+						c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at, c.cancellation_cutoff,
+						c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at, u.name AS host_name,
+						rm.name AS room_name, rm.track AS room_track, e.name AS event_name,
+						COUNT(r.user_id) AS registration_count,
+						(SELECT COUNT(*) FROM waitlist_entries w JOIN users wu ON w.user_id = wu.id
+							WHERE w.conference_id = c.id AND wu.deleted_at IS NULL) AS waitlist_count
 					FROM conferences c
 					JOIN users u ON c.host_id = u.id
-					LEFT JOIN registrations r ON c.id = r.conference_id
+					JOIN rooms rm ON c.room_id = rm.id
+					JOIN events e ON c.event_id = e.id
+					LEFT JOIN registrations r ON c.id = r.conference_id AND r.cancelled_at IS NULL
 					WHERE c.id = $1
 					AND c.deleted_at IS NULL
 					GROUP BY
 						c.id, c.title, c.description, c.speaker_name, c.speaker_title,
-						c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at,
-						c.host_id, c.status, c.created_at, c.updated_at, u.name
+						c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at, c.cancellation_cutoff,
+						c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at, u.name, rm.name, rm.track, e.name
 		`
 
 	err := r.db.GetContext(ctx, &row, statement, id)
@@ -79,6 +102,27 @@ func (r *conferenceRepository) GetConferenceByID(ctx context.Context, id uuid.UU
 	return &conference, nil
 }
 
+func (r *conferenceRepository) IsUserRegisteredToConference(ctx context.Context, conferenceID,
+	userID uuid.UUID) (bool, error) {
+
+	var exists bool
+	if err := r.db.GetContext(
+		ctx,
+		&exists,
+		`SELECT EXISTS (
+			SELECT 1 FROM registrations
+			WHERE conference_id = $1
+			AND user_id = $2
+			AND cancelled_at IS NULL
+		)`,
+		conferenceID, userID,
+	); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
 func (r *conferenceRepository) GetConferences(ctx context.Context,
 	query *dto.GetConferenceQuery) ([]entity.Conference, dto.LazyLoadResponse, error) {
 
@@ -86,12 +130,17 @@ func (r *conferenceRepository) GetConferences(ctx context.Context,
 	baseQuery := `
         SELECT
             c.id, c.title, c.description, c.speaker_name, c.speaker_title,
-            c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at,
-            c.host_id, c.status, c.created_at, c.updated_at, u.name AS host_name,
-            COUNT(r.user_id) AS registration_count
+            c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at, c.cancellation_cutoff,
+            c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at, u.name AS host_name,
+            rm.name AS room_name, rm.track AS room_track, e.name AS event_name,
+            COUNT(r.user_id) AS registration_count,
+            (SELECT COUNT(*) FROM waitlist_entries w JOIN users wu ON w.user_id = wu.id
+                WHERE w.conference_id = c.id AND wu.deleted_at IS NULL) AS waitlist_count
         FROM conferences c
         JOIN users u ON c.host_id = u.id
-        LEFT JOIN registrations r ON c.id = r.conference_id
+        JOIN rooms rm ON c.room_id = rm.id
+        JOIN events e ON c.event_id = e.id
+        LEFT JOIN registrations r ON c.id = r.conference_id AND r.cancelled_at IS NULL
         WHERE c.deleted_at IS NULL`
 
 	// Initialize query arguments
@@ -115,9 +164,32 @@ func (r *conferenceRepository) GetConferences(ctx context.Context,
 		conditions = append(conditions, fmt.Sprintf("c.host_id = $%d", len(args)))
 	}
 
+	if query.HostOrRegistrantID != nil {
+		args = append(args, query.HostOrRegistrantID)
+		conditions = append(conditions, fmt.Sprintf(`(c.host_id = $%d OR EXISTS (
+			SELECT 1 FROM registrations reg
+			WHERE reg.conference_id = c.id AND reg.user_id = $%d AND reg.cancelled_at IS NULL
+		))`, len(args), len(args)))
+	}
+
 	args = append(args, query.Status)
 	conditions = append(conditions, fmt.Sprintf("c.status = $%d", len(args)))
 
+	if query.RoomID != nil {
+		args = append(args, query.RoomID)
+		conditions = append(conditions, fmt.Sprintf("c.room_id = $%d", len(args)))
+	}
+
+	if query.Track != nil {
+		args = append(args, *query.Track)
+		conditions = append(conditions, fmt.Sprintf("rm.track = $%d", len(args)))
+	}
+
+	if query.EventID != nil {
+		args = append(args, query.EventID)
+		conditions = append(conditions, fmt.Sprintf("c.event_id = $%d", len(args)))
+	}
+
 	if query.StartsBefore != nil {
 		args = append(args, query.StartsBefore)
 		conditions = append(conditions, fmt.Sprintf("c.starts_at < $%d", len(args)))
@@ -192,8 +264,8 @@ func (r *conferenceRepository) GetConferences(ctx context.Context,
 	baseQuery += `
         GROUP BY
             c.id, c.title, c.description, c.speaker_name, c.speaker_title,
-            c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at,
-            c.host_id, c.status, c.created_at, c.updated_at, u.name`
+            c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at, c.cancellation_cutoff,
+            c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at, u.name, rm.name, rm.track, e.name`
 
 	// Add ORDER BY clause
 	if query.OrderBy == "c.created_at" {
@@ -274,7 +346,9 @@ func (r *conferenceRepository) updateConference(ctx context.Context, tx sqlx.Ext
 			seats = :seats,
 			starts_at = :starts_at,
 			ends_at = :ends_at,
+			cancellation_cutoff = :cancellation_cutoff,
 			host_id = :host_id,
+			room_id = :room_id,
 			status = :status,
 			updated_at = now()
 		WHERE id = :id`,
@@ -296,8 +370,26 @@ func (r *conferenceRepository) updateConference(ctx context.Context, tx sqlx.Ext
 	return nil
 }
 
-func (r *conferenceRepository) UpdateConference(ctx context.Context, conference *entity.Conference) error {
-	return r.updateConference(ctx, r.db, conference)
+func (r *conferenceRepository) UpdateConference(ctx context.Context, conference *entity.Conference,
+	audit *entity.ConferenceAudit) error {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err = r.updateConference(ctx, tx, conference); err != nil {
+		return err
+	}
+
+	if err = r.createAudit(ctx, tx, audit); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *conferenceRepository) deleteConference(ctx context.Context, tx sqlx.ExtContext, id uuid.UUID) error {
@@ -318,32 +410,555 @@ func (r *conferenceRepository) deleteConference(ctx context.Context, tx sqlx.Ext
 	return nil
 }
 
-func (r *conferenceRepository) DeleteConference(ctx context.Context, id uuid.UUID) error {
-	return r.deleteConference(ctx, r.db, id)
+func (r *conferenceRepository) DeleteConference(ctx context.Context, id uuid.UUID,
+	audit *entity.ConferenceAudit) error {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err = r.deleteConference(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if err = r.createAudit(ctx, tx, audit); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (r *conferenceRepository) GetConferencesConflictingWithTime(ctx context.Context, startsAt,
-	endsAt time.Time, excludeID uuid.UUID) ([]entity.Conference, error) {
+func (r *conferenceRepository) GetActiveProposalsByHost(ctx context.Context,
+	hostID uuid.UUID) ([]entity.Conference, error) {
 
 	var conferences []entity.Conference
 
 	err := r.db.SelectContext(ctx, &conferences, `
 		SELECT
 			c.id, c.title, c.description, c.speaker_name, c.speaker_title,
-			c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at,
-			c.host_id, c.status, c.created_at, c.updated_at
+			c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at, c.cancellation_cutoff,
+			c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at
+		FROM conferences c
+		WHERE c.deleted_at IS NULL
+		AND c.host_id = $1
+		AND c.status = 'pending'
+		AND c.ends_at > now()
+		ORDER BY c.created_at
+		`, hostID)
+	if err != nil {
+		return nil, err
+	}
+
+	return conferences, nil
+}
+
+func (r *conferenceRepository) getConferencesConflictingWithTime(ctx context.Context, tx sqlx.ExtContext,
+	roomID uuid.UUID, startsAt, endsAt time.Time, excludeID uuid.UUID) ([]entity.Conference, error) {
+
+	var conferences []entity.Conference
+
+	err := sqlx.SelectContext(ctx, tx, &conferences, `
+		SELECT
+			c.id, c.title, c.description, c.speaker_name, c.speaker_title,
+			c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at, c.cancellation_cutoff,
+			c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at
 		FROM conferences c
 		WHERE c.deleted_at IS NULL
 		AND c.id != $1
+		AND c.room_id = $2
 		AND c.status = 'approved'
-		AND c.starts_at < $2
-		AND c.ends_at > $3
+		AND c.starts_at < $3
+		AND c.ends_at > $4
 		ORDER BY c.starts_at
 		LIMIT 10
-		`, excludeID, endsAt, startsAt)
+		`, excludeID, roomID, endsAt, startsAt)
 	if err != nil {
 		return nil, err
 	}
 
 	return conferences, nil
 }
+
+func (r *conferenceRepository) GetConferencesConflictingWithTime(ctx context.Context, roomID uuid.UUID,
+	startsAt, endsAt time.Time, excludeID uuid.UUID) ([]entity.Conference, error) {
+
+	return r.getConferencesConflictingWithTime(ctx, r.db, roomID, startsAt, endsAt, excludeID)
+}
+
+func (r *conferenceRepository) createReview(ctx context.Context, tx sqlx.ExtContext,
+	review *entity.ConferenceReview) error {
+
+	query := `INSERT INTO conference_reviews (id, conference_id, reviewer_id, status, reason, notes)
+		VALUES (:id, :conference_id, :reviewer_id, :status, :reason, :notes)`
+	_, err := sqlx.NamedExecContext(ctx, tx, query, review)
+	return err
+}
+
+func (r *conferenceRepository) ReviewConference(ctx context.Context, conference *entity.Conference,
+	review *entity.ConferenceReview, audit *entity.ConferenceAudit) error {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err = r.updateConference(ctx, tx, conference); err != nil {
+		return err
+	}
+
+	if err = r.createReview(ctx, tx, review); err != nil {
+		return err
+	}
+
+	if err = r.createAudit(ctx, tx, audit); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *conferenceRepository) GetLatestReview(ctx context.Context,
+	conferenceID uuid.UUID) (*entity.ConferenceReview, error) {
+
+	var review entity.ConferenceReview
+	err := r.db.GetContext(ctx, &review, `
+		SELECT id, conference_id, reviewer_id, status, reason, notes, created_at
+		FROM conference_reviews
+		WHERE conference_id = $1
+		ORDER BY id DESC
+		LIMIT 1`, conferenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (r *conferenceRepository) GetReviewsByConference(ctx context.Context,
+	conferenceID uuid.UUID) ([]entity.ConferenceReview, error) {
+
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT cr.id, cr.conference_id, cr.reviewer_id, cr.status, cr.reason, cr.notes, cr.created_at,
+			u.name AS reviewer_name
+		FROM conference_reviews cr
+		JOIN users u ON cr.reviewer_id = u.id
+		WHERE cr.conference_id = $1
+		ORDER BY cr.id ASC`, conferenceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query conference reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []entity.ConferenceReview
+	for rows.Next() {
+		var row struct {
+			entity.ConferenceReview
+			ReviewerName string `db:"reviewer_name"`
+		}
+
+		if err = rows.StructScan(&row); err != nil {
+			return nil, fmt.Errorf("failed to scan conference review: %w", err)
+		}
+
+		review := row.ConferenceReview
+		review.Reviewer = &entity.User{
+			ID:   review.ReviewerID,
+			Name: row.ReviewerName,
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating conference reviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// createAudit records a change next to the change itself. A nil audit means there was nothing to record.
+func (r *conferenceRepository) createAudit(ctx context.Context, tx sqlx.ExtContext,
+	audit *entity.ConferenceAudit) error {
+
+	if audit == nil {
+		return nil
+	}
+
+	query := `INSERT INTO conference_audit (id, conference_id, actor_id, action, previous_values, new_values)
+		VALUES (:id, :conference_id, :actor_id, :action, :previous_values, :new_values)`
+	_, err := sqlx.NamedExecContext(ctx, tx, query, audit)
+	return err
+}
+
+func (r *conferenceRepository) GetAuditsByConference(ctx context.Context,
+	conferenceID uuid.UUID) ([]entity.ConferenceAudit, error) {
+
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT ca.id, ca.conference_id, ca.actor_id, ca.action, ca.previous_values, ca.new_values, ca.created_at,
+			u.name AS actor_name
+		FROM conference_audit ca
+		LEFT JOIN users u ON ca.actor_id = u.id
+		WHERE ca.conference_id = $1
+		ORDER BY ca.id ASC`, conferenceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query conference audit: %w", err)
+	}
+	defer rows.Close()
+
+	var audits []entity.ConferenceAudit
+	for rows.Next() {
+		var row struct {
+			entity.ConferenceAudit
+			ActorName *string `db:"actor_name"`
+		}
+
+		if err = rows.StructScan(&row); err != nil {
+			return nil, fmt.Errorf("failed to scan conference audit: %w", err)
+		}
+
+		audit := row.ConferenceAudit
+		if audit.ActorID != nil && row.ActorName != nil {
+			audit.Actor = &entity.User{
+				ID:   *audit.ActorID,
+				Name: *row.ActorName,
+			}
+		}
+		audits = append(audits, audit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating conference audit: %w", err)
+	}
+
+	return audits, nil
+}
+
+func (r *conferenceRepository) CreateReschedule(ctx context.Context, reschedule *entity.ConferenceReschedule) error {
+	query := `INSERT INTO conference_reschedules (
+			id, conference_id, requester_id, starts_at, ends_at, previous_starts_at, previous_ends_at, status
+		) VALUES (
+			:id, :conference_id, :requester_id, :starts_at, :ends_at, :previous_starts_at, :previous_ends_at, :status
+		)`
+	_, err := sqlx.NamedExecContext(ctx, r.db, query, reschedule)
+	return err
+}
+
+func (r *conferenceRepository) GetRescheduleByID(ctx context.Context,
+	id uuid.UUID) (*entity.ConferenceReschedule, error) {
+
+	var reschedule entity.ConferenceReschedule
+	err := r.db.GetContext(ctx, &reschedule, `
+		SELECT id, conference_id, requester_id, starts_at, ends_at, previous_starts_at, previous_ends_at,
+			status, reviewer_id, notes, created_at, reviewed_at
+		FROM conference_reschedules
+		WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reschedule, nil
+}
+
+func (r *conferenceRepository) GetReschedulesByConference(ctx context.Context,
+	conferenceID uuid.UUID) ([]entity.ConferenceReschedule, error) {
+
+	var reschedules []entity.ConferenceReschedule
+	err := r.db.SelectContext(ctx, &reschedules, `
+		SELECT id, conference_id, requester_id, starts_at, ends_at, previous_starts_at, previous_ends_at,
+			status, reviewer_id, notes, created_at, reviewed_at
+		FROM conference_reschedules
+		WHERE conference_id = $1
+		ORDER BY id ASC`, conferenceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query conference reschedules: %w", err)
+	}
+
+	return reschedules, nil
+}
+
+func (r *conferenceRepository) reviewReschedule(ctx context.Context, tx sqlx.ExtContext,
+	reschedule *entity.ConferenceReschedule) error {
+
+	// Only a pending request can be reviewed, so two coordinators can't review the same one
+	res, err := sqlx.NamedExecContext(
+		ctx,
+		tx,
+		`UPDATE conference_reschedules
+		SET status = :status,
+			reviewer_id = :reviewer_id,
+			notes = :notes,
+			reviewed_at = :reviewed_at
+		WHERE id = :id
+		AND status = 'pending'`,
+		reschedule,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// moveConference saves the new time window of conference if it is still free in its room.
+func (r *conferenceRepository) moveConference(ctx context.Context, tx sqlx.ExtContext,
+	conference *entity.Conference) ([]entity.Conference, error) {
+
+	// Row lock on the conference keeps a cancellation from slipping in between the checks and the update
+	var current entity.Conference
+	err := sqlx.GetContext(ctx, tx, &current, `
+		SELECT status, starts_at, room_id
+		FROM conferences
+		WHERE id = $1
+		AND deleted_at IS NULL
+		FOR UPDATE
+		`, conference.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, contract.ErrConferenceNotApproved
+		}
+		return nil, err
+	}
+
+	if current.Status != enum.ConferenceApproved {
+		return nil, contract.ErrConferenceNotApproved
+	}
+
+	if current.StartsAt.Before(time.Now()) {
+		return nil, contract.ErrConferenceStarted
+	}
+
+	// Row lock on the room serializes every move into it, so two overlapping moves can't both pass the check
+	if _, err = tx.ExecContext(ctx, `SELECT id FROM rooms WHERE id = $1 FOR UPDATE`, current.RoomID); err != nil {
+		return nil, err
+	}
+
+	conflicts, err := r.getConferencesConflictingWithTime(ctx, tx, current.RoomID, conference.StartsAt,
+		conference.EndsAt, conference.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conflicts) > 0 {
+		return conflicts, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE conferences
+		SET starts_at = $1,
+			ends_at = $2,
+			updated_at = now()
+		WHERE id = $3
+		`, conference.StartsAt, conference.EndsAt, conference.ID)
+	return nil, err
+}
+
+func (r *conferenceRepository) ReviewReschedule(ctx context.Context, reschedule *entity.ConferenceReschedule,
+	conference *entity.Conference, audit *entity.ConferenceAudit) ([]entity.Conference, error) {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err = r.reviewReschedule(ctx, tx, reschedule); err != nil {
+		return nil, err
+	}
+
+	if conference != nil {
+		conflicts, err2 := r.moveConference(ctx, tx, conference)
+		if err2 != nil || len(conflicts) > 0 {
+			return conflicts, err2
+		}
+	}
+
+	if err = r.createAudit(ctx, tx, audit); err != nil {
+		return nil, err
+	}
+
+	return nil, tx.Commit()
+}
+
+func (r *conferenceRepository) createSpeakers(ctx context.Context, tx sqlx.ExtContext,
+	speakers []entity.ConferenceSpeaker) error {
+
+	for _, speaker := range speakers {
+		_, err := sqlx.NamedExecContext(ctx, tx,
+			`INSERT INTO conference_speakers (conference_id, position, user_id, name, title, bio)
+			VALUES (:conference_id, :position, :user_id, :name, :title, :bio)`,
+			speaker,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *conferenceRepository) GetSpeakersByConferences(ctx context.Context,
+	conferenceIDs []uuid.UUID) ([]entity.ConferenceSpeaker, error) {
+
+	var rows []dto.ConferenceSpeakerJoinUserRow
+
+	// Speakers whose account was deleted are left out
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT
+			s.conference_id, s.position, s.user_id, s.name, s.title, s.bio,
+			u.name AS user_name, u.bio AS user_bio
+		FROM conference_speakers s
+		LEFT JOIN users u ON s.user_id = u.id
+		WHERE s.conference_id = ANY($1)
+		AND (s.user_id IS NULL OR u.deleted_at IS NULL)
+		ORDER BY s.conference_id, s.position
+		`, conferenceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	speakers := make([]entity.ConferenceSpeaker, len(rows))
+	for i, row := range rows {
+		speakers[i] = row.ToEntity()
+	}
+
+	return speakers, nil
+}
+
+func (r *conferenceRepository) ReplaceSpeakers(ctx context.Context, conference *entity.Conference,
+	audit *entity.ConferenceAudit) error {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM conference_speakers WHERE conference_id = $1`,
+		conference.ID); err != nil {
+		return err
+	}
+
+	if err = r.createSpeakers(ctx, tx, conference.Speakers); err != nil {
+		return err
+	}
+
+	if _, err = sqlx.NamedExecContext(ctx, tx, `
+		UPDATE conferences
+		SET speaker_name = :speaker_name,
+			speaker_title = :speaker_title,
+			updated_at = now()
+		WHERE id = :id
+		`, conference); err != nil {
+		return err
+	}
+
+	if err = r.createAudit(ctx, tx, audit); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *conferenceRepository) GetConferencesBySpeaker(ctx context.Context, userID uuid.UUID,
+	includePast bool, lazy dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error) {
+
+	args := []interface{}{userID, includePast}
+
+	query := `
+		SELECT
+			c.id, c.title, c.description, c.speaker_name, c.speaker_title,
+			c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at, c.cancellation_cutoff,
+			c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at, u.name AS host_name,
+			rm.name AS room_name, rm.track AS room_track, e.name AS event_name,
+			COUNT(r.user_id) AS registration_count,
+			(SELECT COUNT(*) FROM waitlist_entries w JOIN users wu ON w.user_id = wu.id
+				WHERE w.conference_id = c.id AND wu.deleted_at IS NULL) AS waitlist_count
+		FROM conferences c
+		JOIN conference_speakers s ON c.id = s.conference_id AND s.user_id = $1
+		JOIN users u ON c.host_id = u.id
+		JOIN rooms rm ON c.room_id = rm.id
+		JOIN events e ON c.event_id = e.id
+		LEFT JOIN registrations r ON c.id = r.conference_id AND r.cancelled_at IS NULL
+		WHERE c.deleted_at IS NULL
+		AND c.status = 'approved'
+		AND ($2 OR c.ends_at > now())`
+
+	// Add pagination filters
+	if lazy.AfterID != uuid.Nil {
+		args = append(args, lazy.AfterID)
+		query += fmt.Sprintf(`
+		AND (c.starts_at, c.id) > (SELECT starts_at, id FROM conferences WHERE id = $%d)`, len(args))
+	}
+	if lazy.BeforeID != uuid.Nil {
+		args = append(args, lazy.BeforeID)
+		query += fmt.Sprintf(`
+		AND (c.starts_at, c.id) < (SELECT starts_at, id FROM conferences WHERE id = $%d)`, len(args))
+	}
+
+	query += `
+		GROUP BY
+			c.id, c.title, c.description, c.speaker_name, c.speaker_title,
+			c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at, c.cancellation_cutoff,
+			c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at, u.name, rm.name, rm.track, e.name`
+
+	// Add ordering and limit
+	if lazy.BeforeID != uuid.Nil {
+		query += " ORDER BY c.starts_at DESC, c.id DESC"
+	} else {
+		query += " ORDER BY c.starts_at ASC, c.id ASC"
+	}
+	args = append(args, lazy.Limit+1) // Request one extra record to determine if there are more results
+	query += fmt.Sprintf(" LIMIT $%d", len(args))
+
+	var rows []dto.ConferenceJoinUserRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, dto.LazyLoadResponse{}, fmt.Errorf("failed to query speaker conferences: %w", err)
+	}
+
+	conferences := make([]entity.Conference, len(rows))
+	for i, row := range rows {
+		conferences[i] = row.ToEntity()
+	}
+
+	lazyResp := dto.LazyLoadResponse{}
+
+	if len(conferences) > 0 {
+		// Check if we got an extra record
+		if len(conferences) > lazy.Limit {
+			lazyResp.HasMore = true
+			conferences = conferences[:lazy.Limit]
+		}
+
+		// For BeforeID, reverse the final result set to maintain ascending order
+		if lazy.BeforeID != uuid.Nil {
+			for i := 0; i < len(conferences)/2; i++ {
+				j := len(conferences) - 1 - i
+				conferences[i], conferences[j] = conferences[j], conferences[i]
+			}
+		}
+
+		lazyResp.FirstID = conferences[0].ID
+		lazyResp.LastID = conferences[len(conferences)-1].ID
+	}
+
+	return conferences, lazyResp, nil
+}
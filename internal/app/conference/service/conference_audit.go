@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+// Bookkeeping columns change on every write, they would only add noise to the diff
+var unauditedConferenceFields = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+}
+
+// diffConference returns the fields that differ between before and after, keyed by their JSON name.
+func diffConference(before, after *entity.Conference) (previous, current map[string]any) {
+	previous = make(map[string]any)
+	current = make(map[string]any)
+
+	beforeValue := reflect.ValueOf(before).Elem()
+	afterValue := reflect.ValueOf(after).Elem()
+	conferenceType := beforeValue.Type()
+
+	for i := 0; i < conferenceType.NumField(); i++ {
+		field := conferenceType.Field(i)
+		if field.Tag.Get("db") == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" || unauditedConferenceFields[name] {
+			continue
+		}
+
+		oldValue := beforeValue.Field(i).Interface()
+		newValue := afterValue.Field(i).Interface()
+		if auditValuesEqual(oldValue, newValue) {
+			continue
+		}
+
+		previous[name] = oldValue
+		current[name] = newValue
+	}
+
+	return previous, current
+}
+
+// auditValuesEqual compares times by instant, so a value read back from the database in another
+// location doesn't count as a change.
+func auditValuesEqual(a, b any) bool {
+	switch a := a.(type) {
+	case time.Time:
+		return a.Equal(b.(time.Time))
+	case *time.Time:
+		b := b.(*time.Time)
+		if a == nil || b == nil {
+			return a == b
+		}
+		return a.Equal(*b)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// newAudit describes the change from before to after made by the requester. It returns nil when
+// nothing changed.
+func (s *conferenceService) newAudit(ctx context.Context, action enum.ConferenceAuditAction,
+	before, after *entity.Conference) (*entity.ConferenceAudit, error) {
+
+	previous, current := diffConference(before, after)
+	return s.newAuditFromValues(ctx, action, after.ID, previous, current)
+}
+
+// newAuditFromValues records the given changes to the conference made by the requester. It returns nil
+// when nothing changed.
+func (s *conferenceService) newAuditFromValues(ctx context.Context, action enum.ConferenceAuditAction,
+	conferenceID uuid.UUID, previous, current map[string]any) (*entity.ConferenceAudit, error) {
+
+	if len(current) == 0 {
+		return nil, nil
+	}
+
+	id, err := s.uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate audit ID: %w", err)
+	}
+
+	previousJSON, err := json.Marshal(previous)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal previous values: %w", err)
+	}
+
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal new values: %w", err)
+	}
+
+	// Changes made by the system itself have no actor
+	var actorID *uuid.UUID
+	if requesterID, ok := ctx.Value("user.id").(uuid.UUID); ok && requesterID != uuid.Nil {
+		actorID = &requesterID
+	}
+
+	return &entity.ConferenceAudit{
+		ID:             id,
+		ConferenceID:   conferenceID,
+		ActorID:        actorID,
+		Action:         action,
+		PreviousValues: previousJSON,
+		NewValues:      currentJSON,
+		CreatedAt:      time.Now(),
+	}, nil
+}
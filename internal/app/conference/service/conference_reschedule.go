@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/domain/event"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+// checkRescheduleTime validates the new time window of conference, including the conflict check against
+// the other approved conferences in its room.
+func (s *conferenceService) checkRescheduleTime(ctx context.Context, conference *entity.Conference,
+	startsAt, endsAt time.Time) error {
+
+	if startsAt.Before(time.Now()) {
+		return errorpkg.ErrTimeAlreadyPassed
+	}
+
+	if endsAt.Before(startsAt) {
+		return errorpkg.ErrEndTimeBeforeStart
+	}
+
+	if conference.CancellationCutoff != nil && conference.CancellationCutoff.After(startsAt) {
+		return errorpkg.ErrCancellationCutoffAfterStart
+	}
+
+	event, err := s.eventSvc.GetEventByID(ctx, conference.EventID)
+	if err != nil {
+		return err
+	}
+
+	if err = checkEventDates(event, startsAt, endsAt); err != nil {
+		return err
+	}
+
+	conflicts, err := s.r.GetConferencesConflictingWithTime(ctx, conference.RoomID, startsAt, endsAt,
+		conference.ID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":         err,
+			"conference.id": conference.ID,
+			"requester.id":  ctx.Value("user.id"),
+		}, "[ConferenceService][checkRescheduleTime] Failed to get conflicting conferences")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if len(conflicts) > 0 {
+		return timeWindowConflict(conflicts)
+	}
+
+	return nil
+}
+
+// timeWindowConflict lists the conferences already taking the time window.
+func timeWindowConflict(conflicts []entity.Conference) error {
+	resp := make([]dto.ConferenceResponse, len(conflicts))
+	for i, conflict := range conflicts {
+		resp[i] = dto.ConferenceResponse{
+			ID:       conflict.ID,
+			Title:    conflict.Title,
+			StartsAt: &conflict.StartsAt,
+			EndsAt:   &conflict.EndsAt,
+		}
+	}
+
+	return errorpkg.ErrTimeWindowConflict.WithDetail(map[string]interface{}{
+		"conferences": resp,
+	})
+}
+
+func (s *conferenceService) RequestReschedule(ctx context.Context, id uuid.UUID,
+	req dto.RequestRescheduleRequest) (uuid.UUID, error) {
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+
+	conference, err := s.r.GetConferenceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][RequestReschedule] Failed to get conference")
+		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if conference.HostID != requesterID {
+		return uuid.Nil, errorpkg.ErrForbiddenUser
+	}
+
+	// A pending proposal is moved by updating it directly
+	if conference.Status != enum.ConferenceApproved {
+		return uuid.Nil, errorpkg.ErrRescheduleNotApprovedConference
+	}
+
+	if conference.StartsAt.Before(time.Now()) {
+		return uuid.Nil, errorpkg.ErrRescheduleStartedConference
+	}
+
+	if err = s.checkRescheduleTime(ctx, conference, req.StartsAt, req.EndsAt); err != nil {
+		return uuid.Nil, err
+	}
+
+	rescheduleID, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][RequestReschedule] Failed to generate reschedule ID")
+		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	reschedule := entity.ConferenceReschedule{
+		ID:               rescheduleID,
+		ConferenceID:     id,
+		RequesterID:      requesterID,
+		StartsAt:         req.StartsAt,
+		EndsAt:           req.EndsAt,
+		PreviousStartsAt: conference.StartsAt,
+		PreviousEndsAt:   conference.EndsAt,
+		Status:           enum.ReschedulePending,
+	}
+
+	if err = s.r.CreateReschedule(ctx, &reschedule); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "conference_reschedules_conference_id_pending_key" {
+			return uuid.Nil, errorpkg.ErrRescheduleAlreadyRequested
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"reschedule":   reschedule,
+			"requester.id": requesterID,
+		}, "[ConferenceService][RequestReschedule] Failed to create reschedule")
+		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"reschedule":   reschedule,
+		"requester.id": requesterID,
+	}, "[ConferenceService][RequestReschedule] Reschedule requested")
+
+	return rescheduleID, nil
+}
+
+func (s *conferenceService) GetReschedules(ctx context.Context,
+	id uuid.UUID) ([]dto.ConferenceRescheduleResponse, error) {
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+	requesterRole, _ := ctx.Value("user.role").(enum.UserRole)
+
+	conference, err := s.r.GetConferenceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][GetReschedules] Failed to get conference")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if requesterRole == enum.RoleUser && conference.HostID != requesterID {
+		return nil, errorpkg.ErrForbiddenUser
+	}
+
+	if err = s.checkEventCoordinator(ctx, conference.EventID); err != nil {
+		return nil, err
+	}
+
+	reschedules, err := s.r.GetReschedulesByConference(ctx, id)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][GetReschedules] Failed to get reschedules")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.ConferenceRescheduleResponse, len(reschedules))
+	for i, reschedule := range reschedules {
+		resp[i].PopulateFromEntity(&reschedule)
+	}
+
+	return resp, nil
+}
+
+func (s *conferenceService) ReviewReschedule(ctx context.Context, id, rescheduleID uuid.UUID,
+	req dto.ReviewRescheduleRequest) error {
+
+	reviewerID, _ := ctx.Value("user.id").(uuid.UUID)
+
+	reschedule, err := s.r.GetRescheduleByID(ctx, rescheduleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": reviewerID,
+		}, "[ConferenceService][ReviewReschedule] Failed to get reschedule")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if reschedule.ConferenceID != id {
+		return errorpkg.ErrNotFound
+	}
+
+	if reschedule.Status != enum.ReschedulePending {
+		return errorpkg.ErrRescheduleNotPending
+	}
+
+	conference, err := s.r.GetConferenceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": reviewerID,
+		}, "[ConferenceService][ReviewReschedule] Failed to get conference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if err = s.checkEventCoordinator(ctx, conference.EventID); err != nil {
+		return err
+	}
+
+	reviewedAt := time.Now()
+	reschedule.Status = req.Status
+	reschedule.ReviewerID = &reviewerID
+	reschedule.Notes = req.Notes
+	reschedule.ReviewedAt = &reviewedAt
+
+	original := *conference
+	var moved *entity.Conference
+	var audit *entity.ConferenceAudit
+
+	if req.Status == enum.RescheduleApproved {
+		// The schedule may have changed since the host asked, so everything is checked again
+		if conference.Status != enum.ConferenceApproved {
+			return errorpkg.ErrRescheduleNotApprovedConference
+		}
+
+		if conference.StartsAt.Before(time.Now()) {
+			return errorpkg.ErrRescheduleStartedConference
+		}
+
+		if err = s.checkRescheduleTime(ctx, conference, reschedule.StartsAt, reschedule.EndsAt); err != nil {
+			return err
+		}
+
+		conference.StartsAt = reschedule.StartsAt
+		conference.EndsAt = reschedule.EndsAt
+		moved = conference
+
+		audit, err = s.newAudit(ctx, enum.AuditConferenceUpdated, &original, conference)
+		if err != nil {
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error":        err,
+				"requester.id": reviewerID,
+			}, "[ConferenceService][ReviewReschedule] Failed to create audit")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+	}
+
+	// The checks above are repeated under lock while saving, in case the schedule changed meanwhile
+	conflicts, err := s.r.ReviewReschedule(ctx, reschedule, moved, audit)
+	if err != nil {
+		switch {
+		// Another coordinator reviewed it first
+		case errors.Is(err, sql.ErrNoRows):
+			return errorpkg.ErrRescheduleNotPending
+		case errors.Is(err, contract.ErrConferenceNotApproved):
+			return errorpkg.ErrRescheduleNotApprovedConference
+		case errors.Is(err, contract.ErrConferenceStarted):
+			return errorpkg.ErrRescheduleStartedConference
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"reschedule":   reschedule,
+			"requester.id": reviewerID,
+		}, "[ConferenceService][ReviewReschedule] Failed to review reschedule")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if len(conflicts) > 0 {
+		return timeWindowConflict(conflicts)
+	}
+
+	log.Info(map[string]interface{}{
+		"reschedule":   reschedule,
+		"requester.id": reviewerID,
+	}, "[ConferenceService][ReviewReschedule] Reschedule reviewed")
+
+	s.eventBus.Publish(ctx, event.RescheduleReviewed{Conference: *conference, Reschedule: *reschedule})
+
+	if moved != nil {
+		s.eventBus.Publish(ctx, event.ConferenceRescheduled{
+			Conference:       *moved,
+			PreviousStartsAt: original.StartsAt,
+			PreviousEndsAt:   original.EndsAt,
+		})
+	}
+
+	return nil
+}
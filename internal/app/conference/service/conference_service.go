@@ -13,19 +13,94 @@ import (
 	"github.com/nathakusuma/conference-backend/domain/entity"
 	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/domain/event"
+	"github.com/nathakusuma/conference-backend/pkg/eventbus"
 	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/nathakusuma/conference-backend/pkg/metrics"
 	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
 )
 
 type conferenceService struct {
-	r    contract.IConferenceRepository
-	uuid uuidpkg.IUUID
+	r        contract.IConferenceRepository
+	roomSvc  contract.IRoomService
+	eventSvc contract.IEventService
+	userSvc  contract.IUserService
+	uuid     uuidpkg.IUUID
+	eventBus eventbus.IEventBus
 }
 
-func NewConferenceService(conferenceRepo contract.IConferenceRepository,
-	uuid uuidpkg.IUUID) contract.IConferenceService {
+func NewConferenceService(conferenceRepo contract.IConferenceRepository, roomSvc contract.IRoomService,
+	eventSvc contract.IEventService, userSvc contract.IUserService, uuid uuidpkg.IUUID,
+	eventBus eventbus.IEventBus) contract.IConferenceService {
 
-	return &conferenceService{r: conferenceRepo, uuid: uuid}
+	return &conferenceService{r: conferenceRepo, roomSvc: roomSvc, eventSvc: eventSvc, userSvc: userSvc, uuid: uuid,
+		eventBus: eventBus}
+}
+
+// checkRoomCapacity makes sure the room exists and can hold the seats of a conference.
+func (s *conferenceService) checkRoomCapacity(ctx context.Context, roomID uuid.UUID, seats int) error {
+	room, err := s.roomSvc.GetRoomByID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	if seats > room.Capacity {
+		return errorpkg.ErrSeatsExceedRoomCapacity.WithDetail(map[string]interface{}{
+			"room": room,
+		})
+	}
+
+	return nil
+}
+
+// checkEventDates makes sure a conference takes place within the dates of its event.
+func checkEventDates(event *dto.EventResponse, startsAt, endsAt time.Time) error {
+	if startsAt.Before(*event.StartsAt) || endsAt.After(*event.EndsAt) {
+		return errorpkg.ErrOutsideEventDates.WithDetail(map[string]interface{}{
+			"event": event,
+		})
+	}
+
+	return nil
+}
+
+// checkLeadTime makes sure a proposal leaves the coordinators of its event enough time to review it
+// before it starts.
+func checkLeadTime(event *dto.EventResponse, startsAt time.Time) error {
+	if event.CFPMinLeadHours == nil {
+		return nil
+	}
+
+	earliest := time.Now().Add(time.Duration(*event.CFPMinLeadHours) * time.Hour)
+	if startsAt.Before(earliest) {
+		return errorpkg.ErrCFPLeadTimeTooShort.WithDetail(map[string]interface{}{
+			"min_lead_hours":     *event.CFPMinLeadHours,
+			"earliest_starts_at": earliest,
+		})
+	}
+
+	return nil
+}
+
+// checkEventCoordinator makes sure an event coordinator only manages the conferences of the events they
+// coordinate. Other roles are left to the caller.
+func (s *conferenceService) checkEventCoordinator(ctx context.Context, eventID uuid.UUID) error {
+	requesterRole, _ := ctx.Value("user.role").(enum.UserRole)
+	if requesterRole != enum.RoleEventCoordinator {
+		return nil
+	}
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+	ok, err := s.eventSvc.IsEventCoordinator(ctx, eventID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errorpkg.ErrNotEventCoordinator
+	}
+
+	return nil
 }
 
 func (s *conferenceService) CreateConferenceProposal(ctx context.Context,
@@ -41,32 +116,70 @@ func (s *conferenceService) CreateConferenceProposal(ctx context.Context,
 		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
-	// Check if user has active proposal
-	userConferences, _, err := s.GetConferences(ctx, &dto.GetConferenceQuery{
-		Limit:       1,
-		HostID:      &requesterID,
-		Status:      enum.ConferencePending,
-		IncludePast: false,
-		OrderBy:     "created_at",
-		Order:       "desc",
-	})
+	// Check if user has reached their quota of active proposals
+	quota, err := s.userSvc.GetProposalQuota(ctx, requesterID)
 	if err != nil {
 		return uuid.Nil, err
 	}
 
-	if len(userConferences) > 0 {
-		conflict := userConferences[0]
+	activeProposals, err := s.r.GetActiveProposalsByHost(ctx, requesterID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][CreateConferenceProposal] Failed to get active proposals")
+		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if len(activeProposals) >= quota.Quota {
+		resp := make([]dto.ConferenceResponse, len(activeProposals))
+		for i, proposal := range activeProposals {
+			resp[i] = dto.ConferenceResponse{
+				ID:        proposal.ID,
+				Title:     proposal.Title,
+				Status:    proposal.Status,
+				CreatedAt: &proposal.CreatedAt,
+			}
+		}
+
 		return uuid.Nil, errorpkg.ErrUserHasActiveProposal.WithDetail(map[string]interface{}{
-			"conference": dto.ConferenceResponse{
-				ID:        conflict.ID,
-				Title:     conflict.Title,
-				Status:    conflict.Status,
-				CreatedAt: conflict.CreatedAt,
-			}})
+			"quota":       quota.Quota,
+			"conferences": resp,
+		})
 	}
 
-	// Check if there is a conference in the same time window
-	conflicts, err := s.r.GetConferencesConflictingWithTime(ctx, req.StartsAt, req.EndsAt, uuid.Nil)
+	event, err := s.eventSvc.GetEventByID(ctx, req.EventID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	now := time.Now()
+	if now.Before(*event.CFPOpensAt) {
+		return uuid.Nil, errorpkg.ErrCFPNotOpenYet.WithDetail(map[string]interface{}{
+			"event": event,
+		})
+	}
+
+	if now.After(*event.CFPClosesAt) {
+		return uuid.Nil, errorpkg.ErrCFPClosed.WithDetail(map[string]interface{}{
+			"event": event,
+		})
+	}
+
+	if err = checkEventDates(event, req.StartsAt, req.EndsAt); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = checkLeadTime(event, req.StartsAt); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = s.checkRoomCapacity(ctx, req.RoomID, req.Seats); err != nil {
+		return uuid.Nil, err
+	}
+
+	// Check if there is a conference in the same room and time window
+	conflicts, err := s.r.GetConferencesConflictingWithTime(ctx, req.RoomID, req.StartsAt, req.EndsAt, uuid.Nil)
 	if err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":        err,
@@ -111,21 +224,40 @@ func (s *conferenceService) CreateConferenceProposal(ctx context.Context,
 		return uuid.Nil, errorpkg.ErrEndTimeBeforeStart
 	}
 
+	if req.CancellationCutoff != nil && req.CancellationCutoff.After(req.StartsAt) {
+		return uuid.Nil, errorpkg.ErrCancellationCutoffAfterStart
+	}
+
 	conference := entity.Conference{
-		ID:             conferenceID,
-		Title:          req.Title,
-		Description:    req.Description,
-		SpeakerName:    req.SpeakerName,
-		SpeakerTitle:   req.SpeakerTitle,
-		TargetAudience: req.TargetAudience,
-		Prerequisites:  req.Prerequisites,
-		Seats:          req.Seats,
-		StartsAt:       req.StartsAt,
-		EndsAt:         req.EndsAt,
-		HostID:         requesterID,
-		Status:         enum.ConferencePending,
+		ID:                 conferenceID,
+		Title:              req.Title,
+		Description:        req.Description,
+		SpeakerName:        req.SpeakerName,
+		SpeakerTitle:       req.SpeakerTitle,
+		TargetAudience:     req.TargetAudience,
+		Prerequisites:      req.Prerequisites,
+		Seats:              req.Seats,
+		StartsAt:           req.StartsAt,
+		EndsAt:             req.EndsAt,
+		CancellationCutoff: req.CancellationCutoff,
+		HostID:             requesterID,
+		RoomID:             req.RoomID,
+		EventID:            req.EventID,
+		Status:             enum.ConferencePending,
 	}
 
+	// Without a list of speakers, the speaker named in the proposal is its only one
+	speakerReqs := req.Speakers
+	if len(speakerReqs) == 0 {
+		speakerReqs = []dto.ConferenceSpeakerRequest{{Name: &req.SpeakerName, Title: &req.SpeakerTitle}}
+	}
+
+	conference.Speakers, err = s.newSpeakers(ctx, conferenceID, speakerReqs)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	setHeadlineSpeaker(&conference)
+
 	if err = s.r.CreateConference(ctx, &conference); err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":        err,
@@ -135,6 +267,8 @@ func (s *conferenceService) CreateConferenceProposal(ctx context.Context,
 		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	metrics.ProposalsCreated.Inc()
+
 	log.Info(map[string]interface{}{
 		"conference":   conference,
 		"requester.id": requesterID,
@@ -162,13 +296,62 @@ func (s *conferenceService) GetConferenceByID(ctx context.Context, id uuid.UUID)
 
 	isRestrictedUser := requesterRole == enum.RoleUser && conference.HostID != requesterID
 
-	if conference.Status != enum.ConferenceApproved && isRestrictedUser {
-		return nil, errorpkg.ErrForbiddenUser
+	if isRestrictedUser && conference.Status != enum.ConferenceApproved {
+		if conference.Status != enum.ConferenceCancelled {
+			return nil, errorpkg.ErrForbiddenUser
+		}
+
+		// A cancelled conference stays visible to its registrants so they can see what happened to it
+		isRegistered, err2 := s.r.IsUserRegisteredToConference(ctx, id, requesterID)
+		if err2 != nil {
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error":        err2.Error(),
+				"requester.id": requesterID,
+			}, "[ConferenceService][GetConferenceByID] Failed to check registration")
+			return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+		if !isRegistered {
+			return nil, errorpkg.ErrForbiddenUser
+		}
+	}
+
+	conference.Speakers, err = s.r.GetSpeakersByConferences(ctx, []uuid.UUID{id})
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err.Error(),
+			"requester.id": requesterID,
+		}, "[ConferenceService][GetConferenceByID] Failed to get speakers")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
 	var resp dto.ConferenceResponse
 	resp.PopulateFromEntity(conference)
 
+	// Waitlist length is only visible to the host and staff
+	if !isRestrictedUser {
+		resp.WaitlistLength = &conference.WaitlistCount
+	}
+
+	// The host sees why their proposal was approved or rejected, staff see the whole history instead.
+	// Everyone sees why a conference was cancelled.
+	isHost := requesterID != uuid.Nil && conference.HostID == requesterID
+	if isHost || conference.Status == enum.ConferenceCancelled {
+		review, err2 := s.r.GetLatestReview(ctx, id)
+		if err2 != nil && !errors.Is(err2, sql.ErrNoRows) {
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error":        err2.Error(),
+				"requester.id": requesterID,
+			}, "[ConferenceService][GetConferenceByID] Failed to get latest review")
+			return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+		if review != nil && isHost {
+			resp.LatestReview = new(dto.ConferenceReviewResponse).PopulateFromEntity(review)
+		}
+		if review != nil && review.Status == enum.ConferenceCancelled {
+			resp.CancellationReason = review.Notes
+		}
+	}
+
 	return &resp, nil
 }
 
@@ -182,8 +365,11 @@ func (s *conferenceService) GetConferences(ctx context.Context,
 	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
 	requesterRole, _ := ctx.Value("user.role").(enum.UserRole)
 
-	// If requester is system, it will not enter this block because requesterRole is empty
-	if query.Status != enum.ConferenceApproved && requesterRole == enum.RoleUser {
+	// If requester is system, it will not enter these blocks because requesterRole is empty
+	if query.Status == enum.ConferenceCancelled && requesterRole == enum.RoleUser {
+		// Users only see the cancelled conferences they host or are registered to
+		query.HostOrRegistrantID = &requesterID
+	} else if query.Status != enum.ConferenceApproved && requesterRole == enum.RoleUser {
 		if query.HostID == nil {
 			query.HostID = &requesterID
 		} else if *query.HostID != requesterID {
@@ -200,9 +386,22 @@ func (s *conferenceService) GetConferences(ctx context.Context,
 		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	if err = s.attachSpeakers(ctx, conferences); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err.Error(),
+			"requester.id": requesterID,
+		}, "[ConferenceService][GetConferences] Failed to get speakers")
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
 	resp := make([]dto.ConferenceResponse, len(conferences))
 	for i, conference := range conferences {
 		resp[i].PopulateFromEntity(&conference)
+
+		// Waitlist length is only visible to the host and staff
+		if requesterRole != enum.RoleUser || conference.HostID == requesterID {
+			resp[i].WaitlistLength = &conference.WaitlistCount
+		}
 	}
 
 	return resp, lazy, nil
@@ -241,8 +440,14 @@ func (s *conferenceService) UpdateConference(ctx context.Context, id uuid.UUID,
 		return errorpkg.ErrUpdateNotPendingConference
 	}
 
-	// Check if there is a conference in the same time window
-	if req.StartsAt != nil || req.EndsAt != nil {
+	if req.RoomID != nil {
+		if err = s.checkRoomCapacity(ctx, conference.RoomID, conference.Seats); err != nil {
+			return err
+		}
+	}
+
+	// Check if there is a conference in the same room and time window
+	if req.StartsAt != nil || req.EndsAt != nil || req.RoomID != nil {
 		if conference.StartsAt.Before(time.Now()) {
 			return errorpkg.ErrTimeAlreadyPassed
 		}
@@ -251,8 +456,26 @@ func (s *conferenceService) UpdateConference(ctx context.Context, id uuid.UUID,
 			return errorpkg.ErrEndTimeBeforeStart
 		}
 
-		// Check if there is a conference in the same time window
-		conflicts, err := s.r.GetConferencesConflictingWithTime(ctx, *req.StartsAt, *req.EndsAt, id)
+		if req.StartsAt != nil || req.EndsAt != nil {
+			event, err2 := s.eventSvc.GetEventByID(ctx, conference.EventID)
+			if err2 != nil {
+				return err2
+			}
+
+			if err = checkEventDates(event, conference.StartsAt, conference.EndsAt); err != nil {
+				return err
+			}
+
+			// Moving a proposal closer must not get around the lead time
+			if conference.StartsAt.Before(original.StartsAt) {
+				if err = checkLeadTime(event, conference.StartsAt); err != nil {
+					return err
+				}
+			}
+		}
+
+		conflicts, err := s.r.GetConferencesConflictingWithTime(ctx, conference.RoomID, conference.StartsAt,
+			conference.EndsAt, id)
 		if err != nil {
 			traceID := log.ErrorWithTraceID(map[string]interface{}{
 				"error":        err,
@@ -278,8 +501,21 @@ func (s *conferenceService) UpdateConference(ctx context.Context, id uuid.UUID,
 		}
 	}
 
+	if conference.CancellationCutoff != nil && conference.CancellationCutoff.After(conference.StartsAt) {
+		return errorpkg.ErrCancellationCutoffAfterStart
+	}
+
+	audit, err := s.newAudit(ctx, enum.AuditConferenceUpdated, original, &conference)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][UpdateConference] Failed to create audit")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
 	// update conference
-	if err = s.r.UpdateConference(ctx, &conference); err != nil {
+	if err = s.r.UpdateConference(ctx, &conference, audit); err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":        err,
 			"requester.id": requesterID,
@@ -292,6 +528,14 @@ func (s *conferenceService) UpdateConference(ctx context.Context, id uuid.UUID,
 		"requester.id": requesterID,
 	}, "[ConferenceService][UpdateConference] Conference updated")
 
+	if !conference.StartsAt.Equal(original.StartsAt) || !conference.EndsAt.Equal(original.EndsAt) {
+		s.eventBus.Publish(ctx, event.ConferenceRescheduled{
+			Conference:       conference,
+			PreviousStartsAt: original.StartsAt,
+			PreviousEndsAt:   original.EndsAt,
+		})
+	}
+
 	return nil
 }
 
@@ -316,7 +560,29 @@ func (s *conferenceService) DeleteConference(ctx context.Context, id uuid.UUID)
 		return errorpkg.ErrForbiddenUser
 	}
 
-	if err = s.r.DeleteConference(ctx, id); err != nil {
+	if err = s.checkEventCoordinator(ctx, conference.EventID); err != nil {
+		return err
+	}
+
+	// Registrants of an approved conference must be told, so it can only be cancelled, not deleted
+	if conference.Status == enum.ConferenceApproved || conference.Status == enum.ConferenceCancelled {
+		return errorpkg.ErrDeleteApprovedConference
+	}
+
+	deleted := *conference
+	deletedAt := time.Now()
+	deleted.DeletedAt = &deletedAt
+
+	audit, err := s.newAudit(ctx, enum.AuditConferenceDeleted, conference, &deleted)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][DeleteConference] Failed to create audit")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if err = s.r.DeleteConference(ctx, id, audit); err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":        err,
 			"requester.id": ctx.Value("user.id"),
@@ -332,8 +598,98 @@ func (s *conferenceService) DeleteConference(ctx context.Context, id uuid.UUID)
 	return nil
 }
 
+func (s *conferenceService) CancelConference(ctx context.Context, id uuid.UUID,
+	req dto.CancelConferenceRequest) error {
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+	requesterRole, _ := ctx.Value("user.role").(enum.UserRole)
+
+	conference, err := s.r.GetConferenceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][CancelConference] Failed to get conference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if requesterRole == enum.RoleUser && conference.HostID != requesterID {
+		return errorpkg.ErrForbiddenUser
+	}
+
+	if err = s.checkEventCoordinator(ctx, conference.EventID); err != nil {
+		return err
+	}
+
+	if conference.Status != enum.ConferenceApproved {
+		return errorpkg.ErrCancelNotApprovedConference
+	}
+
+	if conference.StartsAt.Before(time.Now()) {
+		return errorpkg.ErrCancelStartedConference
+	}
+
+	reviewID, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][CancelConference] Failed to generate review ID")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	// The cancellation is kept in the review history, with the reason as its notes
+	review := entity.ConferenceReview{
+		ID:           reviewID,
+		ConferenceID: id,
+		ReviewerID:   requesterID,
+		Status:       enum.ConferenceCancelled,
+		Notes:        &req.Reason,
+		CreatedAt:    time.Now(),
+	}
+
+	original := *conference
+	conference.Status = enum.ConferenceCancelled
+
+	audit, err := s.newAudit(ctx, enum.AuditConferenceStatusChanged, &original, conference)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][CancelConference] Failed to create audit")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if err = s.r.ReviewConference(ctx, conference, &review, audit); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][CancelConference] Failed to cancel conference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	metrics.ConferenceStatusChanges.WithLabelValues(string(enum.ConferenceCancelled)).Inc()
+
+	log.Info(map[string]interface{}{
+		"conference":   conference,
+		"review":       review,
+		"requester.id": requesterID,
+	}, "[ConferenceService][CancelConference] Conference cancelled")
+
+	s.eventBus.Publish(ctx, event.ConferenceCancelled{Conference: *conference, Reason: req.Reason})
+
+	return nil
+}
+
 func (s *conferenceService) UpdateConferenceStatus(ctx context.Context, id uuid.UUID,
-	status enum.ConferenceStatus) error {
+	req dto.UpdateConferenceStatusRequest) error {
+
+	reviewerID, _ := ctx.Value("user.id").(uuid.UUID)
+	status := req.Status
 
 	conference, err := s.r.GetConferenceByID(ctx, id)
 	if err != nil {
@@ -348,6 +704,10 @@ func (s *conferenceService) UpdateConferenceStatus(ctx context.Context, id uuid.
 		return errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	if err = s.checkEventCoordinator(ctx, conference.EventID); err != nil {
+		return err
+	}
+
 	if conference.Status != enum.ConferencePending {
 		return errorpkg.ErrUpdateNotPendingConference
 	}
@@ -359,7 +719,8 @@ func (s *conferenceService) UpdateConferenceStatus(ctx context.Context, id uuid.
 
 	if status == enum.ConferenceApproved {
 		// Check for time conflicts only when approving
-		conflicts, err2 := s.r.GetConferencesConflictingWithTime(ctx, conference.StartsAt, conference.EndsAt, id)
+		conflicts, err2 := s.r.GetConferencesConflictingWithTime(ctx, conference.RoomID, conference.StartsAt,
+			conference.EndsAt, id)
 		if err2 != nil {
 			traceID := log.ErrorWithTraceID(map[string]interface{}{
 				"error":        err2,
@@ -385,10 +746,45 @@ func (s *conferenceService) UpdateConferenceStatus(ctx context.Context, id uuid.
 		}
 	}
 
+	reviewID, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": ctx.Value("user.id"),
+		}, "[ConferenceService][UpdateConferenceStatus] Failed to generate review ID")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	// A reason only explains a rejection
+	reason := req.Reason
+	if status != enum.ConferenceRejected {
+		reason = nil
+	}
+
+	review := entity.ConferenceReview{
+		ID:           reviewID,
+		ConferenceID: id,
+		ReviewerID:   reviewerID,
+		Status:       status,
+		Reason:       reason,
+		Notes:        req.Notes,
+		CreatedAt:    time.Now(),
+	}
+
 	// update conference status
+	original := *conference
 	conference.Status = status
 
-	if err = s.r.UpdateConference(ctx, conference); err != nil {
+	audit, err := s.newAudit(ctx, enum.AuditConferenceStatusChanged, &original, conference)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": ctx.Value("user.id"),
+		}, "[ConferenceService][UpdateConferenceStatus] Failed to create audit")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if err = s.r.ReviewConference(ctx, conference, &review, audit); err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":        err,
 			"requester.id": ctx.Value("user.id"),
@@ -396,10 +792,104 @@ func (s *conferenceService) UpdateConferenceStatus(ctx context.Context, id uuid.
 		return errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	metrics.ConferenceStatusChanges.WithLabelValues(string(status)).Inc()
+
 	log.Info(map[string]interface{}{
 		"conference":   conference,
+		"review":       review,
 		"requester.id": ctx.Value("user.id"),
 	}, fmt.Sprintf("[ConferenceService][UpdateConferenceStatus] Conference status updated to %s", status))
 
+	switch status {
+	case enum.ConferenceApproved:
+		s.eventBus.Publish(ctx, event.ConferenceApproved{Conference: *conference, Review: review})
+	case enum.ConferenceRejected:
+		s.eventBus.Publish(ctx, event.ConferenceRejected{Conference: *conference, Review: review})
+	}
+
 	return nil
 }
+
+func (s *conferenceService) GetConferenceReviews(ctx context.Context,
+	id uuid.UUID) ([]dto.ConferenceReviewResponse, error) {
+
+	conference, err := s.r.GetConferenceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": ctx.Value("user.id"),
+		}, "[ConferenceService][GetConferenceReviews] Failed to get conference")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if err = s.checkEventCoordinator(ctx, conference.EventID); err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.r.GetReviewsByConference(ctx, id)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":         err,
+			"conference.id": id,
+			"requester.id":  ctx.Value("user.id"),
+		}, "[ConferenceService][GetConferenceReviews] Failed to get conference reviews")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.ConferenceReviewResponse, len(reviews))
+	for i, review := range reviews {
+		resp[i].PopulateFromEntity(&review)
+	}
+
+	return resp, nil
+}
+
+func (s *conferenceService) GetConferenceHistory(ctx context.Context,
+	id uuid.UUID) ([]dto.ConferenceAuditResponse, error) {
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+	requesterRole, _ := ctx.Value("user.role").(enum.UserRole)
+
+	conference, err := s.r.GetConferenceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][GetConferenceHistory] Failed to get conference")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	// Only the host and staff may see the history
+	if requesterRole == enum.RoleUser && conference.HostID != requesterID {
+		return nil, errorpkg.ErrForbiddenUser
+	}
+
+	if err = s.checkEventCoordinator(ctx, conference.EventID); err != nil {
+		return nil, err
+	}
+
+	audits, err := s.r.GetAuditsByConference(ctx, id)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":         err,
+			"conference.id": id,
+			"requester.id":  requesterID,
+		}, "[ConferenceService][GetConferenceHistory] Failed to get conference audit")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.ConferenceAuditResponse, len(audits))
+	for i, audit := range audits {
+		resp[i].PopulateFromEntity(&audit)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+// newSpeakers turns the requested speakers into those of the conference, in the order given. Linked
+// speakers must be existing users and can only be listed once, guests need a name.
+func (s *conferenceService) newSpeakers(ctx context.Context, conferenceID uuid.UUID,
+	reqs []dto.ConferenceSpeakerRequest) ([]entity.ConferenceSpeaker, error) {
+
+	listed := make(map[uuid.UUID]bool, len(reqs))
+	speakers := make([]entity.ConferenceSpeaker, len(reqs))
+	for i, req := range reqs {
+		// A linked speaker's name comes from their profile
+		if (req.UserID == nil) == (req.Name == nil) {
+			return nil, errorpkg.ErrInvalidSpeaker.WithDetail(map[string]interface{}{
+				"order": i,
+			})
+		}
+
+		speakers[i] = entity.ConferenceSpeaker{
+			ConferenceID: conferenceID,
+			Position:     i,
+			UserID:       req.UserID,
+			Name:         req.Name,
+			Title:        req.Title,
+			Bio:          req.Bio,
+		}
+
+		if req.UserID == nil {
+			continue
+		}
+
+		if listed[*req.UserID] {
+			return nil, errorpkg.ErrSpeakerListedTwice.WithDetail(map[string]interface{}{
+				"user_id": *req.UserID,
+			})
+		}
+		listed[*req.UserID] = true
+
+		user, err := s.userSvc.GetUserByID(ctx, *req.UserID)
+		if err != nil {
+			if errors.Is(err, errorpkg.ErrNotFound) {
+				return nil, errorpkg.ErrSpeakerUserNotFound.WithDetail(map[string]interface{}{
+					"user_id": *req.UserID,
+				})
+			}
+			return nil, err
+		}
+		speakers[i].User = user
+	}
+
+	return speakers, nil
+}
+
+// setHeadlineSpeaker copies the first speaker into speaker_name and speaker_title of conference, which the
+// lists that don't load the speakers still show.
+func setHeadlineSpeaker(conference *entity.Conference) {
+	if len(conference.Speakers) == 0 {
+		return
+	}
+
+	first := conference.Speakers[0]
+	if first.User != nil {
+		conference.SpeakerName = first.User.Name
+	} else if first.Name != nil {
+		conference.SpeakerName = *first.Name
+	}
+
+	conference.SpeakerTitle = ""
+	if first.Title != nil {
+		conference.SpeakerTitle = *first.Title
+	}
+}
+
+// attachSpeakers loads the speakers of all conferences with one query.
+func (s *conferenceService) attachSpeakers(ctx context.Context, conferences []entity.Conference) error {
+	if len(conferences) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(conferences))
+	for i, conference := range conferences {
+		ids[i] = conference.ID
+	}
+
+	speakers, err := s.r.GetSpeakersByConferences(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	byConference := make(map[uuid.UUID][]entity.ConferenceSpeaker, len(conferences))
+	for _, speaker := range speakers {
+		byConference[speaker.ConferenceID] = append(byConference[speaker.ConferenceID], speaker)
+	}
+
+	for i := range conferences {
+		conferences[i].Speakers = byConference[conferences[i].ID]
+	}
+
+	return nil
+}
+
+// withoutUsers drops the loaded profiles, leaving only what is stored for each speaker.
+func withoutUsers(speakers []entity.ConferenceSpeaker) []entity.ConferenceSpeaker {
+	stored := make([]entity.ConferenceSpeaker, len(speakers))
+	for i, speaker := range speakers {
+		speaker.User = nil
+		stored[i] = speaker
+	}
+
+	return stored
+}
+
+func (s *conferenceService) UpdateConferenceSpeakers(ctx context.Context, id uuid.UUID,
+	req dto.UpdateConferenceSpeakersRequest) error {
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+
+	conference, err := s.r.GetConferenceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][UpdateConferenceSpeakers] Failed to get conference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if conference.HostID != requesterID {
+		return errorpkg.ErrForbiddenUser
+	}
+
+	// Speakers are part of the proposal, so they're settled once it's reviewed like the rest of it
+	if conference.EndsAt.Before(time.Now()) {
+		return errorpkg.ErrUpdatePastConference
+	}
+
+	if conference.Status != enum.ConferencePending {
+		return errorpkg.ErrUpdateNotPendingConference
+	}
+
+	current, err := s.r.GetSpeakersByConferences(ctx, []uuid.UUID{id})
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][UpdateConferenceSpeakers] Failed to get speakers")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	speakers, err := s.newSpeakers(ctx, id, req.Speakers)
+	if err != nil {
+		return err
+	}
+
+	previous, updated := map[string]any{}, map[string]any{}
+	if before, after := withoutUsers(current), withoutUsers(speakers); !reflect.DeepEqual(before, after) {
+		previous["speakers"] = before
+		updated["speakers"] = after
+	}
+
+	audit, err := s.newAuditFromValues(ctx, enum.AuditConferenceUpdated, id, previous, updated)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][UpdateConferenceSpeakers] Failed to create audit")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	conference.Speakers = speakers
+	setHeadlineSpeaker(conference)
+
+	if err = s.r.ReplaceSpeakers(ctx, conference, audit); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"requester.id": requesterID,
+		}, "[ConferenceService][UpdateConferenceSpeakers] Failed to replace speakers")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"conference.id": id,
+		"speakers":      withoutUsers(speakers),
+		"requester.id":  requesterID,
+	}, "[ConferenceService][UpdateConferenceSpeakers] Speakers updated")
+
+	return nil
+}
+
+func (s *conferenceService) GetSpeakerConferences(ctx context.Context, userID uuid.UUID,
+	includePast bool, lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error) {
+
+	if lazyReq.AfterID != uuid.Nil && lazyReq.BeforeID != uuid.Nil {
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInvalidPagination
+	}
+
+	requesterID := ctx.Value("user.id")
+
+	if _, err := s.userSvc.GetUserByID(ctx, userID); err != nil {
+		return nil, dto.LazyLoadResponse{}, err
+	}
+
+	conferences, lazyResp, err := s.r.GetConferencesBySpeaker(ctx, userID, includePast, lazyReq)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"user.id":      userID,
+			"requester.id": requesterID,
+		}, "[ConferenceService][GetSpeakerConferences] Failed to get conferences")
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if err = s.attachSpeakers(ctx, conferences); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"user.id":      userID,
+			"requester.id": requesterID,
+		}, "[ConferenceService][GetSpeakerConferences] Failed to get speakers")
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.ConferenceResponse, len(conferences))
+	for i, conference := range conferences {
+		resp[i].PopulateFromEntity(&conference)
+	}
+
+	return resp, lazyResp, nil
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/middleware"
+	"github.com/nathakusuma/conference-backend/pkg/validator"
+)
+
+type emailHandler struct {
+	svc contract.IEmailService
+	val validator.IValidator
+}
+
+func InitEmailHandler(
+	router fiber.Router,
+	midw *middleware.Middleware,
+	val validator.IValidator,
+	emailSvc contract.IEmailService,
+) {
+	handler := emailHandler{
+		svc: emailSvc,
+		val: val,
+	}
+
+	emailGroup := router.Group("/admin/emails")
+	emailGroup.Use(midw.RequireAuthenticated())
+	emailGroup.Use(midw.RequireOneOfRoles(enum.RoleAdmin))
+
+	emailGroup.Get("",
+		handler.getEmails(),
+	)
+
+	emailGroup.Get("/stats",
+		handler.getQueueStats(),
+	)
+
+	emailGroup.Post("/:id/retry",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionEmailRetried,
+			TargetType: "email",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		handler.retryEmail(),
+	)
+}
+
+func (h *emailHandler) getEmails() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		type request struct {
+			Status string `query:"status" validate:"omitempty,oneof=pending sent failed"`
+		}
+
+		var req request
+		if err := c.QueryParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var lazyReq dto.LazyLoadQuery
+		if err := c.QueryParser(&lazyReq); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err := h.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err := h.val.ValidateStruct(lazyReq); err != nil {
+			return err
+		}
+
+		emails, lazyResp, err := h.svc.GetEmails(c.Context(), enum.EmailStatus(req.Status), lazyReq)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(map[string]interface{}{
+			"emails":     emails,
+			"pagination": lazyResp,
+		})
+	}
+}
+
+func (h *emailHandler) getQueueStats() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		stats, err := h.svc.GetQueueStats(c.Context())
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(map[string]interface{}{
+			"stats": stats,
+		})
+	}
+}
+
+func (h *emailHandler) retryEmail() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		emailID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err := h.svc.RetryEmail(c.Context(), emailID); err != nil {
+			return err
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+const emailColumns = `id, recipient, subject, template, data, status, attempts, last_error, next_attempt_at,
+	created_at, updated_at, sent_at`
+
+type emailRepository struct {
+	db *sqlx.DB
+}
+
+func NewEmailRepository(db *sqlx.DB) contract.IEmailRepository {
+	return &emailRepository{
+		db: db,
+	}
+}
+
+func (r *emailRepository) createEmail(ctx context.Context, tx sqlx.ExtContext, email *entity.Email) error {
+	query := `INSERT INTO email_outbox (id, recipient, subject, template, data)
+		VALUES (:id, :recipient, :subject, :template, :data)`
+	_, err := sqlx.NamedExecContext(ctx, tx, query, email)
+	return err
+}
+
+func (r *emailRepository) CreateEmail(ctx context.Context, email *entity.Email) error {
+	return r.createEmail(ctx, r.db, email)
+}
+
+func (r *emailRepository) ClaimDueEmails(ctx context.Context, limit int, lease time.Duration) ([]entity.Email, error) {
+	// SKIP LOCKED lets several workers, even across app instances, claim different emails at the same time
+	query := `UPDATE email_outbox
+		SET attempts = attempts + 1,
+			next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $2),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id IN (
+			SELECT id FROM email_outbox
+			WHERE status = 'pending' AND next_attempt_at <= CURRENT_TIMESTAMP
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + emailColumns
+
+	var emails []entity.Email
+	if err := r.db.SelectContext(ctx, &emails, query, limit, lease.Seconds()); err != nil {
+		return nil, fmt.Errorf("failed to claim due emails: %w", err)
+	}
+
+	return emails, nil
+}
+
+func (r *emailRepository) updateEmail(ctx context.Context, query string, args ...interface{}) error {
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *emailRepository) MarkEmailSent(ctx context.Context, id uuid.UUID) error {
+	// The template data is dropped once sent, it may hold secrets such as OTPs
+	return r.updateEmail(ctx, `UPDATE email_outbox
+		SET status = 'sent', data = '{}', last_error = NULL, sent_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id)
+}
+
+func (r *emailRepository) MarkEmailRetry(ctx context.Context, id uuid.UUID, retryIn time.Duration,
+	lastError string) error {
+
+	return r.updateEmail(ctx, `UPDATE email_outbox
+		SET next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $2), last_error = $3,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, retryIn.Seconds(), lastError)
+}
+
+func (r *emailRepository) MarkEmailFailed(ctx context.Context, id uuid.UUID, lastError string) error {
+	return r.updateEmail(ctx, `UPDATE email_outbox
+		SET status = 'failed', last_error = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, lastError)
+}
+
+func (r *emailRepository) GetEmails(ctx context.Context, status enum.EmailStatus,
+	lazy dto.LazyLoadQuery) ([]entity.Email, dto.LazyLoadResponse, error) {
+
+	var emails []entity.Email
+	var args []interface{}
+	argCount := 0
+
+	query := `SELECT ` + emailColumns + ` FROM email_outbox WHERE TRUE`
+
+	if status != "" {
+		query += fmt.Sprintf(" AND status = $%d", argCount+1)
+		args = append(args, status)
+		argCount++
+	}
+
+	// Add pagination filters
+	if lazy.AfterID != uuid.Nil {
+		query += fmt.Sprintf(" AND id > $%d", argCount+1)
+		args = append(args, lazy.AfterID)
+		argCount++
+	}
+	if lazy.BeforeID != uuid.Nil {
+		query += fmt.Sprintf(" AND id < $%d", argCount+1)
+		args = append(args, lazy.BeforeID)
+		argCount++
+	}
+
+	// Add ordering and limit
+	if lazy.BeforeID != uuid.Nil {
+		query += " ORDER BY id DESC"
+	} else {
+		query += " ORDER BY id ASC"
+	}
+	query += fmt.Sprintf(" LIMIT $%d", argCount+1)
+	args = append(args, lazy.Limit+1) // Request one extra record to determine if there are more results
+
+	if err := r.db.SelectContext(ctx, &emails, query, args...); err != nil {
+		return nil, dto.LazyLoadResponse{}, fmt.Errorf("failed to query emails: %w", err)
+	}
+
+	// Prepare response
+	lazyResp := dto.LazyLoadResponse{
+		HasMore: false,
+		FirstID: nil,
+		LastID:  nil,
+	}
+
+	if len(emails) > 0 {
+		// Check if we got an extra record
+		if len(emails) > lazy.Limit {
+			lazyResp.HasMore = true
+			if lazy.BeforeID != uuid.Nil {
+				emails = emails[1:] // Remove first record when paginating backwards
+			} else {
+				emails = emails[:lazy.Limit] // Remove last record when paginating forwards
+			}
+		}
+
+		// For BeforeID, reverse the final result set to maintain ascending order
+		if lazy.BeforeID != uuid.Nil {
+			for i := 0; i < len(emails)/2; i++ {
+				j := len(emails) - 1 - i
+				emails[i], emails[j] = emails[j], emails[i]
+			}
+		}
+
+		lazyResp.FirstID = emails[0].ID
+		lazyResp.LastID = emails[len(emails)-1].ID
+	}
+
+	return emails, lazyResp, nil
+}
+
+func (r *emailRepository) CountEmailsByStatus(ctx context.Context) (map[enum.EmailStatus]int, error) {
+	var rows []struct {
+		Status enum.EmailStatus `db:"status"`
+		Count  int              `db:"count"`
+	}
+
+	if err := r.db.SelectContext(ctx, &rows,
+		`SELECT status, COUNT(*) AS count FROM email_outbox GROUP BY status`); err != nil {
+		return nil, fmt.Errorf("failed to count emails: %w", err)
+	}
+
+	counts := make(map[enum.EmailStatus]int, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *emailRepository) RetryFailedEmail(ctx context.Context, id uuid.UUID) error {
+	return r.updateEmail(ctx, `UPDATE email_outbox
+		SET status = 'pending', attempts = 0, next_attempt_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'failed'`, id)
+}
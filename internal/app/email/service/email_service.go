@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/nathakusuma/conference-backend/pkg/mail"
+	"github.com/nathakusuma/conference-backend/pkg/metrics"
+	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
+)
+
+const (
+	maxEmailAttempts    = 8
+	emailRetryBaseDelay = 30 * time.Second
+	emailRetryMaxDelay  = time.Hour
+	// emailClaimLease must be longer than sending a batch takes, otherwise another worker sends the same email again
+	emailClaimLease = 2 * time.Minute
+	emailBatchSize  = 10
+)
+
+type emailService struct {
+	repo   contract.IEmailRepository
+	mailer mail.IMailer
+	uuid   uuidpkg.IUUID
+}
+
+func NewEmailService(
+	emailRepository contract.IEmailRepository,
+	mailer mail.IMailer,
+	uuid uuidpkg.IUUID,
+) contract.IEmailService {
+	return &emailService{
+		repo:   emailRepository,
+		mailer: mailer,
+		uuid:   uuid,
+	}
+}
+
+func (s *emailService) Enqueue(ctx context.Context, recipient, subject, templateName string,
+	data map[string]any) error {
+
+	id, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":    err.Error(),
+			"template": templateName,
+		}, "[EmailService][Enqueue] failed to generate id")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":    err.Error(),
+			"template": templateName,
+		}, "[EmailService][Enqueue] failed to marshal template data")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	email := &entity.Email{
+		ID:        id,
+		Recipient: recipient,
+		Subject:   subject,
+		Template:  templateName,
+		Data:      dataJSON,
+	}
+
+	if err = s.repo.CreateEmail(ctx, email); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":    err.Error(),
+			"email.id": id,
+			"template": templateName,
+		}, "[EmailService][Enqueue] failed to create email")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return nil
+}
+
+// ProcessDueEmails claims a batch of due emails and tries to send each of them once.
+// It returns how many emails were claimed, so the caller knows whether to poll again right away.
+func (s *emailService) ProcessDueEmails(ctx context.Context) (int, error) {
+	emails, err := s.repo.ClaimDueEmails(ctx, emailBatchSize, emailClaimLease)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error": err.Error(),
+		}, "[EmailService][ProcessDueEmails] failed to claim due emails")
+		return 0, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	for _, email := range emails {
+		s.sendEmail(ctx, email)
+	}
+
+	return len(emails), nil
+}
+
+func (s *emailService) sendEmail(ctx context.Context, email entity.Email) {
+	var data map[string]any
+	err := json.Unmarshal(email.Data, &data)
+	if err != nil {
+		err = errors.Join(mail.ErrPermanent, err)
+	} else {
+		err = s.mailer.Send(email.Recipient, email.Subject, email.Template, data)
+	}
+
+	if err == nil {
+		if err = s.repo.MarkEmailSent(ctx, email.ID); err != nil {
+			log.Error(map[string]interface{}{
+				"error":    err.Error(),
+				"email.id": email.ID,
+			}, "[EmailService][sendEmail] failed to mark email as sent")
+		}
+
+		metrics.EmailsSent.WithLabelValues(email.Template).Inc()
+		return
+	}
+
+	if errors.Is(err, mail.ErrPermanent) || email.Attempts >= maxEmailAttempts {
+		if markErr := s.repo.MarkEmailFailed(ctx, email.ID, err.Error()); markErr != nil {
+			log.Error(map[string]interface{}{
+				"error":    markErr.Error(),
+				"email.id": email.ID,
+			}, "[EmailService][sendEmail] failed to mark email as failed")
+		}
+
+		metrics.EmailsDeadLettered.WithLabelValues(email.Template).Inc()
+		log.Error(map[string]interface{}{
+			"error":    err.Error(),
+			"email.id": email.ID,
+			"attempts": email.Attempts,
+		}, "[EmailService][sendEmail] email dead-lettered")
+		return
+	}
+
+	retryIn := emailRetryDelay(email.Attempts)
+	if markErr := s.repo.MarkEmailRetry(ctx, email.ID, retryIn, err.Error()); markErr != nil {
+		// The claim lease runs out eventually, so the email is retried anyway
+		log.Error(map[string]interface{}{
+			"error":    markErr.Error(),
+			"email.id": email.ID,
+		}, "[EmailService][sendEmail] failed to schedule email retry")
+	}
+
+	log.Warn(map[string]interface{}{
+		"error":    err.Error(),
+		"email.id": email.ID,
+		"attempts": email.Attempts,
+		"retry_in": retryIn.String(),
+	}, "[EmailService][sendEmail] failed to send email, will retry")
+}
+
+// emailRetryDelay doubles the delay after every attempt, up to emailRetryMaxDelay.
+func emailRetryDelay(attempts int) time.Duration {
+	delay := emailRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= emailRetryMaxDelay {
+			return emailRetryMaxDelay
+		}
+	}
+
+	return delay
+}
+
+func (s *emailService) GetEmails(ctx context.Context, status enum.EmailStatus,
+	lazyReq dto.LazyLoadQuery) ([]dto.EmailResponse, dto.LazyLoadResponse, error) {
+
+	emails, lazyResp, err := s.repo.GetEmails(ctx, status, lazyReq)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":  err.Error(),
+			"status": status,
+		}, "[EmailService][GetEmails] failed to get emails")
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.EmailResponse, len(emails))
+	for i, email := range emails {
+		resp[i].PopulateFromEntity(&email)
+	}
+
+	return resp, lazyResp, nil
+}
+
+func (s *emailService) GetQueueStats(ctx context.Context) (dto.EmailQueueStats, error) {
+	counts, err := s.repo.CountEmailsByStatus(ctx)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error": err.Error(),
+		}, "[EmailService][GetQueueStats] failed to count emails")
+		return dto.EmailQueueStats{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return dto.EmailQueueStats{
+		Pending: counts[enum.EmailPending],
+		Sent:    counts[enum.EmailSent],
+		Failed:  counts[enum.EmailFailed],
+	}, nil
+}
+
+func (s *emailService) RetryEmail(ctx context.Context, id uuid.UUID) error {
+	err := s.repo.RetryFailedEmail(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound.WithMessage("Failed email not found.")
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":    err.Error(),
+			"email.id": id,
+		}, "[EmailService][RetryEmail] failed to retry email")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"email.id": id,
+	}, "[EmailService][RetryEmail] failed email queued again")
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/pkg/background"
+	"github.com/nathakusuma/conference-backend/pkg/metrics"
+)
+
+const (
+	outboxPollInterval  = 2 * time.Second
+	outboxStatsInterval = 15 * time.Second
+)
+
+// StartOutboxWorkers starts the workers that send the outbox emails, plus one that refreshes the queue depth metric.
+// They stop once ctx is done, after finishing the batch at hand.
+func StartOutboxWorkers(ctx context.Context, svc contract.IEmailService, workers int) {
+	for i := 0; i < workers; i++ {
+		background.Go("email.outbox_worker", func() {
+			runOutboxWorker(ctx, svc)
+		})
+	}
+
+	background.Go("email.outbox_stats", func() {
+		runOutboxStats(ctx, svc)
+	})
+}
+
+func runOutboxWorker(ctx context.Context, svc contract.IEmailService) {
+	// A claimed batch is finished even during shutdown, so its emails aren't left waiting for the lease to expire
+	processCtx := context.WithoutCancel(ctx)
+
+	for {
+		claimed, err := svc.ProcessDueEmails(processCtx)
+
+		// A full batch means there may be more due emails, so don't wait
+		if err != nil || claimed < emailBatchSize {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(outboxPollInterval):
+			}
+		} else if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func runOutboxStats(ctx context.Context, svc contract.IEmailService) {
+	ticker := time.NewTicker(outboxStatsInterval)
+	defer ticker.Stop()
+
+	for {
+		// Errors are already logged by the service, the gauges keep their last values
+		if stats, err := svc.GetQueueStats(ctx); err == nil {
+			metrics.EmailOutboxDepth.WithLabelValues(enum.EmailPending.String()).Set(float64(stats.Pending))
+			metrics.EmailOutboxDepth.WithLabelValues(enum.EmailFailed.String()).Set(float64(stats.Failed))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,399 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/middleware"
+	"github.com/nathakusuma/conference-backend/pkg/validator"
+)
+
+type eventHandler struct {
+	svc contract.IEventService
+	val validator.IValidator
+}
+
+func InitEventHandler(
+	router fiber.Router,
+	midw *middleware.Middleware,
+	val validator.IValidator,
+	eventSvc contract.IEventService,
+) {
+	handler := eventHandler{
+		svc: eventSvc,
+		val: val,
+	}
+
+	eventGroup := router.Group("/events")
+	eventGroup.Post("",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionEventCreated,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByLocal("audit.target_id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin),
+		handler.createEvent(),
+	)
+	eventGroup.Get("",
+		midw.RequireAuthenticated(),
+		handler.getEvents(),
+	)
+	eventGroup.Get("/:id",
+		midw.RequireAuthenticated(),
+		handler.getEventByID(),
+	)
+	eventGroup.Patch("/:id",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionEventUpdated,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin),
+		handler.updateEvent(),
+	)
+	eventGroup.Delete("/:id",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionEventDeleted,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin),
+		handler.deleteEvent(),
+	)
+	// Anyone can see whether an event is accepting proposals
+	eventGroup.Get("/:id/cfp",
+		handler.getCFP(),
+	)
+	eventGroup.Patch("/:id/cfp",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionCFPUpdated,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin, enum.RoleEventCoordinator),
+		handler.updateCFP(),
+	)
+	eventGroup.Post("/:id/cfp/open",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionCFPOpened,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin, enum.RoleEventCoordinator),
+		handler.openCFP(),
+	)
+	eventGroup.Post("/:id/cfp/close",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionCFPClosed,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin, enum.RoleEventCoordinator),
+		handler.closeCFP(),
+	)
+	eventGroup.Post("/:id/cfp/extend",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionCFPExtended,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin, enum.RoleEventCoordinator),
+		handler.extendCFP(),
+	)
+	eventGroup.Get("/:id/coordinators",
+		midw.RequireAuthenticated(),
+		midw.RequireOneOfRoles(enum.RoleAdmin, enum.RoleEventCoordinator),
+		handler.getCoordinators(),
+	)
+	eventGroup.Put("/:id/coordinators/:userId",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionCoordinatorAdded,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin),
+		handler.addCoordinator(),
+	)
+	eventGroup.Delete("/:id/coordinators/:userId",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionCoordinatorRemoved,
+			TargetType: "event",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin),
+		handler.removeCoordinator(),
+	)
+}
+
+func (h *eventHandler) createEvent() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var req dto.CreateEventRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err := h.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		eventID, err := h.svc.CreateEvent(ctx.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		ctx.Locals("audit.target_id", eventID)
+
+		return ctx.Status(fiber.StatusCreated).JSON(map[string]interface{}{
+			"event": dto.EventResponse{ID: eventID},
+		})
+	}
+}
+
+func (h *eventHandler) getEvents() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var query dto.GetEventsQuery
+		if err := ctx.QueryParser(&query); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		events, err := h.svc.GetEvents(ctx.Context(), query)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"events": events,
+		})
+	}
+}
+
+func (h *eventHandler) getEventByID() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		event, err := h.svc.GetEventByID(ctx.Context(), eventID)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"event": event,
+		})
+	}
+}
+
+func (h *eventHandler) updateEvent() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req dto.UpdateEventRequest
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err = h.svc.UpdateEvent(ctx.Context(), eventID, req); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *eventHandler) deleteEvent() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.svc.DeleteEvent(ctx.Context(), eventID); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *eventHandler) getCFP() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		cfp, err := h.svc.GetCFP(ctx.Context(), eventID)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"cfp": cfp,
+		})
+	}
+}
+
+func (h *eventHandler) updateCFP() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req dto.UpdateCFPRequest
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err = h.svc.UpdateCFP(ctx.Context(), eventID, req); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *eventHandler) openCFP() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		// The body is optional
+		var req dto.OpenCFPRequest
+		if len(ctx.Body()) > 0 {
+			if err = ctx.BodyParser(&req); err != nil {
+				return errorpkg.ErrFailParseRequest
+			}
+		}
+
+		if err = h.svc.OpenCFP(ctx.Context(), eventID, req); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *eventHandler) closeCFP() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.svc.CloseCFP(ctx.Context(), eventID); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *eventHandler) extendCFP() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req dto.ExtendCFPRequest
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err = h.svc.ExtendCFP(ctx.Context(), eventID, req); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *eventHandler) getCoordinators() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		users, err := h.svc.GetCoordinators(ctx.Context(), eventID)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"users": users,
+		})
+	}
+}
+
+func (h *eventHandler) addCoordinator() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		userID, err := uuid.Parse(ctx.Params("userId"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.svc.AddCoordinator(ctx.Context(), eventID, userID); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *eventHandler) removeCoordinator() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		eventID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		userID, err := uuid.Parse(ctx.Params("userId"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.svc.RemoveCoordinator(ctx.Context(), eventID, userID); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
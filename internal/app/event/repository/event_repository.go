@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type eventRepository struct {
+	db *sqlx.DB
+}
+
+func NewEventRepository(db *sqlx.DB) contract.IEventRepository {
+	return &eventRepository{
+		db: db,
+	}
+}
+
+func (r *eventRepository) CreateEvent(ctx context.Context, event *entity.Event) error {
+	_, err := sqlx.NamedExecContext(
+		ctx,
+		r.db,
+		`INSERT INTO events (id, name, description, starts_at, ends_at, cfp_opens_at, cfp_closes_at,
+			cfp_min_lead_hours)
+		VALUES (:id, :name, :description, :starts_at, :ends_at, :cfp_opens_at, :cfp_closes_at,
+			:cfp_min_lead_hours)`,
+		event,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *eventRepository) GetEventByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+	var event entity.Event
+
+	err := r.db.GetContext(ctx, &event, `
+		SELECT id, name, description, starts_at, ends_at, cfp_opens_at, cfp_closes_at, cfp_min_lead_hours,
+			created_at, updated_at, deleted_at
+		FROM events
+		WHERE id = $1
+		AND deleted_at IS NULL`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r *eventRepository) GetEvents(ctx context.Context, query dto.GetEventsQuery) ([]entity.Event, error) {
+	statement := `
+		SELECT id, name, description, starts_at, ends_at, cfp_opens_at, cfp_closes_at, cfp_min_lead_hours,
+			created_at, updated_at, deleted_at
+		FROM events
+		WHERE deleted_at IS NULL`
+
+	if !query.IncludePast {
+		statement += " AND ends_at > now()"
+	}
+
+	statement += " ORDER BY starts_at"
+
+	var events []entity.Event
+	if err := r.db.SelectContext(ctx, &events, statement); err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *eventRepository) UpdateEvent(ctx context.Context, event *entity.Event) error {
+	res, err := sqlx.NamedExecContext(
+		ctx,
+		r.db,
+		`UPDATE events
+		SET name = :name,
+			description = :description,
+			starts_at = :starts_at,
+			ends_at = :ends_at,
+			cfp_opens_at = :cfp_opens_at,
+			cfp_closes_at = :cfp_closes_at,
+			cfp_min_lead_hours = :cfp_min_lead_hours,
+			updated_at = now()
+		WHERE id = :id
+		AND deleted_at IS NULL`,
+		event,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *eventRepository) UpdateCFP(ctx context.Context, event *entity.Event) error {
+	res, err := sqlx.NamedExecContext(
+		ctx,
+		r.db,
+		`UPDATE events
+		SET cfp_opens_at = :cfp_opens_at,
+			cfp_closes_at = :cfp_closes_at,
+			cfp_min_lead_hours = :cfp_min_lead_hours,
+			updated_at = now()
+		WHERE id = :id
+		AND deleted_at IS NULL`,
+		event,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *eventRepository) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE events SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *eventRepository) HasConferences(ctx context.Context, eventID uuid.UUID) (bool, error) {
+	var exists bool
+
+	err := r.db.GetContext(ctx, &exists, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM conferences
+			WHERE event_id = $1
+			AND deleted_at IS NULL
+		)`, eventID)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *eventRepository) HasConferencesOutside(ctx context.Context, eventID uuid.UUID,
+	startsAt, endsAt time.Time) (bool, error) {
+
+	var exists bool
+
+	err := r.db.GetContext(ctx, &exists, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM conferences
+			WHERE event_id = $1
+			AND deleted_at IS NULL
+			AND status IN ('pending', 'approved')
+			AND (starts_at < $2 OR ends_at > $3)
+		)`, eventID, startsAt, endsAt)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *eventRepository) AddCoordinator(ctx context.Context, eventID, userID uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO event_coordinators (event_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING`, eventID, userID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *eventRepository) RemoveCoordinator(ctx context.Context, eventID, userID uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM event_coordinators WHERE event_id = $1 AND user_id = $2`, eventID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *eventRepository) GetCoordinators(ctx context.Context, eventID uuid.UUID) ([]entity.User, error) {
+	var users []entity.User
+
+	err := r.db.SelectContext(ctx, &users, `
+		SELECT u.id, u.name, u.email, u.role, u.bio, u.created_at, u.updated_at
+		FROM event_coordinators ec
+		JOIN users u ON ec.user_id = u.id
+		WHERE ec.event_id = $1
+		AND u.deleted_at IS NULL
+		ORDER BY u.name`, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event coordinators: %w", err)
+	}
+
+	return users, nil
+}
+
+func (r *eventRepository) IsCoordinator(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	var exists bool
+
+	err := r.db.GetContext(ctx, &exists, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM event_coordinators
+			WHERE event_id = $1
+			AND user_id = $2
+		)`, eventID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+// cfpStatus tells where now falls in the call for proposals of event.
+func cfpStatus(event *entity.Event, now time.Time) enum.CFPStatus {
+	switch {
+	case now.Before(event.CFPOpensAt):
+		return enum.CFPUpcoming
+	case now.After(event.CFPClosesAt):
+		return enum.CFPClosed
+	default:
+		return enum.CFPOpen
+	}
+}
+
+// checkCoordinator makes sure an event coordinator only manages the events they coordinate. Admins
+// manage all of them.
+func (s *eventService) checkCoordinator(ctx context.Context, eventID uuid.UUID) error {
+	requesterRole, _ := ctx.Value("user.role").(enum.UserRole)
+	if requesterRole != enum.RoleEventCoordinator {
+		return nil
+	}
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+	ok, err := s.IsEventCoordinator(ctx, eventID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errorpkg.ErrNotEventCoordinator
+	}
+
+	return nil
+}
+
+// getManagedEvent returns the event if the requester may manage its call for proposals.
+func (s *eventService) getManagedEvent(ctx context.Context, eventID uuid.UUID) (*entity.Event, error) {
+	event, err := s.getEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkCoordinator(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (s *eventService) saveCFP(ctx context.Context, event *entity.Event, method string) error {
+	requesterID := ctx.Value("user.id")
+
+	if err := s.r.UpdateCFP(ctx, event); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event.id":     event.ID,
+			"requester.id": requesterID,
+		}, "[EventService]["+method+"] Failed to update CFP")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"event.id":       event.ID,
+		"cfp.opens_at":   event.CFPOpensAt,
+		"cfp.closes_at":  event.CFPClosesAt,
+		"cfp.lead_hours": event.CFPMinLeadHours,
+		"requester.id":   requesterID,
+	}, "[EventService]["+method+"] CFP updated")
+
+	return nil
+}
+
+func (s *eventService) GetCFP(ctx context.Context, eventID uuid.UUID) (*dto.CFPResponse, error) {
+	event, err := s.getEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := &dto.CFPResponse{
+		EventID:      event.ID,
+		Status:       cfpStatus(event, now),
+		OpensAt:      event.CFPOpensAt,
+		ClosesAt:     event.CFPClosesAt,
+		MinLeadHours: event.CFPMinLeadHours,
+	}
+
+	if resp.Status == enum.CFPOpen {
+		// A conference can't start before its event either
+		earliest := now.Add(time.Duration(event.CFPMinLeadHours) * time.Hour)
+		if earliest.Before(event.StartsAt) {
+			earliest = event.StartsAt
+		}
+		resp.EarliestStartsAt = &earliest
+	}
+
+	return resp, nil
+}
+
+func (s *eventService) UpdateCFP(ctx context.Context, eventID uuid.UUID, req dto.UpdateCFPRequest) error {
+	event, err := s.getManagedEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if req.OpensAt != nil {
+		event.CFPOpensAt = *req.OpensAt
+	}
+	if req.ClosesAt != nil {
+		event.CFPClosesAt = *req.ClosesAt
+	}
+	if req.MinLeadHours != nil {
+		event.CFPMinLeadHours = *req.MinLeadHours
+	}
+
+	if event.CFPClosesAt.Before(event.CFPOpensAt) {
+		return errorpkg.ErrCFPClosesBeforeOpens
+	}
+
+	return s.saveCFP(ctx, event, "UpdateCFP")
+}
+
+func (s *eventService) OpenCFP(ctx context.Context, eventID uuid.UUID, req dto.OpenCFPRequest) error {
+	event, err := s.getManagedEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if cfpStatus(event, now) == enum.CFPOpen {
+		return errorpkg.ErrCFPAlreadyOpen
+	}
+
+	event.CFPOpensAt = now
+	if req.ClosesAt != nil {
+		event.CFPClosesAt = *req.ClosesAt
+	}
+
+	// Reopening a closed call needs a new closing time
+	if !event.CFPClosesAt.After(now) {
+		return errorpkg.ErrTimeAlreadyPassed
+	}
+
+	return s.saveCFP(ctx, event, "OpenCFP")
+}
+
+func (s *eventService) CloseCFP(ctx context.Context, eventID uuid.UUID) error {
+	event, err := s.getManagedEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if cfpStatus(event, now) == enum.CFPClosed {
+		return errorpkg.ErrCFPClosed
+	}
+
+	// Closing a call that hasn't opened yet leaves it empty
+	if event.CFPOpensAt.After(now) {
+		event.CFPOpensAt = now
+	}
+	event.CFPClosesAt = now
+
+	return s.saveCFP(ctx, event, "CloseCFP")
+}
+
+func (s *eventService) ExtendCFP(ctx context.Context, eventID uuid.UUID, req dto.ExtendCFPRequest) error {
+	event, err := s.getManagedEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if !req.ClosesAt.After(event.CFPClosesAt) {
+		return errorpkg.ErrCFPExtendNotLater
+	}
+
+	if !req.ClosesAt.After(time.Now()) {
+		return errorpkg.ErrTimeAlreadyPassed
+	}
+
+	// Extending a closed call reopens it until the new closing time
+	event.CFPClosesAt = req.ClosesAt
+
+	return s.saveCFP(ctx, event, "ExtendCFP")
+}
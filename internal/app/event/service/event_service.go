@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
+)
+
+type eventService struct {
+	r       contract.IEventRepository
+	userSvc contract.IUserService
+	uuid    uuidpkg.IUUID
+}
+
+func NewEventService(eventRepo contract.IEventRepository, userSvc contract.IUserService,
+	uuid uuidpkg.IUUID) contract.IEventService {
+
+	return &eventService{r: eventRepo, userSvc: userSvc, uuid: uuid}
+}
+
+func validateEventDates(event *entity.Event) error {
+	if event.EndsAt.Before(event.StartsAt) {
+		return errorpkg.ErrEndTimeBeforeStart
+	}
+
+	if event.CFPClosesAt.Before(event.CFPOpensAt) {
+		return errorpkg.ErrCFPClosesBeforeOpens
+	}
+
+	return nil
+}
+
+func (s *eventService) CreateEvent(ctx context.Context, req dto.CreateEventRequest) (uuid.UUID, error) {
+	requesterID := ctx.Value("user.id")
+
+	event := entity.Event{
+		Name:            req.Name,
+		Description:     req.Description,
+		StartsAt:        req.StartsAt,
+		EndsAt:          req.EndsAt,
+		CFPOpensAt:      req.CFPOpensAt,
+		CFPClosesAt:     req.CFPClosesAt,
+		CFPMinLeadHours: req.CFPMinLeadHours,
+	}
+
+	if err := validateEventDates(&event); err != nil {
+		return uuid.Nil, err
+	}
+
+	eventID, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"request":      req,
+			"requester.id": requesterID,
+		}, "[EventService][CreateEvent] Failed to generate event ID")
+		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+	event.ID = eventID
+
+	if err = s.r.CreateEvent(ctx, &event); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"request":      req,
+			"requester.id": requesterID,
+		}, "[EventService][CreateEvent] Failed to create event")
+		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"event":        event,
+		"requester.id": requesterID,
+	}, "[EventService][CreateEvent] Event created")
+
+	return eventID, nil
+}
+
+func (s *eventService) getEventByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+	event, err := s.r.GetEventByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event.id":     id,
+			"requester.id": ctx.Value("user.id"),
+		}, "[EventService][getEventByID] Failed to get event")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return event, nil
+}
+
+func (s *eventService) GetEventByID(ctx context.Context, id uuid.UUID) (*dto.EventResponse, error) {
+	event, err := s.getEventByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(dto.EventResponse).PopulateFromEntity(event), nil
+}
+
+func (s *eventService) GetEvents(ctx context.Context, query dto.GetEventsQuery) ([]dto.EventResponse, error) {
+	events, err := s.r.GetEvents(ctx, query)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"query":        query,
+			"requester.id": ctx.Value("user.id"),
+		}, "[EventService][GetEvents] Failed to get events")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.EventResponse, len(events))
+	for i, event := range events {
+		resp[i].PopulateFromEntity(&event)
+	}
+
+	return resp, nil
+}
+
+func (s *eventService) UpdateEvent(ctx context.Context, id uuid.UUID, req dto.UpdateEventRequest) error {
+	requesterID := ctx.Value("user.id")
+
+	event, err := s.getEventByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if req.Name != nil {
+		event.Name = *req.Name
+	}
+	if req.Description != nil {
+		event.Description = req.Description
+	}
+	if req.StartsAt != nil {
+		event.StartsAt = *req.StartsAt
+	}
+	if req.EndsAt != nil {
+		event.EndsAt = *req.EndsAt
+	}
+	if req.CFPOpensAt != nil {
+		event.CFPOpensAt = *req.CFPOpensAt
+	}
+	if req.CFPClosesAt != nil {
+		event.CFPClosesAt = *req.CFPClosesAt
+	}
+	if req.CFPMinLeadHours != nil {
+		event.CFPMinLeadHours = *req.CFPMinLeadHours
+	}
+
+	if err = validateEventDates(event); err != nil {
+		return err
+	}
+
+	// Moving the dates must keep every planned conference inside the event
+	if req.StartsAt != nil || req.EndsAt != nil {
+		outside, err2 := s.r.HasConferencesOutside(ctx, id, event.StartsAt, event.EndsAt)
+		if err2 != nil {
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error":        err2,
+				"event.id":     id,
+				"requester.id": requesterID,
+			}, "[EventService][UpdateEvent] Failed to check conferences outside the event")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+
+		if outside {
+			return errorpkg.ErrEventDatesExcludeConferences
+		}
+	}
+
+	if err = s.r.UpdateEvent(ctx, event); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event":        event,
+			"requester.id": requesterID,
+		}, "[EventService][UpdateEvent] Failed to update event")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"event":        event,
+		"requester.id": requesterID,
+	}, "[EventService][UpdateEvent] Event updated")
+
+	return nil
+}
+
+func (s *eventService) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	requesterID := ctx.Value("user.id")
+
+	// Even cancelled and past conferences keep pointing at their event
+	inUse, err := s.r.HasConferences(ctx, id)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event.id":     id,
+			"requester.id": requesterID,
+		}, "[EventService][DeleteEvent] Failed to check conferences")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if inUse {
+		return errorpkg.ErrEventInUse
+	}
+
+	if err = s.r.DeleteEvent(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event.id":     id,
+			"requester.id": requesterID,
+		}, "[EventService][DeleteEvent] Failed to delete event")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"event.id":     id,
+		"requester.id": requesterID,
+	}, "[EventService][DeleteEvent] Event deleted")
+
+	return nil
+}
+
+func (s *eventService) AddCoordinator(ctx context.Context, eventID, userID uuid.UUID) error {
+	requesterID := ctx.Value("user.id")
+
+	if _, err := s.getEventByID(ctx, eventID); err != nil {
+		return err
+	}
+
+	user, err := s.userSvc.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.Role != enum.RoleEventCoordinator {
+		return errorpkg.ErrUserNotCoordinator
+	}
+
+	if err = s.r.AddCoordinator(ctx, eventID, userID); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event.id":     eventID,
+			"user.id":      userID,
+			"requester.id": requesterID,
+		}, "[EventService][AddCoordinator] Failed to add coordinator")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"event.id":     eventID,
+		"user.id":      userID,
+		"requester.id": requesterID,
+	}, "[EventService][AddCoordinator] Coordinator added")
+
+	return nil
+}
+
+func (s *eventService) RemoveCoordinator(ctx context.Context, eventID, userID uuid.UUID) error {
+	requesterID := ctx.Value("user.id")
+
+	if err := s.r.RemoveCoordinator(ctx, eventID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event.id":     eventID,
+			"user.id":      userID,
+			"requester.id": requesterID,
+		}, "[EventService][RemoveCoordinator] Failed to remove coordinator")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"event.id":     eventID,
+		"user.id":      userID,
+		"requester.id": requesterID,
+	}, "[EventService][RemoveCoordinator] Coordinator removed")
+
+	return nil
+}
+
+func (s *eventService) GetCoordinators(ctx context.Context, eventID uuid.UUID) ([]dto.UserResponse, error) {
+	if _, err := s.getEventByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	users, err := s.r.GetCoordinators(ctx, eventID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event.id":     eventID,
+			"requester.id": ctx.Value("user.id"),
+		}, "[EventService][GetCoordinators] Failed to get coordinators")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.UserResponse, len(users))
+	for i, user := range users {
+		resp[i].PopulateMinimalFromEntity(&user)
+	}
+
+	return resp, nil
+}
+
+func (s *eventService) IsEventCoordinator(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	ok, err := s.r.IsCoordinator(ctx, eventID, userID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"event.id":     eventID,
+			"user.id":      userID,
+			"requester.id": ctx.Value("user.id"),
+		}, "[EventService][IsEventCoordinator] Failed to check coordinator")
+		return false, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return ok, nil
+}
@@ -41,6 +41,11 @@ func InitFeedbackHandler(
 	)
 
 	feedbackGroup.Delete("/:id",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionFeedbackDeleted,
+			TargetType: "feedback",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
 		midw.RequireOneOfRoles(enum.RoleEventCoordinator),
 		handler.deleteFeedback(),
 	)
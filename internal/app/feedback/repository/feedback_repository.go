@@ -141,6 +141,18 @@ func (r *feedbackRepository) GetFeedbacksByConferenceID(ctx context.Context,
 	return feedbacks, lazyResp, nil
 }
 
+func (r *feedbackRepository) GetFeedbackByID(ctx context.Context, id uuid.UUID) (*entity.Feedback, error) {
+	var feedback entity.Feedback
+	query := `SELECT id, user_id, conference_id, comment, created_at
+		FROM feedbacks
+		WHERE id = $1 AND deleted_at IS NULL`
+	if err := r.db.GetContext(ctx, &feedback, query, id); err != nil {
+		return nil, err
+	}
+
+	return &feedback, nil
+}
+
 func (r *feedbackRepository) deleteFeedback(ctx context.Context, tx sqlx.ExtContext, id uuid.UUID) error {
 	query := `UPDATE feedbacks SET deleted_at = now() WHERE id = $1`
 	res, err := tx.ExecContext(ctx, query, id)
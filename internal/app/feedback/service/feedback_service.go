@@ -10,6 +10,7 @@ import (
 	"github.com/nathakusuma/conference-backend/domain/contract"
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
 	"github.com/nathakusuma/conference-backend/pkg/log"
 	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
@@ -19,6 +20,7 @@ type feedbackService struct {
 	repo            contract.IFeedbackRepository
 	registrationSvc contract.IRegistrationService
 	conferenceSvc   contract.IConferenceService
+	eventSvc        contract.IEventService
 	uuid            uuidpkg.IUUID
 }
 
@@ -26,12 +28,14 @@ func NewFeedbackService(
 	feedbackRepository contract.IFeedbackRepository,
 	registrationService contract.IRegistrationService,
 	conferenceService contract.IConferenceService,
+	eventService contract.IEventService,
 	uuid uuidpkg.IUUID,
 ) contract.IFeedbackService {
 	return &feedbackService{
 		repo:            feedbackRepository,
 		registrationSvc: registrationService,
 		conferenceSvc:   conferenceService,
+		eventSvc:        eventService,
 		uuid:            uuid,
 	}
 }
@@ -65,6 +69,11 @@ func (s *feedbackService) CreateFeedback(ctx context.Context, userID, conference
 		return uuid.Nil, err
 	}
 
+	// A conference that never took place has nothing to give feedback on
+	if conference.Status == enum.ConferenceCancelled {
+		return uuid.Nil, errorpkg.ErrConferenceCancelled
+	}
+
 	if conference.Host.ID == userID {
 		return uuid.Nil, errorpkg.ErrHostCannotGiveFeedback
 	}
@@ -125,7 +134,51 @@ func (s *feedbackService) GetFeedbacksByConferenceID(ctx context.Context, confer
 	return resp, lazyResp, nil
 }
 
+// checkEventCoordinator makes sure an event coordinator only deletes feedback on the conferences of the
+// events they coordinate.
+func (s *feedbackService) checkEventCoordinator(ctx context.Context, id uuid.UUID) error {
+	requesterRole, _ := ctx.Value("user.role").(enum.UserRole)
+	if requesterRole != enum.RoleEventCoordinator {
+		return nil
+	}
+
+	feedback, err := s.repo.GetFeedbackByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"feedback.id":  id,
+			"requester.id": ctx.Value("user.id"),
+		}, "[FeedbackService][checkEventCoordinator] Failed to get feedback")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	conference, err := s.conferenceSvc.GetConferenceByID(ctx, feedback.ConferenceID)
+	if err != nil {
+		return err
+	}
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+	ok, err := s.eventSvc.IsEventCoordinator(ctx, conference.Event.ID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errorpkg.ErrNotEventCoordinator
+	}
+
+	return nil
+}
+
 func (s *feedbackService) DeleteFeedback(ctx context.Context, id uuid.UUID) error {
+	if err := s.checkEventCoordinator(ctx, id); err != nil {
+		return err
+	}
+
 	if err := s.repo.DeleteFeedback(ctx, id); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return errorpkg.ErrNotFound
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/middleware"
+	"github.com/nathakusuma/conference-backend/pkg/validator"
+)
+
+type notificationHandler struct {
+	svc contract.INotificationService
+	val validator.IValidator
+}
+
+func InitNotificationHandler(
+	router fiber.Router,
+	midw *middleware.Middleware,
+	val validator.IValidator,
+	notificationSvc contract.INotificationService,
+) {
+	handler := notificationHandler{
+		svc: notificationSvc,
+		val: val,
+	}
+
+	notificationGroup := router.Group("/notifications")
+	notificationGroup.Use(midw.RequireAuthenticated())
+
+	notificationGroup.Get("",
+		handler.getNotifications(),
+	)
+}
+
+func (h *notificationHandler) getNotifications() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var lazyReq dto.LazyLoadQuery
+		if err := c.QueryParser(&lazyReq); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err := h.val.ValidateStruct(lazyReq); err != nil {
+			return err
+		}
+
+		userID, _ := c.Locals("user.id").(uuid.UUID)
+
+		notifications, lazyResp, err := h.svc.GetNotificationsByUser(c.Context(), userID, lazyReq)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(map[string]interface{}{
+			"notifications": notifications,
+			"pagination":    lazyResp,
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type notificationRepository struct {
+	db *sqlx.DB
+}
+
+func NewNotificationRepository(db *sqlx.DB) contract.INotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
+
+func (r *notificationRepository) createNotifications(ctx context.Context, tx sqlx.ExtContext,
+	notifications []entity.Notification) error {
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO notifications (id, user_id, type, title, message, conference_id)
+		VALUES (:id, :user_id, :type, :title, :message, :conference_id)`
+	_, err := sqlx.NamedExecContext(ctx, tx, query, notifications)
+	return err
+}
+
+func (r *notificationRepository) CreateNotifications(ctx context.Context,
+	notifications []entity.Notification) error {
+
+	return r.createNotifications(ctx, r.db, notifications)
+}
+
+func (r *notificationRepository) GetNotificationsByUser(ctx context.Context, userID uuid.UUID,
+	lazy dto.LazyLoadQuery) ([]entity.Notification, dto.LazyLoadResponse, error) {
+
+	var notifications []entity.Notification
+	var args []interface{}
+	args = append(args, userID)
+	argCount := 1
+
+	query := `SELECT id, user_id, type, title, message, conference_id, created_at
+		FROM notifications
+		WHERE user_id = $1`
+
+	// Add pagination filters
+	if lazy.AfterID != uuid.Nil {
+		query += fmt.Sprintf(" AND id > $%d", argCount+1)
+		args = append(args, lazy.AfterID)
+		argCount++
+	}
+	if lazy.BeforeID != uuid.Nil {
+		query += fmt.Sprintf(" AND id < $%d", argCount+1)
+		args = append(args, lazy.BeforeID)
+		argCount++
+	}
+
+	// Add ordering and limit
+	if lazy.BeforeID != uuid.Nil {
+		query += " ORDER BY id DESC"
+	} else {
+		query += " ORDER BY id ASC"
+	}
+	query += fmt.Sprintf(" LIMIT $%d", argCount+1)
+	args = append(args, lazy.Limit+1) // Request one extra record to determine if there are more results
+
+	if err := r.db.SelectContext(ctx, &notifications, query, args...); err != nil {
+		return nil, dto.LazyLoadResponse{}, fmt.Errorf("failed to query notifications: %w", err)
+	}
+
+	// Prepare response
+	lazyResp := dto.LazyLoadResponse{
+		HasMore: false,
+		FirstID: nil,
+		LastID:  nil,
+	}
+
+	if len(notifications) > 0 {
+		// Check if we got an extra record
+		if len(notifications) > lazy.Limit {
+			lazyResp.HasMore = true
+			if lazy.BeforeID != uuid.Nil {
+				notifications = notifications[1:] // Remove first record when paginating backwards
+			} else {
+				notifications = notifications[:lazy.Limit] // Remove last record when paginating forwards
+			}
+		}
+
+		// For BeforeID, reverse the final result set to maintain ascending order
+		if lazy.BeforeID != uuid.Nil {
+			for i := 0; i < len(notifications)/2; i++ {
+				j := len(notifications) - 1 - i
+				notifications[i], notifications[j] = notifications[j], notifications[i]
+			}
+		}
+
+		lazyResp.FirstID = notifications[0].ID
+		lazyResp.LastID = notifications[len(notifications)-1].ID
+	}
+
+	return notifications, lazyResp, nil
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/domain/event"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
+)
+
+type notificationService struct {
+	repo            contract.INotificationRepository
+	userSvc         contract.IUserService
+	registrationSvc contract.IRegistrationService
+	emailSvc        contract.IEmailService
+	uuid            uuidpkg.IUUID
+}
+
+func NewNotificationService(
+	notificationRepository contract.INotificationRepository,
+	userService contract.IUserService,
+	registrationService contract.IRegistrationService,
+	emailService contract.IEmailService,
+	uuid uuidpkg.IUUID,
+) contract.INotificationService {
+	return &notificationService{
+		repo:            notificationRepository,
+		userSvc:         userService,
+		registrationSvc: registrationService,
+		emailSvc:        emailService,
+		uuid:            uuid,
+	}
+}
+
+// notice is what every recipient of one event gets, both in the app and by email.
+type notice struct {
+	recipients    []entity.User
+	title         string
+	message       string
+	conferenceID  uuid.UUID
+	emailSubject  string
+	emailTemplate string
+	emailData     map[string]any
+}
+
+func (s *notificationService) HandleEvent(ctx context.Context, e event.Event) error {
+	var n notice
+	var err error
+
+	switch e := e.(type) {
+	case event.ConferenceApproved:
+		n, err = s.hostNotice(ctx, e.Conference)
+		n.title = "Conference proposal approved"
+		n.message = fmt.Sprintf("Your conference \"%s\" has been approved.", e.Conference.Title)
+		n.emailSubject = "[Conference App] Your Conference Was Approved"
+		n.emailTemplate = "conference_approved.html"
+	case event.ConferenceRejected:
+		n, err = s.hostNotice(ctx, e.Conference)
+		n.title = "Conference proposal rejected"
+		n.message = fmt.Sprintf("Your conference \"%s\" has been rejected.", e.Conference.Title)
+		if e.Review.Reason != nil {
+			n.message += fmt.Sprintf(" Reason: %s.", e.Review.Reason.Description())
+		}
+		n.emailSubject = "[Conference App] Your Conference Was Rejected"
+		n.emailTemplate = "conference_rejected.html"
+		if err == nil {
+			n.emailData["reason"] = rejectionReasonOf(e.Review)
+			n.emailData["notes"] = notesOf(e.Review)
+		}
+	case event.ConferenceCancelled:
+		n, err = s.attendeesNotice(ctx, e.Conference)
+		n.title = "Conference cancelled"
+		n.message = fmt.Sprintf("The conference \"%s\" you registered for has been cancelled. Reason: %s",
+			e.Conference.Title, e.Reason)
+		n.emailSubject = "[Conference App] Conference Cancelled"
+		n.emailTemplate = "conference_cancelled.html"
+		if err == nil {
+			n.emailData["reason"] = e.Reason
+		}
+	case event.ConferenceRescheduled:
+		n, err = s.attendeesNotice(ctx, e.Conference)
+		n.title = "Conference rescheduled"
+		n.message = fmt.Sprintf("The conference \"%s\" you registered for now starts at %s.",
+			e.Conference.Title, e.Conference.StartsAt.Format(time.RFC1123))
+		n.emailSubject = "[Conference App] Conference Rescheduled"
+		n.emailTemplate = "conference_rescheduled.html"
+		if err == nil {
+			n.emailData["previous_starts_at"] = e.PreviousStartsAt.Format(time.RFC1123)
+		}
+	case event.RescheduleReviewed:
+		n, err = s.hostNotice(ctx, e.Conference)
+		approved := e.Reschedule.Status == enum.RescheduleApproved
+		requestedStartsAt := e.Reschedule.StartsAt.Format(time.RFC1123)
+		if approved {
+			n.title = "Reschedule approved"
+			n.message = fmt.Sprintf("Your request to move \"%s\" to %s has been approved.",
+				e.Conference.Title, requestedStartsAt)
+			n.emailSubject = "[Conference App] Your Reschedule Was Approved"
+		} else {
+			n.title = "Reschedule rejected"
+			n.message = fmt.Sprintf("Your request to move \"%s\" to %s has been rejected.",
+				e.Conference.Title, requestedStartsAt)
+			n.emailSubject = "[Conference App] Your Reschedule Was Rejected"
+		}
+		n.emailTemplate = "reschedule_reviewed.html"
+		if err == nil {
+			n.emailData["approved"] = approved
+			n.emailData["requested_starts_at"] = requestedStartsAt
+			n.emailData["notes"] = ""
+			if e.Reschedule.Notes != nil {
+				n.emailData["notes"] = *e.Reschedule.Notes
+			}
+		}
+	case event.RegistrationConfirmed:
+		n, err = s.registrantNotice(ctx, e)
+	case event.RegistrationConflict:
+		n, err = s.conflictNotice(ctx, e)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.deliver(ctx, e.EventName(), n)
+}
+
+func (s *notificationService) hostNotice(ctx context.Context, conference entity.Conference) (notice, error) {
+	host, err := s.userSvc.GetUserByID(ctx, conference.HostID)
+	if err != nil {
+		return notice{}, err
+	}
+
+	return notice{
+		recipients:   []entity.User{*host},
+		conferenceID: conference.ID,
+		emailData:    conferenceEmailData(conference.Title, conference.StartsAt),
+	}, nil
+}
+
+func (s *notificationService) attendeesNotice(ctx context.Context, conference entity.Conference) (notice, error) {
+	attendees, err := s.registrationSvc.GetAttendeesByConference(ctx, conference.ID)
+	if err != nil {
+		return notice{}, err
+	}
+
+	return notice{
+		recipients:   attendees,
+		conferenceID: conference.ID,
+		emailData:    conferenceEmailData(conference.Title, conference.StartsAt),
+	}, nil
+}
+
+func (s *notificationService) registrantNotice(ctx context.Context, e event.RegistrationConfirmed) (notice, error) {
+	user, err := s.userSvc.GetUserByID(ctx, e.UserID)
+	if err != nil {
+		return notice{}, err
+	}
+
+	n := notice{
+		recipients:    []entity.User{*user},
+		conferenceID:  e.ConferenceID,
+		title:         "Registration confirmed",
+		message:       fmt.Sprintf("You are registered for \"%s\".", e.ConferenceTitle),
+		emailSubject:  "[Conference App] Registration Confirmed",
+		emailTemplate: "registration_confirmed.html",
+		emailData:     conferenceEmailData(e.ConferenceTitle, e.StartsAt),
+	}
+
+	if e.FromWaitlist {
+		n.message = fmt.Sprintf("A seat opened up and you are now registered for \"%s\".", e.ConferenceTitle)
+		n.emailSubject = "[Conference App] You Got a Seat"
+		n.emailTemplate = "waitlist_promoted.html"
+	}
+
+	return n, nil
+}
+
+func (s *notificationService) conflictNotice(ctx context.Context, e event.RegistrationConflict) (notice, error) {
+	user, err := s.userSvc.GetUserByID(ctx, e.UserID)
+	if err != nil {
+		return notice{}, err
+	}
+
+	conflicts := strings.Join(e.ConflictingTitles, ", ")
+
+	n := notice{
+		recipients:   []entity.User{*user},
+		conferenceID: e.ConferenceID,
+		title:        "Schedule conflict",
+		message: fmt.Sprintf("\"%s\" has moved and now clashes with %s. Keep or drop your seat.",
+			e.ConferenceTitle, conflicts),
+		emailSubject:  "[Conference App] Schedule Conflict",
+		emailTemplate: "registration_conflict.html",
+		emailData:     conferenceEmailData(e.ConferenceTitle, e.StartsAt),
+	}
+	n.emailData["conflicts"] = conflicts
+
+	return n, nil
+}
+
+func rejectionReasonOf(review entity.ConferenceReview) string {
+	if review.Reason == nil {
+		return ""
+	}
+	return review.Reason.Description()
+}
+
+func notesOf(review entity.ConferenceReview) string {
+	if review.Notes == nil {
+		return ""
+	}
+	return *review.Notes
+}
+
+func conferenceEmailData(title string, startsAt time.Time) map[string]any {
+	return map[string]any{
+		"title":     title,
+		"starts_at": startsAt.Format(time.RFC1123),
+	}
+}
+
+// deliver records the notice for every recipient, then queues their emails. The notifications are the
+// source of truth, so a failed email is only logged.
+func (s *notificationService) deliver(ctx context.Context, eventName string, n notice) error {
+	if len(n.recipients) == 0 {
+		return nil
+	}
+
+	var conferenceID *uuid.UUID
+	if n.conferenceID != uuid.Nil {
+		conferenceID = &n.conferenceID
+	}
+
+	notifications := make([]entity.Notification, len(n.recipients))
+	for i, recipient := range n.recipients {
+		id, err := s.uuid.NewV7()
+		if err != nil {
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error": err.Error(),
+				"event": eventName,
+			}, "[NotificationService][deliver] Failed to generate UUID")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+
+		notifications[i] = entity.Notification{
+			ID:           id,
+			UserID:       recipient.ID,
+			Type:         eventName,
+			Title:        n.title,
+			Message:      n.message,
+			ConferenceID: conferenceID,
+		}
+	}
+
+	if err := s.repo.CreateNotifications(ctx, notifications); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error": err.Error(),
+			"event": eventName,
+		}, "[NotificationService][deliver] Failed to create notifications")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	for _, recipient := range n.recipients {
+		data := make(map[string]any, len(n.emailData)+1)
+		for k, v := range n.emailData {
+			data[k] = v
+		}
+		data["name"] = recipient.Name
+
+		if err := s.emailSvc.Enqueue(ctx, recipient.Email, n.emailSubject, n.emailTemplate, data); err != nil {
+			log.Error(map[string]interface{}{
+				"error":   err.Error(),
+				"event":   eventName,
+				"user.id": recipient.ID,
+			}, "[NotificationService][deliver] Failed to queue email")
+		}
+	}
+
+	log.Info(map[string]interface{}{
+		"event":      eventName,
+		"recipients": len(n.recipients),
+	}, "[NotificationService][deliver] Notifications delivered")
+
+	return nil
+}
+
+func (s *notificationService) GetNotificationsByUser(ctx context.Context, userID uuid.UUID,
+	lazyReq dto.LazyLoadQuery) ([]dto.NotificationResponse, dto.LazyLoadResponse, error) {
+
+	notifications, lazyResp, err := s.repo.GetNotificationsByUser(ctx, userID, lazyReq)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":   err,
+			"user.id": userID,
+		}, "[NotificationService][GetNotificationsByUser] Failed to get notifications by user")
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		resp[i].PopulateFromEntity(&notification)
+	}
+
+	return resp, lazyResp, nil
+}
@@ -39,6 +39,30 @@ func InitRegistrationHandler(
 		handler.getRegisteredUsersByConference(),
 	)
 
+	registrationGroup.Delete("/conferences/:id",
+		middleware.RequireOneOfRoles(enum.RoleUser),
+		handler.cancelRegistration(),
+	)
+
+	registrationGroup.Post("/conferences/:id/keep",
+		middleware.RequireOneOfRoles(enum.RoleUser),
+		handler.keepRegistration(),
+	)
+
+	registrationGroup.Post("/conferences/:id/waitlist",
+		middleware.RequireOneOfRoles(enum.RoleUser),
+		handler.joinWaitlist(),
+	)
+
+	registrationGroup.Delete("/conferences/:id/waitlist",
+		middleware.RequireOneOfRoles(enum.RoleUser),
+		handler.leaveWaitlist(),
+	)
+
+	registrationGroup.Get("/conferences/:id/waitlist",
+		handler.getWaitlistByConference(),
+	)
+
 	registrationGroup.Get("/users/me",
 		handler.getRegisteredConferencesByUser("me"),
 	)
@@ -120,7 +144,17 @@ func (h *registrationHandler) getRegisteredConferencesByUser(param string) fiber
 
 		includePast := c.Query("include_past") == "true"
 
-		conferences, lazyResp, err := h.svc.GetRegisteredConferencesByUser(c.Context(), userID, includePast, lazyReq)
+		var eventID *uuid.UUID
+		if c.Query("event_id") != "" {
+			id, err2 := uuid.Parse(c.Query("event_id"))
+			if err2 != nil {
+				return errorpkg.ErrFailParseRequest
+			}
+			eventID = &id
+		}
+
+		conferences, lazyResp, err := h.svc.GetRegisteredConferencesByUser(c.Context(), userID, includePast, eventID,
+			lazyReq)
 		if err != nil {
 			return err
 		}
@@ -131,3 +165,99 @@ func (h *registrationHandler) getRegisteredConferencesByUser(param string) fiber
 		})
 	}
 }
+
+func (h *registrationHandler) cancelRegistration() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		conferenceID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		userID, _ := c.Locals("user.id").(uuid.UUID)
+
+		if err = h.svc.CancelRegistration(c.Context(), conferenceID, userID); err != nil {
+			return err
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *registrationHandler) keepRegistration() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		conferenceID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		userID, _ := c.Locals("user.id").(uuid.UUID)
+
+		if err = h.svc.KeepRegistration(c.Context(), conferenceID, userID); err != nil {
+			return err
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *registrationHandler) joinWaitlist() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		conferenceID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		userID, _ := c.Locals("user.id").(uuid.UUID)
+
+		if err = h.svc.JoinWaitlist(c.Context(), conferenceID, userID); err != nil {
+			return err
+		}
+
+		return c.SendStatus(fiber.StatusCreated)
+	}
+}
+
+func (h *registrationHandler) leaveWaitlist() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		conferenceID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		userID, _ := c.Locals("user.id").(uuid.UUID)
+
+		if err = h.svc.LeaveWaitlist(c.Context(), conferenceID, userID); err != nil {
+			return err
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *registrationHandler) getWaitlistByConference() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		conferenceID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var lazyReq dto.LazyLoadQuery
+		if err2 := c.QueryParser(&lazyReq); err2 != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err2 := h.val.ValidateStruct(lazyReq); err2 != nil {
+			return err2
+		}
+
+		entries, lazyResp, err := h.svc.GetWaitlistByConference(c.Context(), conferenceID, lazyReq)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(map[string]interface{}{
+			"waitlist":   entries,
+			"pagination": lazyResp,
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -22,28 +23,68 @@ func NewRegistrationRepository(db *sqlx.DB) contract.IRegistrationRepository {
 	}
 }
 
+func (r *registrationRepository) lockConference(ctx context.Context, tx sqlx.ExtContext,
+	conferenceID uuid.UUID) error {
+
+	// Row lock on the conference serializes every seat allocation of the same conference
+	_, err := tx.ExecContext(ctx, `SELECT id FROM conferences WHERE id = $1 FOR UPDATE`, conferenceID)
+	return err
+}
+
 func (r *registrationRepository) createRegistration(ctx context.Context, tx sqlx.ExtContext,
 	registration *entity.Registration) error {
 
-	_, err := sqlx.NamedExecContext(
+	// Only insert when there is still an available seat.
+	// Must be called after lockConference in the same transaction to be race-free.
+	res, err := tx.ExecContext(
 		ctx,
-		tx,
 		`INSERT INTO registrations (
 			conference_id, user_id
-		) VALUES (
-			:conference_id, :user_id
+		)
+		SELECT c.id, $2
+		FROM conferences c
+		WHERE c.id = $1
+		AND c.seats > (
+			SELECT COUNT(*) FROM registrations
+			WHERE conference_id = c.id
+			AND cancelled_at IS NULL
 		)`,
-		registration,
+		registration.ConferenceID, registration.UserID,
 	)
 	if err != nil {
 		return err
 	}
 
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
 	return nil
 }
 
 func (r *registrationRepository) CreateRegistration(ctx context.Context, registration *entity.Registration) error {
-	return r.createRegistration(ctx, r.db, registration)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err = r.lockConference(ctx, tx, registration.ConferenceID); err != nil {
+		return err
+	}
+
+	if err = r.createRegistration(ctx, tx, registration); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *registrationRepository) GetRegisteredUsersByConference(ctx context.Context,
@@ -54,10 +95,11 @@ func (r *registrationRepository) GetRegisteredUsersByConference(ctx context.Cont
 	args = append(args, conferenceID)
 	argCount := 1
 
-	query := `SELECT id, name FROM users
+	query := `SELECT id, name, email FROM users
         WHERE id IN (
             SELECT user_id FROM registrations
             WHERE conference_id = $1
+            AND cancelled_at IS NULL
         )`
 
 	// Add pagination filters
@@ -91,7 +133,7 @@ func (r *registrationRepository) GetRegisteredUsersByConference(ctx context.Cont
 	// Scan results
 	for rows.Next() {
 		var user entity.User
-		if err2 := rows.Scan(&user.ID, &user.Name); err2 != nil {
+		if err2 := rows.Scan(&user.ID, &user.Name, &user.Email); err2 != nil {
 			return nil, dto.LazyLoadResponse{}, fmt.Errorf("failed to scan user: %w", err2)
 		}
 		users = append(users, user)
@@ -134,8 +176,26 @@ func (r *registrationRepository) GetRegisteredUsersByConference(ctx context.Cont
 	return users, lazyResp, nil
 }
 
+func (r *registrationRepository) GetAttendeesByConference(ctx context.Context,
+	conferenceID uuid.UUID) ([]entity.User, error) {
+
+	var users []entity.User
+	query := `SELECT u.id, u.name, u.email FROM users u
+		JOIN registrations r ON r.user_id = u.id
+		WHERE r.conference_id = $1
+		AND r.cancelled_at IS NULL
+		AND u.deleted_at IS NULL
+		ORDER BY u.id`
+
+	if err := r.db.SelectContext(ctx, &users, query, conferenceID); err != nil {
+		return nil, fmt.Errorf("failed to query attendees: %w", err)
+	}
+
+	return users, nil
+}
+
 func (r *registrationRepository) GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID,
-	includePast bool, lazy dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error) {
+	includePast bool, eventID *uuid.UUID, lazy dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error) {
 
 	var conferences []entity.Conference
 	var args []interface{}
@@ -145,17 +205,28 @@ func (r *registrationRepository) GetRegisteredConferencesByUser(ctx context.Cont
 	query := `SELECT
         c.id, c.title, c.description, c.speaker_name, c.speaker_title,
         c.target_audience, c.prerequisites, c.seats, c.starts_at, c.ends_at,
-        c.host_id, c.status, c.created_at, c.updated_at, u.name AS host_name
+        c.host_id, c.room_id, c.event_id, c.status, c.created_at, c.updated_at, u.name AS host_name,
+        rm.name AS room_name, rm.track AS room_track, e.name AS event_name,
+        r.conflict_flagged_at IS NOT NULL AS schedule_conflict
     FROM conferences c
     JOIN users u ON c.host_id = u.id
+    JOIN rooms rm ON c.room_id = rm.id
+    JOIN events e ON c.event_id = e.id
     JOIN registrations r ON c.id = r.conference_id
-    WHERE r.user_id = $1`
+    WHERE r.user_id = $1
+    AND r.cancelled_at IS NULL`
 
 	// Add filter for past conferences
 	if !includePast {
 		query += fmt.Sprintf(" AND c.ends_at > NOW()")
 	}
 
+	if eventID != nil {
+		query += fmt.Sprintf(" AND c.event_id = $%d", argCount+1)
+		args = append(args, *eventID)
+		argCount++
+	}
+
 	// Add pagination filters
 	if lazy.AfterID != uuid.Nil {
 		query += fmt.Sprintf(" AND c.starts_at > (SELECT starts_at FROM conferences WHERE id = $%d)", argCount+1)
@@ -191,12 +262,15 @@ func (r *registrationRepository) GetRegisteredConferencesByUser(ctx context.Cont
 		if err := rows.Scan(
 			&conf.ID, &conf.Title, &conf.Description, &conf.SpeakerName, &conf.SpeakerTitle,
 			&conf.TargetAudience, &conf.Prerequisites, &conf.Seats, &conf.StartsAt, &conf.EndsAt,
-			&conf.HostID, &conf.Status, &conf.CreatedAt, &conf.UpdatedAt, &hostName,
+			&conf.HostID, &conf.RoomID, &conf.EventID, &conf.Status, &conf.CreatedAt, &conf.UpdatedAt, &hostName,
+			&conf.Room.Name, &conf.Room.Track, &conf.Event.Name, &conf.ScheduleConflict,
 		); err != nil {
 			return nil, dto.LazyLoadResponse{}, fmt.Errorf("failed to scan conference: %w", err)
 		}
 		conf.Host.ID = conf.HostID
 		conf.Host.Name = hostName
+		conf.Room.ID = conf.RoomID
+		conf.Event.ID = conf.EventID
 		conferences = append(conferences, conf)
 	}
 
@@ -248,6 +322,7 @@ func (r *registrationRepository) IsUserRegisteredToConference(ctx context.Contex
     			SELECT 1 FROM registrations
     			WHERE conference_id = $1
     			AND user_id = $2
+    			AND cancelled_at IS NULL
     		)`,
 		conferenceID, userID,
 	); err != nil {
@@ -271,7 +346,9 @@ func (r *registrationRepository) GetConflictingRegistrations(ctx context.Context
         FROM registrations r
         JOIN conferences c ON r.conference_id = c.id
         WHERE r.user_id = $1
+            AND r.cancelled_at IS NULL
             AND c.deleted_at IS NULL
+            AND c.status != 'cancelled'
             AND (
                 ($2 BETWEEN c.starts_at AND c.ends_at)
                 OR
@@ -293,7 +370,7 @@ func (r *registrationRepository) CountRegistrationsByConference(ctx context.Cont
 	if err := r.db.GetContext(
 		ctx,
 		&count,
-		`SELECT COUNT(*) FROM registrations WHERE conference_id = $1`,
+		`SELECT COUNT(*) FROM registrations WHERE conference_id = $1 AND cancelled_at IS NULL`,
 		conferenceID,
 	); err != nil {
 		return 0, err
@@ -301,3 +378,329 @@ func (r *registrationRepository) CountRegistrationsByConference(ctx context.Cont
 
 	return count, nil
 }
+
+func (r *registrationRepository) cancelRegistration(ctx context.Context, tx sqlx.ExtContext, conferenceID,
+	userID, cancelledBy uuid.UUID) error {
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE registrations
+		SET cancelled_at = now(),
+			cancelled_by = $3
+		WHERE conference_id = $1
+		AND user_id = $2
+		AND cancelled_at IS NULL`,
+		conferenceID, userID, cancelledBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *registrationRepository) CancelRegistration(ctx context.Context, conferenceID, userID,
+	cancelledBy uuid.UUID) error {
+	return r.cancelRegistration(ctx, r.db, conferenceID, userID, cancelledBy)
+}
+
+func (r *registrationRepository) GetRegistration(ctx context.Context, conferenceID,
+	userID uuid.UUID) (*entity.Registration, error) {
+
+	var registration entity.Registration
+	if err := r.db.GetContext(
+		ctx,
+		&registration,
+		`SELECT user_id, conference_id, created_at, cancelled_at, cancelled_by, conflict_flagged_at
+		FROM registrations
+		WHERE conference_id = $1
+		AND user_id = $2
+		AND cancelled_at IS NULL`,
+		conferenceID, userID,
+	); err != nil {
+		return nil, err
+	}
+
+	return &registration, nil
+}
+
+func (r *registrationRepository) FlagScheduleConflicts(ctx context.Context, conferenceID uuid.UUID,
+	userIDs []uuid.UUID) error {
+
+	// A registration keeps the time it was first flagged, and is unflagged once it no longer clashes
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE registrations
+		SET conflict_flagged_at = CASE
+			WHEN user_id = ANY($2) THEN COALESCE(conflict_flagged_at, now())
+			ELSE NULL
+		END
+		WHERE conference_id = $1
+		AND cancelled_at IS NULL`,
+		conferenceID, userIDs,
+	)
+	return err
+}
+
+func (r *registrationRepository) ClearScheduleConflict(ctx context.Context, conferenceID, userID uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE registrations
+		SET conflict_flagged_at = NULL
+		WHERE conference_id = $1
+		AND user_id = $2
+		AND cancelled_at IS NULL
+		AND conflict_flagged_at IS NOT NULL`,
+		conferenceID, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *registrationRepository) createWaitlistEntry(ctx context.Context, tx sqlx.ExtContext,
+	entry *entity.WaitlistEntry) error {
+
+	_, err := sqlx.NamedExecContext(
+		ctx,
+		tx,
+		`INSERT INTO waitlist_entries (
+			id, conference_id, user_id
+		) VALUES (
+			:id, :conference_id, :user_id
+		)`,
+		entry,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *registrationRepository) CreateWaitlistEntry(ctx context.Context, entry *entity.WaitlistEntry) error {
+	return r.createWaitlistEntry(ctx, r.db, entry)
+}
+
+func (r *registrationRepository) GetWaitlistByConference(ctx context.Context, conferenceID uuid.UUID,
+	lazy dto.LazyLoadQuery) ([]entity.WaitlistEntry, dto.LazyLoadResponse, error) {
+
+	var entries []entity.WaitlistEntry
+	var args []interface{}
+	args = append(args, conferenceID)
+	argCount := 1
+
+	query := `SELECT w.id, w.user_id, w.conference_id, w.created_at, u.name AS user_name
+        FROM waitlist_entries w
+        JOIN users u ON w.user_id = u.id
+        WHERE w.conference_id = $1
+        AND u.deleted_at IS NULL`
+
+	// Add pagination filters
+	if lazy.AfterID != uuid.Nil {
+		query += fmt.Sprintf(" AND w.id > $%d", argCount+1)
+		args = append(args, lazy.AfterID)
+		argCount++
+	}
+	if lazy.BeforeID != uuid.Nil {
+		query += fmt.Sprintf(" AND w.id < $%d", argCount+1)
+		args = append(args, lazy.BeforeID)
+		argCount++
+	}
+
+	// Add ordering and limit. Entry IDs are UUIDv7, so ordering by ID is ordering by join time.
+	if lazy.BeforeID != uuid.Nil {
+		query += " ORDER BY w.id DESC"
+	} else {
+		query += " ORDER BY w.id ASC"
+	}
+	query += fmt.Sprintf(" LIMIT $%d", argCount+1)
+	args = append(args, lazy.Limit+1) // Request one extra record to determine if there are more results
+
+	// Execute query
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, dto.LazyLoadResponse{}, fmt.Errorf("failed to query waitlist entries: %w", err)
+	}
+	defer rows.Close()
+
+	// Scan results
+	for rows.Next() {
+		var entry entity.WaitlistEntry
+		var userName string
+		if err2 := rows.Scan(&entry.ID, &entry.UserID, &entry.ConferenceID, &entry.CreatedAt,
+			&userName); err2 != nil {
+			return nil, dto.LazyLoadResponse{}, fmt.Errorf("failed to scan waitlist entry: %w", err2)
+		}
+		entry.User = &entity.User{
+			ID:   entry.UserID,
+			Name: userName,
+		}
+		entries = append(entries, entry)
+	}
+
+	if err2 := rows.Err(); err2 != nil {
+		return nil, dto.LazyLoadResponse{}, fmt.Errorf("error iterating waitlist entries: %w", err2)
+	}
+
+	// Prepare response
+	lazyResp := dto.LazyLoadResponse{
+		HasMore: false,
+		FirstID: nil,
+		LastID:  nil,
+	}
+
+	if len(entries) > 0 {
+		// Check if we got an extra record
+		if len(entries) > lazy.Limit {
+			lazyResp.HasMore = true
+			if lazy.BeforeID != uuid.Nil {
+				entries = entries[1:] // Remove first record when paginating backwards
+			} else {
+				entries = entries[:lazy.Limit] // Remove last record when paginating forwards
+			}
+		}
+
+		// For BeforeID, reverse the final result set to maintain ascending order
+		if lazy.BeforeID != uuid.Nil {
+			for i := 0; i < len(entries)/2; i++ {
+				j := len(entries) - 1 - i
+				entries[i], entries[j] = entries[j], entries[i]
+			}
+		}
+
+		lazyResp.FirstID = entries[0].ID
+		lazyResp.LastID = entries[len(entries)-1].ID
+	}
+
+	return entries, lazyResp, nil
+}
+
+func (r *registrationRepository) GetFirstWaitlistEntry(ctx context.Context,
+	conferenceID uuid.UUID) (*entity.WaitlistEntry, error) {
+
+	var row struct {
+		ID           uuid.UUID `db:"id"`
+		UserID       uuid.UUID `db:"user_id"`
+		ConferenceID uuid.UUID `db:"conference_id"`
+		CreatedAt    time.Time `db:"created_at"`
+		UserName     string    `db:"user_name"`
+		UserEmail    string    `db:"user_email"`
+	}
+
+	err := r.db.GetContext(ctx, &row, `
+		SELECT w.id, w.user_id, w.conference_id, w.created_at, u.name AS user_name, u.email AS user_email
+		FROM waitlist_entries w
+		JOIN users u ON w.user_id = u.id
+		WHERE w.conference_id = $1
+		AND u.deleted_at IS NULL
+		ORDER BY w.id
+		LIMIT 1`,
+		conferenceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.WaitlistEntry{
+		ID:           row.ID,
+		UserID:       row.UserID,
+		ConferenceID: row.ConferenceID,
+		CreatedAt:    row.CreatedAt,
+		User: &entity.User{
+			ID:    row.UserID,
+			Name:  row.UserName,
+			Email: row.UserEmail,
+		},
+	}, nil
+}
+
+func (r *registrationRepository) IsUserInWaitlist(ctx context.Context, conferenceID,
+	userID uuid.UUID) (bool, error) {
+
+	var exists bool
+	if err := r.db.GetContext(
+		ctx,
+		&exists,
+		`SELECT EXISTS (
+    			SELECT 1 FROM waitlist_entries
+    			WHERE conference_id = $1
+    			AND user_id = $2
+    		)`,
+		conferenceID, userID,
+	); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *registrationRepository) deleteWaitlistEntry(ctx context.Context, tx sqlx.ExtContext, conferenceID,
+	userID uuid.UUID) error {
+
+	res, err := tx.ExecContext(ctx,
+		`DELETE FROM waitlist_entries WHERE conference_id = $1 AND user_id = $2`, conferenceID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *registrationRepository) DeleteWaitlistEntry(ctx context.Context, conferenceID, userID uuid.UUID) error {
+	return r.deleteWaitlistEntry(ctx, r.db, conferenceID, userID)
+}
+
+func (r *registrationRepository) PromoteWaitlistEntry(ctx context.Context, entry *entity.WaitlistEntry) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err = r.lockConference(ctx, tx, entry.ConferenceID); err != nil {
+		return err
+	}
+
+	// Removing the entry first makes a concurrent promotion of the same entry fail with sql.ErrNoRows
+	if err = r.deleteWaitlistEntry(ctx, tx, entry.ConferenceID, entry.UserID); err != nil {
+		return err
+	}
+
+	if err = r.createRegistration(ctx, tx, &entity.Registration{
+		ConferenceID: entry.ConferenceID,
+		UserID:       entry.UserID,
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -2,27 +2,39 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/nathakusuma/conference-backend/domain/contract"
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
 	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/domain/event"
+	"github.com/nathakusuma/conference-backend/pkg/eventbus"
 	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/nathakusuma/conference-backend/pkg/metrics"
+	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
 	"time"
 )
 
 type registrationService struct {
 	r             contract.IRegistrationRepository
 	conferenceSvc contract.IConferenceService
+	eventBus      eventbus.IEventBus
+	uuid          uuidpkg.IUUID
 }
 
 func NewRegistrationService(registrationRepository contract.IRegistrationRepository,
-	conferenceService contract.IConferenceService) contract.IRegistrationService {
+	conferenceService contract.IConferenceService, eventBus eventbus.IEventBus,
+	uuid uuidpkg.IUUID) contract.IRegistrationService {
 
 	return &registrationService{
 		r:             registrationRepository,
 		conferenceSvc: conferenceService,
+		eventBus:      eventBus,
+		uuid:          uuid,
 	}
 }
 
@@ -32,7 +44,11 @@ func (s *registrationService) Register(ctx context.Context, conferenceID, userID
 	if err != nil {
 		return err
 	}
-	// At this point, conference must have been approved, because it's checked in the GetConferenceByID method
+	// At this point, conference must have been approved or cancelled, because it's checked in the
+	// GetConferenceByID method
+	if conference.Status == enum.ConferenceCancelled {
+		return errorpkg.ErrConferenceCancelled
+	}
 
 	// Is user host of conference?
 	if conference.Host.ID == userID {
@@ -95,6 +111,17 @@ func (s *registrationService) Register(ctx context.Context, conferenceID, userID
 		ConferenceID: conferenceID,
 		UserID:       userID,
 	}); err != nil {
+		// The last seat was taken by a concurrent registration
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrConferenceFull
+		}
+
+		// A concurrent request of the same user has registered first
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "registrations_user_id_conference_id_active_key" {
+			return errorpkg.ErrUserAlreadyRegisteredToConference
+		}
+
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":        err,
 			"conferenceID": conferenceID,
@@ -103,9 +130,33 @@ func (s *registrationService) Register(ctx context.Context, conferenceID, userID
 		return errorpkg.ErrInternalServer.WithTraceID(traceID)
 	}
 
+	metrics.Registrations.WithLabelValues("direct").Inc()
+
+	s.eventBus.Publish(ctx, event.RegistrationConfirmed{
+		ConferenceID:    conferenceID,
+		ConferenceTitle: conference.Title,
+		StartsAt:        *conference.StartsAt,
+		UserID:          userID,
+	})
+
 	return nil
 }
 
+func (s *registrationService) GetAttendeesByConference(ctx context.Context,
+	conferenceID uuid.UUID) ([]entity.User, error) {
+
+	users, err := s.r.GetAttendeesByConference(ctx, conferenceID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+		}, "[RegistrationService][GetAttendeesByConference] Failed to get attendees by conference")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return users, nil
+}
+
 func (s *registrationService) GetRegisteredUsersByConference(ctx context.Context,
 	conferenceID uuid.UUID, lazyReq dto.LazyLoadQuery) ([]dto.UserResponse, dto.LazyLoadResponse, error) {
 	if lazyReq.AfterID != uuid.Nil && lazyReq.BeforeID != uuid.Nil {
@@ -147,7 +198,7 @@ func (s *registrationService) GetRegisteredUsersByConference(ctx context.Context
 }
 
 func (s *registrationService) GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID,
-	includePast bool, lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error) {
+	includePast bool, eventID *uuid.UUID, lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error) {
 
 	if lazyReq.AfterID != uuid.Nil && lazyReq.BeforeID != uuid.Nil {
 		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInvalidPagination
@@ -160,7 +211,7 @@ func (s *registrationService) GetRegisteredConferencesByUser(ctx context.Context
 		return nil, dto.LazyLoadResponse{}, errorpkg.ErrForbiddenUser
 	}
 
-	conferences, lazyResp, err := s.r.GetRegisteredConferencesByUser(ctx, userID, includePast, lazyReq)
+	conferences, lazyResp, err := s.r.GetRegisteredConferencesByUser(ctx, userID, includePast, eventID, lazyReq)
 	if err != nil {
 		traceID := log.ErrorWithTraceID(map[string]interface{}{
 			"error":        err,
@@ -195,3 +246,424 @@ func (s *registrationService) IsUserRegisteredToConference(ctx context.Context,
 
 	return ok, nil
 }
+
+func (s *registrationService) CancelRegistration(ctx context.Context, conferenceID, userID uuid.UUID) error {
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+
+	conference, err := s.conferenceSvc.GetConferenceByID(ctx, conferenceID)
+	if err != nil {
+		return err
+	}
+
+	// Is the conference already started?
+	now := time.Now()
+	if conference.StartsAt.Before(now) {
+		return errorpkg.ErrConferenceStarted
+	}
+
+	// Has the host-defined cutoff passed? A registration that a reschedule made clash may still be dropped.
+	if conference.CancellationCutoff != nil && conference.CancellationCutoff.Before(now) {
+		registration, err2 := s.r.GetRegistration(ctx, conferenceID, userID)
+		if err2 != nil {
+			if errors.Is(err2, sql.ErrNoRows) {
+				return errorpkg.ErrUserNotRegisteredToConference
+			}
+
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error":        err2,
+				"conferenceID": conferenceID,
+				"userID":       userID,
+				"requester.id": requesterID,
+			}, "[RegistrationService][CancelRegistration] Failed to get registration")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+
+		if registration.ConflictFlaggedAt == nil {
+			return errorpkg.ErrCancellationCutoffPassed
+		}
+	}
+
+	if err = s.r.CancelRegistration(ctx, conferenceID, userID, requesterID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrUserNotRegisteredToConference
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+			"userID":       userID,
+			"requester.id": requesterID,
+		}, "[RegistrationService][CancelRegistration] Failed to cancel registration")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"conferenceID": conferenceID,
+		"userID":       userID,
+		"requester.id": requesterID,
+	}, "[RegistrationService][CancelRegistration] Registration cancelled")
+
+	// The seat is freed regardless of the promotion result. Failures are already logged inside.
+	_ = s.PromoteFromWaitlist(ctx, conferenceID)
+
+	return nil
+}
+
+func (s *registrationService) KeepRegistration(ctx context.Context, conferenceID, userID uuid.UUID) error {
+	if err := s.r.ClearScheduleConflict(ctx, conferenceID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNoScheduleConflict
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+			"userID":       userID,
+		}, "[RegistrationService][KeepRegistration] Failed to clear schedule conflict")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"conferenceID": conferenceID,
+		"userID":       userID,
+	}, "[RegistrationService][KeepRegistration] Registration kept despite schedule conflict")
+
+	return nil
+}
+
+func (s *registrationService) HandleEvent(ctx context.Context, e event.Event) error {
+	if e, ok := e.(event.ConferenceRescheduled); ok {
+		return s.flagScheduleConflicts(ctx, e.Conference)
+	}
+
+	return nil
+}
+
+// flagScheduleConflicts flags every registrant of the rescheduled conference whose other registrations now
+// clash with it, and tells them so they can keep or drop their seat.
+func (s *registrationService) flagScheduleConflicts(ctx context.Context, conference entity.Conference) error {
+	attendees, err := s.r.GetAttendeesByConference(ctx, conference.ID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conference.ID,
+		}, "[RegistrationService][flagScheduleConflicts] Failed to get attendees by conference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	var flagged []uuid.UUID
+	var conflicts []event.RegistrationConflict
+	for _, attendee := range attendees {
+		registrations, err2 := s.r.GetConflictingRegistrations(ctx, attendee.ID, conference.StartsAt,
+			conference.EndsAt)
+		if err2 != nil {
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error":        err2,
+				"conferenceID": conference.ID,
+				"userID":       attendee.ID,
+			}, "[RegistrationService][flagScheduleConflicts] Failed to get conflicting registrations")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+
+		// The rescheduled conference always overlaps itself
+		var titles []string
+		for _, registration := range registrations {
+			if registration.ID != conference.ID {
+				titles = append(titles, registration.Title)
+			}
+		}
+		if len(titles) == 0 {
+			continue
+		}
+
+		flagged = append(flagged, attendee.ID)
+		conflicts = append(conflicts, event.RegistrationConflict{
+			ConferenceID:      conference.ID,
+			ConferenceTitle:   conference.Title,
+			StartsAt:          conference.StartsAt,
+			UserID:            attendee.ID,
+			ConflictingTitles: titles,
+		})
+	}
+
+	if err = s.r.FlagScheduleConflicts(ctx, conference.ID, flagged); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conference.ID,
+		}, "[RegistrationService][flagScheduleConflicts] Failed to flag schedule conflicts")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"conferenceID": conference.ID,
+		"flagged":      len(flagged),
+	}, "[RegistrationService][flagScheduleConflicts] Schedule conflicts flagged")
+
+	for _, conflict := range conflicts {
+		s.eventBus.Publish(ctx, conflict)
+	}
+
+	return nil
+}
+
+func (s *registrationService) JoinWaitlist(ctx context.Context, conferenceID, userID uuid.UUID) error {
+	conference, err := s.conferenceSvc.GetConferenceByID(ctx, conferenceID)
+	if err != nil {
+		return err
+	}
+
+	if conference.Status == enum.ConferenceCancelled {
+		return errorpkg.ErrConferenceCancelled
+	}
+
+	if conference.Host.ID == userID {
+		return errorpkg.ErrHostCannotRegister
+	}
+
+	if conference.EndsAt.Before(time.Now()) {
+		return errorpkg.ErrConferenceEnded
+	}
+
+	// Is user registered to conference?
+	isRegistered, err := s.r.IsUserRegisteredToConference(ctx, conferenceID, userID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+			"userID":       userID,
+		}, "[RegistrationService][JoinWaitlist] Failed to check if user is registered to conference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+	if isRegistered {
+		return errorpkg.ErrUserAlreadyRegisteredToConference
+	}
+
+	// Is user already waiting?
+	isWaiting, err := s.r.IsUserInWaitlist(ctx, conferenceID, userID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+			"userID":       userID,
+		}, "[RegistrationService][JoinWaitlist] Failed to check if user is in waitlist")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+	if isWaiting {
+		return errorpkg.ErrUserAlreadyInWaitlist
+	}
+
+	// Waitlist is only for full conferences
+	taken, err := s.r.CountRegistrationsByConference(ctx, conferenceID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+		}, "[RegistrationService][JoinWaitlist] Failed to count registrations by conference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+	if taken < conference.Seats {
+		return errorpkg.ErrConferenceNotFull
+	}
+
+	conflictingRegistrations, err := s.r.GetConflictingRegistrations(ctx, userID, *conference.StartsAt,
+		*conference.EndsAt)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+			"userID":       userID,
+		}, "[RegistrationService][JoinWaitlist] Failed to get conflicting registrations")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+	if len(conflictingRegistrations) > 0 {
+		return errorpkg.ErrConflictingRegistrations.WithDetail(map[string]interface{}{
+			"conferences": conflictingRegistrations,
+		})
+	}
+
+	entryID, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+			"userID":       userID,
+		}, "[RegistrationService][JoinWaitlist] Failed to generate waitlist entry ID")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	entry := &entity.WaitlistEntry{
+		ID:           entryID,
+		ConferenceID: conferenceID,
+		UserID:       userID,
+	}
+
+	if err = s.r.CreateWaitlistEntry(ctx, entry); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "waitlist_entries_user_id_conference_id_key" {
+			return errorpkg.ErrUserAlreadyInWaitlist
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error": err,
+			"entry": entry,
+		}, "[RegistrationService][JoinWaitlist] Failed to create waitlist entry")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"entry": entry,
+	}, "[RegistrationService][JoinWaitlist] User joined waitlist")
+
+	return nil
+}
+
+func (s *registrationService) LeaveWaitlist(ctx context.Context, conferenceID, userID uuid.UUID) error {
+	if err := s.r.DeleteWaitlistEntry(ctx, conferenceID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrUserNotInWaitlist
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+			"userID":       userID,
+		}, "[RegistrationService][LeaveWaitlist] Failed to delete waitlist entry")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"conferenceID": conferenceID,
+		"userID":       userID,
+	}, "[RegistrationService][LeaveWaitlist] User left waitlist")
+
+	return nil
+}
+
+func (s *registrationService) GetWaitlistByConference(ctx context.Context, conferenceID uuid.UUID,
+	lazyReq dto.LazyLoadQuery) ([]dto.WaitlistEntryResponse, dto.LazyLoadResponse, error) {
+
+	if lazyReq.AfterID != uuid.Nil && lazyReq.BeforeID != uuid.Nil {
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInvalidPagination
+	}
+
+	requesterID, _ := ctx.Value("user.id").(uuid.UUID)
+	requesterRole, _ := ctx.Value("user.role").(enum.UserRole)
+
+	conference, err := s.conferenceSvc.GetConferenceByID(ctx, conferenceID)
+	if err != nil {
+		return nil, dto.LazyLoadResponse{}, err
+	}
+
+	if requesterRole == enum.RoleUser && requesterID != conference.Host.ID {
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrForbiddenUser
+	}
+
+	entries, lazyResp, err := s.r.GetWaitlistByConference(ctx, conferenceID, lazyReq)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+			"requester.id": requesterID,
+		}, "[RegistrationService][GetWaitlistByConference] Failed to get waitlist by conference")
+		return nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.WaitlistEntryResponse, len(entries))
+	for i, entry := range entries {
+		resp[i].PopulateFromEntity(&entry)
+	}
+
+	return resp, lazyResp, nil
+}
+
+func (s *registrationService) PromoteFromWaitlist(ctx context.Context, conferenceID uuid.UUID) error {
+	conference, err := s.conferenceSvc.GetConferenceByID(ctx, conferenceID)
+	if err != nil {
+		return err
+	}
+
+	// Nobody wants a seat in a conference that has ended or won't take place
+	if conference.EndsAt.Before(time.Now()) || conference.Status == enum.ConferenceCancelled {
+		return nil
+	}
+
+	taken, err := s.r.CountRegistrationsByConference(ctx, conferenceID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"conferenceID": conferenceID,
+		}, "[RegistrationService][PromoteFromWaitlist] Failed to count registrations by conference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	for taken < conference.Seats {
+		entry, err := s.r.GetFirstWaitlistEntry(ctx, conferenceID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error":        err,
+				"conferenceID": conferenceID,
+			}, "[RegistrationService][PromoteFromWaitlist] Failed to get first waitlist entry")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+
+		// The user may have registered to a clashing conference while waiting. Drop them and try the next one.
+		conflictingRegistrations, err := s.r.GetConflictingRegistrations(ctx, entry.UserID, *conference.StartsAt,
+			*conference.EndsAt)
+		if err != nil {
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error": err,
+				"entry": entry,
+			}, "[RegistrationService][PromoteFromWaitlist] Failed to get conflicting registrations")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+
+		if len(conflictingRegistrations) > 0 {
+			if err = s.r.DeleteWaitlistEntry(ctx, conferenceID, entry.UserID); err != nil &&
+				!errors.Is(err, sql.ErrNoRows) {
+				traceID := log.ErrorWithTraceID(map[string]interface{}{
+					"error": err,
+					"entry": entry,
+				}, "[RegistrationService][PromoteFromWaitlist] Failed to delete conflicting waitlist entry")
+				return errorpkg.ErrInternalServer.WithTraceID(traceID)
+			}
+
+			log.Info(map[string]interface{}{
+				"entry": entry,
+			}, "[RegistrationService][PromoteFromWaitlist] Waitlist entry dropped due to conflicting registrations")
+			continue
+		}
+
+		if err = s.r.PromoteWaitlistEntry(ctx, entry); err != nil {
+			// Somebody else promoted this entry first, or the seat was taken by a concurrent registration
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error": err,
+				"entry": entry,
+			}, "[RegistrationService][PromoteFromWaitlist] Failed to promote waitlist entry")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+		taken++
+		metrics.Registrations.WithLabelValues("waitlist").Inc()
+
+		log.Info(map[string]interface{}{
+			"entry": entry,
+		}, "[RegistrationService][PromoteFromWaitlist] User promoted from waitlist")
+
+		s.eventBus.Publish(ctx, event.RegistrationConfirmed{
+			ConferenceID:    conferenceID,
+			ConferenceTitle: conference.Title,
+			StartsAt:        *conference.StartsAt,
+			UserID:          entry.UserID,
+			FromWaitlist:    true,
+		})
+	}
+
+	return nil
+}
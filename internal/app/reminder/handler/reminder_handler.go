@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/middleware"
+	"github.com/nathakusuma/conference-backend/pkg/validator"
+)
+
+type reminderHandler struct {
+	svc contract.IReminderService
+	val validator.IValidator
+}
+
+func InitReminderHandler(
+	router fiber.Router,
+	midw *middleware.Middleware,
+	val validator.IValidator,
+	reminderSvc contract.IReminderService,
+) {
+	handler := reminderHandler{
+		svc: reminderSvc,
+		val: val,
+	}
+
+	reminderGroup := router.Group("/reminders/preferences")
+	reminderGroup.Use(midw.RequireAuthenticated())
+
+	reminderGroup.Get("",
+		handler.getPreferences(),
+	)
+
+	reminderGroup.Put("/:type",
+		handler.updatePreference(),
+	)
+}
+
+func (h *reminderHandler) getPreferences() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, _ := c.Locals("user.id").(uuid.UUID)
+
+		preferences, err := h.svc.GetPreferences(c.Context(), userID)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(map[string]interface{}{
+			"preferences": preferences,
+		})
+	}
+}
+
+func (h *reminderHandler) updatePreference() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req dto.UpdateReminderPreferenceRequest
+		if err := c.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err := h.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		userID, _ := c.Locals("user.id").(uuid.UUID)
+
+		if err := h.svc.UpdatePreference(c.Context(), userID, c.Params("type"), *req.Enabled); err != nil {
+			return err
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
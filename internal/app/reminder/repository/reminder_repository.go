@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+)
+
+type reminderRepository struct {
+	db *sqlx.DB
+}
+
+func NewReminderRepository(db *sqlx.DB) contract.IReminderRepository {
+	return &reminderRepository{
+		db: db,
+	}
+}
+
+func (r *reminderRepository) claimReminder(ctx context.Context, tx sqlx.ExtContext,
+	conferenceID, userID uuid.UUID, reminderType string) (bool, error) {
+
+	// The primary key makes a second claim a no-op, so restarts and concurrent schedulers can't send twice
+	query := `INSERT INTO reminder_deliveries (conference_id, user_id, reminder_type)
+		SELECT $1::uuid, $2::uuid, $3::varchar
+		WHERE NOT EXISTS (
+			SELECT 1 FROM reminder_opt_outs
+			WHERE user_id = $2 AND reminder_type = $3
+		)
+		ON CONFLICT DO NOTHING`
+
+	res, err := tx.ExecContext(ctx, query, conferenceID, userID, reminderType)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim reminder: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (r *reminderRepository) ClaimReminder(ctx context.Context, conferenceID, userID uuid.UUID,
+	reminderType string) (bool, error) {
+
+	return r.claimReminder(ctx, r.db, conferenceID, userID, reminderType)
+}
+
+func (r *reminderRepository) releaseReminder(ctx context.Context, tx sqlx.ExtContext,
+	conferenceID, userID uuid.UUID, reminderType string) error {
+
+	query := `DELETE FROM reminder_deliveries
+		WHERE conference_id = $1 AND user_id = $2 AND reminder_type = $3`
+
+	res, err := tx.ExecContext(ctx, query, conferenceID, userID, reminderType)
+	if err != nil {
+		return fmt.Errorf("failed to release reminder: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *reminderRepository) ReleaseReminder(ctx context.Context, conferenceID, userID uuid.UUID,
+	reminderType string) error {
+
+	return r.releaseReminder(ctx, r.db, conferenceID, userID, reminderType)
+}
+
+func (r *reminderRepository) GetOptOutsByUser(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	var reminderTypes []string
+	query := `SELECT reminder_type FROM reminder_opt_outs WHERE user_id = $1`
+
+	if err := r.db.SelectContext(ctx, &reminderTypes, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to query reminder opt-outs: %w", err)
+	}
+
+	return reminderTypes, nil
+}
+
+func (r *reminderRepository) createOptOut(ctx context.Context, tx sqlx.ExtContext,
+	userID uuid.UUID, reminderType string) error {
+
+	query := `INSERT INTO reminder_opt_outs (user_id, reminder_type)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING`
+	_, err := tx.ExecContext(ctx, query, userID, reminderType)
+	return err
+}
+
+func (r *reminderRepository) CreateOptOut(ctx context.Context, userID uuid.UUID, reminderType string) error {
+	return r.createOptOut(ctx, r.db, userID, reminderType)
+}
+
+func (r *reminderRepository) deleteOptOut(ctx context.Context, tx sqlx.ExtContext,
+	userID uuid.UUID, reminderType string) error {
+
+	query := `DELETE FROM reminder_opt_outs WHERE user_id = $1 AND reminder_type = $2`
+	_, err := tx.ExecContext(ctx, query, userID, reminderType)
+	return err
+}
+
+func (r *reminderRepository) DeleteOptOut(ctx context.Context, userID uuid.UUID, reminderType string) error {
+	return r.deleteOptOut(ctx, r.db, userID, reminderType)
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/pkg/background"
+)
+
+const reminderInterval = time.Minute
+
+// StartReminderScheduler starts the job that queues the reminders due every minute. It stops once ctx is done,
+// after finishing the run at hand.
+func StartReminderScheduler(ctx context.Context, svc contract.IReminderService) {
+	background.Go("reminder.scheduler", func() {
+		runReminderScheduler(ctx, svc)
+	})
+}
+
+func runReminderScheduler(ctx context.Context, svc contract.IReminderService) {
+	ticker := time.NewTicker(reminderInterval)
+	defer ticker.Stop()
+
+	// A run is finished even during shutdown, every reminder it claims is queued or given back
+	runCtx := context.WithoutCancel(ctx)
+
+	for {
+		// Errors are already logged by the service, the run is simply retried on the next tick
+		_, _ = svc.SendDueReminders(runCtx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/nathakusuma/conference-backend/pkg/metrics"
+)
+
+const reminderBatchSize = 100
+
+type reminderService struct {
+	repo            contract.IReminderRepository
+	conferenceSvc   contract.IConferenceService
+	registrationSvc contract.IRegistrationService
+	emailSvc        contract.IEmailService
+	windows         []time.Duration
+}
+
+// NewReminderService creates the reminder service. A reminder is sent when a conference is at most one
+// of the windows away from starting, e.g. 24h and 1h before.
+func NewReminderService(
+	reminderRepository contract.IReminderRepository,
+	conferenceService contract.IConferenceService,
+	registrationService contract.IRegistrationService,
+	emailService contract.IEmailService,
+	windows []time.Duration,
+) contract.IReminderService {
+	sorted := slices.Clone(windows)
+	slices.Sort(sorted)
+
+	return &reminderService{
+		repo:            reminderRepository,
+		conferenceSvc:   conferenceService,
+		registrationSvc: registrationService,
+		emailSvc:        emailService,
+		windows:         slices.Compact(sorted),
+	}
+}
+
+// reminderTypeOf names the reminder of a window, e.g. 24h or 1h30m.
+func reminderTypeOf(window time.Duration) string {
+	s := window.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// startsIn describes a window for the email, e.g. 24 hours.
+func startsIn(window time.Duration) string {
+	switch {
+	case window%time.Hour == 0:
+		return pluralize(int(window/time.Hour), "hour")
+	case window < time.Hour && window%time.Minute == 0:
+		return pluralize(int(window/time.Minute), "minute")
+	default:
+		return reminderTypeOf(window)
+	}
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+func (s *reminderService) SendDueReminders(ctx context.Context) (int, error) {
+	now := time.Now()
+	queued := 0
+
+	// Each window only covers conferences the next smaller window doesn't, so someone who registers
+	// 30 minutes before the start gets the 1h reminder only, not the 24h one as well
+	var previous time.Duration
+	for _, window := range s.windows {
+		from := now.Add(previous)
+		to := now.Add(window)
+		previous = window
+
+		conferences, err := s.getConferencesStartingBetween(ctx, from, to)
+		if err != nil {
+			return queued, err
+		}
+
+		for _, conference := range conferences {
+			// A failing conference is already logged, it's retried on the next run
+			n, _ := s.remindRegistrants(ctx, conference, window)
+			queued += n
+		}
+	}
+
+	if queued > 0 {
+		log.Info(map[string]interface{}{
+			"queued": queued,
+		}, "[ReminderService][SendDueReminders] Reminders queued")
+	}
+
+	return queued, nil
+}
+
+func (s *reminderService) getConferencesStartingBetween(ctx context.Context,
+	from, to time.Time) ([]dto.ConferenceResponse, error) {
+
+	var conferences []dto.ConferenceResponse
+	query := &dto.GetConferenceQuery{
+		Limit:        reminderBatchSize,
+		Status:       enum.ConferenceApproved,
+		StartsAfter:  &from,
+		StartsBefore: &to,
+		OrderBy:      "starts_at",
+		Order:        "asc",
+	}
+
+	for {
+		page, lazy, err := s.conferenceSvc.GetConferences(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		conferences = append(conferences, page...)
+
+		if !lazy.HasMore || len(page) == 0 {
+			return conferences, nil
+		}
+		lastID := page[len(page)-1].ID
+		query.AfterID = &lastID
+	}
+}
+
+func (s *reminderService) remindRegistrants(ctx context.Context, conference dto.ConferenceResponse,
+	window time.Duration) (int, error) {
+
+	reminderType := reminderTypeOf(window)
+	queued := 0
+	lazy := dto.LazyLoadQuery{Limit: reminderBatchSize}
+
+	for {
+		users, lazyResp, err := s.registrationSvc.GetRegisteredUsersByConference(ctx, conference.ID, lazy)
+		if err != nil {
+			return queued, err
+		}
+
+		for _, user := range users {
+			claimed, err := s.repo.ClaimReminder(ctx, conference.ID, user.ID, reminderType)
+			if err != nil {
+				traceID := log.ErrorWithTraceID(map[string]interface{}{
+					"error":         err.Error(),
+					"conference.id": conference.ID,
+					"user.id":       user.ID,
+					"reminder.type": reminderType,
+				}, "[ReminderService][remindRegistrants] Failed to claim reminder")
+				return queued, errorpkg.ErrInternalServer.WithTraceID(traceID)
+			}
+			if !claimed {
+				continue
+			}
+
+			err = s.emailSvc.Enqueue(ctx, user.Email,
+				"[Conference App] Conference Reminder",
+				"conference_reminder.html",
+				map[string]any{
+					"name":      user.Name,
+					"title":     conference.Title,
+					"starts_at": conference.StartsAt.Format(time.RFC1123),
+					"starts_in": startsIn(window),
+				})
+			if err != nil {
+				// Give the claim back so the next run tries again
+				if err2 := s.repo.ReleaseReminder(ctx, conference.ID, user.ID, reminderType); err2 != nil {
+					log.Error(map[string]interface{}{
+						"error":         err2.Error(),
+						"conference.id": conference.ID,
+						"user.id":       user.ID,
+						"reminder.type": reminderType,
+					}, "[ReminderService][remindRegistrants] Failed to release reminder")
+				}
+				return queued, err
+			}
+
+			queued++
+			metrics.RemindersQueued.WithLabelValues(reminderType).Inc()
+		}
+
+		if !lazyResp.HasMore || len(users) == 0 {
+			return queued, nil
+		}
+		lazy.AfterID = users[len(users)-1].ID
+	}
+}
+
+func (s *reminderService) GetPreferences(ctx context.Context, userID uuid.UUID) ([]dto.ReminderPreference, error) {
+	optOuts, err := s.repo.GetOptOutsByUser(ctx, userID)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":   err.Error(),
+			"user.id": userID,
+		}, "[ReminderService][GetPreferences] Failed to get reminder opt-outs")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	// Largest window first, the order the reminders arrive in
+	resp := make([]dto.ReminderPreference, 0, len(s.windows))
+	for i := len(s.windows) - 1; i >= 0; i-- {
+		reminderType := reminderTypeOf(s.windows[i])
+		resp = append(resp, dto.ReminderPreference{
+			Type:    reminderType,
+			Enabled: !slices.Contains(optOuts, reminderType),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *reminderService) UpdatePreference(ctx context.Context, userID uuid.UUID, reminderType string,
+	enabled bool) error {
+
+	if !slices.ContainsFunc(s.windows, func(window time.Duration) bool {
+		return reminderTypeOf(window) == reminderType
+	}) {
+		return errorpkg.ErrNotFound.WithMessage("Reminder type not found.")
+	}
+
+	var err error
+	if enabled {
+		err = s.repo.DeleteOptOut(ctx, userID, reminderType)
+	} else {
+		err = s.repo.CreateOptOut(ctx, userID, reminderType)
+	}
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":         err.Error(),
+			"user.id":       userID,
+			"reminder.type": reminderType,
+			"enabled":       enabled,
+		}, "[ReminderService][UpdatePreference] Failed to update reminder preference")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"user.id":       userID,
+		"reminder.type": reminderType,
+		"enabled":       enabled,
+	}, "[ReminderService][UpdatePreference] Reminder preference updated")
+
+	return nil
+}
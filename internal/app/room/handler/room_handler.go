@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/middleware"
+	"github.com/nathakusuma/conference-backend/pkg/validator"
+)
+
+type roomHandler struct {
+	svc contract.IRoomService
+	val validator.IValidator
+}
+
+func InitRoomHandler(
+	router fiber.Router,
+	midw *middleware.Middleware,
+	val validator.IValidator,
+	roomSvc contract.IRoomService,
+) {
+	handler := roomHandler{
+		svc: roomSvc,
+		val: val,
+	}
+
+	roomGroup := router.Group("/rooms")
+	roomGroup.Use(midw.RequireAuthenticated())
+
+	roomGroup.Post("",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionRoomCreated,
+			TargetType: "room",
+			TargetBy:   middleware.TargetByLocal("audit.target_id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleEventCoordinator),
+		handler.createRoom(),
+	)
+	roomGroup.Get("",
+		handler.getRooms(),
+	)
+	roomGroup.Get("/:id",
+		handler.getRoomByID(),
+	)
+	roomGroup.Patch("/:id",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionRoomUpdated,
+			TargetType: "room",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleEventCoordinator),
+		handler.updateRoom(),
+	)
+	roomGroup.Delete("/:id",
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionRoomDeleted,
+			TargetType: "room",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleEventCoordinator),
+		handler.deleteRoom(),
+	)
+}
+
+func (h *roomHandler) createRoom() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var req dto.CreateRoomRequest
+		if err := ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err := h.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		roomID, err := h.svc.CreateRoom(ctx.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		ctx.Locals("audit.target_id", roomID)
+
+		return ctx.Status(fiber.StatusCreated).JSON(map[string]interface{}{
+			"room": dto.RoomResponse{ID: roomID},
+		})
+	}
+}
+
+func (h *roomHandler) getRooms() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var query dto.GetRoomsQuery
+		if err := ctx.QueryParser(&query); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err := h.val.ValidateStruct(query); err != nil {
+			return err
+		}
+
+		rooms, err := h.svc.GetRooms(ctx.Context(), query)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"rooms": rooms,
+		})
+	}
+}
+
+func (h *roomHandler) getRoomByID() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		roomID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		room, err := h.svc.GetRoomByID(ctx.Context(), roomID)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(map[string]interface{}{
+			"room": room,
+		})
+	}
+}
+
+func (h *roomHandler) updateRoom() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		roomID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req dto.UpdateRoomRequest
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err = h.svc.UpdateRoom(ctx.Context(), roomID, req); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (h *roomHandler) deleteRoom() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		roomID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = h.svc.DeleteRoom(ctx.Context(), roomID); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+)
+
+type roomRepository struct {
+	db *sqlx.DB
+}
+
+func NewRoomRepository(db *sqlx.DB) contract.IRoomRepository {
+	return &roomRepository{
+		db: db,
+	}
+}
+
+func (r *roomRepository) CreateRoom(ctx context.Context, room *entity.Room) error {
+	_, err := sqlx.NamedExecContext(
+		ctx,
+		r.db,
+		`INSERT INTO rooms (id, name, capacity, track) VALUES (:id, :name, :capacity, :track)`,
+		room,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *roomRepository) GetRoomByID(ctx context.Context, id uuid.UUID) (*entity.Room, error) {
+	var room entity.Room
+
+	err := r.db.GetContext(ctx, &room, `
+		SELECT id, name, capacity, track, created_at, updated_at, deleted_at
+		FROM rooms
+		WHERE id = $1
+		AND deleted_at IS NULL`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &room, nil
+}
+
+func (r *roomRepository) GetRooms(ctx context.Context, query dto.GetRoomsQuery) ([]entity.Room, error) {
+	statement := `
+		SELECT id, name, capacity, track, created_at, updated_at, deleted_at
+		FROM rooms
+		WHERE deleted_at IS NULL`
+
+	var args []interface{}
+	if query.Track != nil {
+		args = append(args, *query.Track)
+		statement += fmt.Sprintf(" AND track = $%d", len(args))
+	}
+
+	statement += " ORDER BY track NULLS FIRST, name"
+
+	var rooms []entity.Room
+	if err := r.db.SelectContext(ctx, &rooms, statement, args...); err != nil {
+		return nil, fmt.Errorf("failed to query rooms: %w", err)
+	}
+
+	return rooms, nil
+}
+
+func (r *roomRepository) UpdateRoom(ctx context.Context, room *entity.Room) error {
+	res, err := sqlx.NamedExecContext(
+		ctx,
+		r.db,
+		`UPDATE rooms
+		SET name = :name,
+			capacity = :capacity,
+			track = :track,
+			updated_at = now()
+		WHERE id = :id
+		AND deleted_at IS NULL`,
+		room,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *roomRepository) DeleteRoom(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE rooms SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *roomRepository) GetLargestUpcomingSeats(ctx context.Context, roomID uuid.UUID) (int, error) {
+	var seats int
+
+	err := r.db.GetContext(ctx, &seats, `
+		SELECT COALESCE(MAX(seats), 0)
+		FROM conferences
+		WHERE room_id = $1
+		AND deleted_at IS NULL
+		AND status IN ('pending', 'approved')
+		AND ends_at > now()`, roomID)
+	if err != nil {
+		return 0, err
+	}
+
+	return seats, nil
+}
+
+func (r *roomRepository) HasUpcomingConferences(ctx context.Context, roomID uuid.UUID) (bool, error) {
+	var exists bool
+
+	err := r.db.GetContext(ctx, &exists, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM conferences
+			WHERE room_id = $1
+			AND deleted_at IS NULL
+			AND status IN ('pending', 'approved')
+			AND ends_at > now()
+		)`, roomID)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
+)
+
+type roomService struct {
+	r    contract.IRoomRepository
+	uuid uuidpkg.IUUID
+}
+
+func NewRoomService(roomRepo contract.IRoomRepository, uuid uuidpkg.IUUID) contract.IRoomService {
+	return &roomService{r: roomRepo, uuid: uuid}
+}
+
+func (s *roomService) CreateRoom(ctx context.Context, req dto.CreateRoomRequest) (uuid.UUID, error) {
+	requesterID := ctx.Value("user.id")
+
+	roomID, err := s.uuid.NewV7()
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"request":      req,
+			"requester.id": requesterID,
+		}, "[RoomService][CreateRoom] Failed to generate room ID")
+		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	room := entity.Room{
+		ID:       roomID,
+		Name:     req.Name,
+		Capacity: req.Capacity,
+		Track:    req.Track,
+	}
+
+	if err = s.r.CreateRoom(ctx, &room); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "rooms_name_key" {
+			return uuid.Nil, errorpkg.ErrRoomNameTaken
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"request":      req,
+			"requester.id": requesterID,
+		}, "[RoomService][CreateRoom] Failed to create room")
+		return uuid.Nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"room":         room,
+		"requester.id": requesterID,
+	}, "[RoomService][CreateRoom] Room created")
+
+	return roomID, nil
+}
+
+func (s *roomService) getRoomByID(ctx context.Context, id uuid.UUID) (*entity.Room, error) {
+	room, err := s.r.GetRoomByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"room.id":      id,
+			"requester.id": ctx.Value("user.id"),
+		}, "[RoomService][getRoomByID] Failed to get room")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	return room, nil
+}
+
+func (s *roomService) GetRoomByID(ctx context.Context, id uuid.UUID) (*dto.RoomResponse, error) {
+	room, err := s.getRoomByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(dto.RoomResponse).PopulateFromEntity(room), nil
+}
+
+func (s *roomService) GetRooms(ctx context.Context, query dto.GetRoomsQuery) ([]dto.RoomResponse, error) {
+	rooms, err := s.r.GetRooms(ctx, query)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"query":        query,
+			"requester.id": ctx.Value("user.id"),
+		}, "[RoomService][GetRooms] Failed to get rooms")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	resp := make([]dto.RoomResponse, len(rooms))
+	for i, room := range rooms {
+		resp[i].PopulateFromEntity(&room)
+	}
+
+	return resp, nil
+}
+
+func (s *roomService) UpdateRoom(ctx context.Context, id uuid.UUID, req dto.UpdateRoomRequest) error {
+	requesterID := ctx.Value("user.id")
+
+	room, err := s.getRoomByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if req.Name != nil {
+		room.Name = *req.Name
+	}
+	if req.Track != nil {
+		room.Track = req.Track
+	}
+
+	// Shrinking the room must leave space for every conference already planned in it
+	if req.Capacity != nil && *req.Capacity < room.Capacity {
+		seats, err2 := s.r.GetLargestUpcomingSeats(ctx, id)
+		if err2 != nil {
+			traceID := log.ErrorWithTraceID(map[string]interface{}{
+				"error":        err2,
+				"room.id":      id,
+				"requester.id": requesterID,
+			}, "[RoomService][UpdateRoom] Failed to get largest upcoming seats")
+			return errorpkg.ErrInternalServer.WithTraceID(traceID)
+		}
+
+		if seats > *req.Capacity {
+			return errorpkg.ErrRoomCapacityBelowSeats
+		}
+	}
+	if req.Capacity != nil {
+		room.Capacity = *req.Capacity
+	}
+
+	if err = s.r.UpdateRoom(ctx, room); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "rooms_name_key" {
+			return errorpkg.ErrRoomNameTaken
+		}
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"room":         room,
+			"requester.id": requesterID,
+		}, "[RoomService][UpdateRoom] Failed to update room")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"room":         room,
+		"requester.id": requesterID,
+	}, "[RoomService][UpdateRoom] Room updated")
+
+	return nil
+}
+
+func (s *roomService) DeleteRoom(ctx context.Context, id uuid.UUID) error {
+	requesterID := ctx.Value("user.id")
+
+	// Past conferences keep pointing at the deleted room, upcoming ones would be left without a place
+	inUse, err := s.r.HasUpcomingConferences(ctx, id)
+	if err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"room.id":      id,
+			"requester.id": requesterID,
+		}, "[RoomService][DeleteRoom] Failed to check upcoming conferences")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	if inUse {
+		return errorpkg.ErrRoomInUse
+	}
+
+	if err = s.r.DeleteRoom(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err,
+			"room.id":      id,
+			"requester.id": requesterID,
+		}, "[RoomService][DeleteRoom] Failed to delete room")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"room.id":      id,
+		"requester.id": requesterID,
+	}, "[RoomService][DeleteRoom] Room deleted")
+
+	return nil
+}
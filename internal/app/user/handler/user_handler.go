@@ -30,6 +30,11 @@ func InitUserHandler(
 	userGroup := router.Group("/users")
 	userGroup.Post("",
 		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionUserCreated,
+			TargetType: "user",
+			TargetBy:   middleware.TargetByLocal("audit.target_id"),
+		}),
 		midw.RequireOneOfRoles(enum.RoleAdmin),
 		handler.createUser(),
 	)
@@ -47,9 +52,43 @@ func InitUserHandler(
 	)
 	userGroup.Delete("/:id",
 		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionUserDeleted,
+			TargetType: "user",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
 		midw.RequireOneOfRoles(enum.RoleAdmin),
 		handler.deleteUser(),
 	)
+	userGroup.Get("/me/proposal-quota",
+		midw.RequireAuthenticated(),
+		handler.getProposalQuota("me"),
+	)
+	userGroup.Get("/:id/proposal-quota",
+		midw.RequireAuthenticated(),
+		midw.RequireOneOfRoles(enum.RoleAdmin),
+		handler.getProposalQuota("id"),
+	)
+	userGroup.Put("/:id/proposal-quota",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionProposalQuotaSet,
+			TargetType: "user",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin),
+		handler.setProposalQuota(),
+	)
+	userGroup.Delete("/:id/proposal-quota",
+		midw.RequireAuthenticated(),
+		midw.Audit(middleware.AuditConfig{
+			Action:     enum.AuditActionProposalQuotaReset,
+			TargetType: "user",
+			TargetBy:   middleware.TargetByParam("id"),
+		}),
+		midw.RequireOneOfRoles(enum.RoleAdmin),
+		handler.resetProposalQuota(),
+	)
 }
 
 func (c *userHandler) createUser() fiber.Handler {
@@ -68,6 +107,8 @@ func (c *userHandler) createUser() fiber.Handler {
 			return err
 		}
 
+		ctx.Locals("audit.target_id", userID)
+
 		return ctx.Status(fiber.StatusCreated).JSON(map[string]interface{}{
 			"user": dto.UserResponse{ID: userID},
 		})
@@ -138,3 +179,66 @@ func (c *userHandler) deleteUser() fiber.Handler {
 		return ctx.SendStatus(fiber.StatusNoContent)
 	}
 }
+
+func (c *userHandler) getProposalQuota(param string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var userID uuid.UUID
+		if param == "me" {
+			userID = ctx.Locals("user.id").(uuid.UUID)
+		} else {
+			var err error
+			userID, err = uuid.Parse(ctx.Params("id"))
+			if err != nil {
+				return errorpkg.ErrFailParseRequest
+			}
+		}
+
+		quota, err := c.svc.GetProposalQuota(ctx.Context(), userID)
+		if err != nil {
+			return err
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(map[string]interface{}{
+			"proposal_quota": quota,
+		})
+	}
+}
+
+func (c *userHandler) setProposalQuota() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		userID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		var req dto.SetProposalQuotaRequest
+		if err = ctx.BodyParser(&req); err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = c.val.ValidateStruct(req); err != nil {
+			return err
+		}
+
+		if err = c.svc.SetProposalQuota(ctx.Context(), userID, req); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (c *userHandler) resetProposalQuota() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		userID, err := uuid.Parse(ctx.Params("id"))
+		if err != nil {
+			return errorpkg.ErrFailParseRequest
+		}
+
+		if err = c.svc.ResetProposalQuota(ctx.Context(), userID); err != nil {
+			return err
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
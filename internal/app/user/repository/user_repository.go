@@ -113,3 +113,48 @@ func (r *userRepository) deleteUser(ctx context.Context, tx sqlx.ExtContext, id
 func (r *userRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return r.deleteUser(ctx, r.conn, id)
 }
+
+func (r *userRepository) GetProposalQuota(ctx context.Context, userID uuid.UUID) (int, error) {
+	var quota int
+
+	err := r.conn.GetContext(ctx, &quota,
+		`SELECT quota FROM user_proposal_quotas WHERE user_id = $1`, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return quota, nil
+}
+
+func (r *userRepository) SetProposalQuota(ctx context.Context, userID uuid.UUID, quota int) error {
+	_, err := r.conn.ExecContext(ctx, `
+		INSERT INTO user_proposal_quotas (user_id, quota)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET quota = EXCLUDED.quota,
+			updated_at = now()`, userID, quota)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepository) DeleteProposalQuota(ctx context.Context, userID uuid.UUID) error {
+	res, err := r.conn.ExecContext(ctx,
+		`DELETE FROM user_proposal_quotas WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
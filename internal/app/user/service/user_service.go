@@ -6,6 +6,7 @@ import (
 	"errors"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
 	"github.com/nathakusuma/conference-backend/pkg/log"
 	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
@@ -16,21 +17,27 @@ import (
 	"github.com/nathakusuma/conference-backend/pkg/bcrypt"
 )
 
+// Roles missing from the configured quotas keep the single pending proposal they always had
+const defaultProposalQuota = 1
+
 type userService struct {
-	userRepo contract.IUserRepository
-	bcrypt   bcrypt.IBcrypt
-	uuid     uuidpkg.IUUID
+	userRepo       contract.IUserRepository
+	bcrypt         bcrypt.IBcrypt
+	uuid           uuidpkg.IUUID
+	proposalQuotas map[enum.UserRole]int
 }
 
 func NewUserService(
 	userRepo contract.IUserRepository,
 	bcrypt bcrypt.IBcrypt,
 	uuid uuidpkg.IUUID,
+	proposalQuotas map[enum.UserRole]int,
 ) contract.IUserService {
 	return &userService{
-		userRepo: userRepo,
-		bcrypt:   bcrypt,
-		uuid:     uuid,
+		userRepo:       userRepo,
+		bcrypt:         bcrypt,
+		uuid:           uuid,
+		proposalQuotas: proposalQuotas,
 	}
 }
 
@@ -229,3 +236,80 @@ func (s *userService) DeleteUser(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+func (s *userService) GetProposalQuota(ctx context.Context, userID uuid.UUID) (*dto.ProposalQuotaResponse, error) {
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := s.userRepo.GetProposalQuota(ctx, userID)
+	if err == nil {
+		return &dto.ProposalQuotaResponse{UserID: userID, Quota: quota, Overridden: true}, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err.Error(),
+			"user.id":      userID,
+			"requester.id": ctx.Value("user.id"),
+		}, "[UserService][GetProposalQuota] Failed to get proposal quota")
+		return nil, errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	quota, ok := s.proposalQuotas[user.Role]
+	if !ok {
+		quota = defaultProposalQuota
+	}
+
+	return &dto.ProposalQuotaResponse{UserID: userID, Quota: quota}, nil
+}
+
+func (s *userService) SetProposalQuota(ctx context.Context, userID uuid.UUID, req dto.SetProposalQuotaRequest) error {
+	requesterID := ctx.Value("user.id")
+
+	if _, err := s.GetUserByID(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.SetProposalQuota(ctx, userID, *req.Quota); err != nil {
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err.Error(),
+			"user.id":      userID,
+			"requester.id": requesterID,
+		}, "[UserService][SetProposalQuota] Failed to set proposal quota")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"user.id":      userID,
+		"quota":        *req.Quota,
+		"requester.id": requesterID,
+	}, "[UserService][SetProposalQuota] Proposal quota set")
+
+	return nil
+}
+
+func (s *userService) ResetProposalQuota(ctx context.Context, userID uuid.UUID) error {
+	requesterID := ctx.Value("user.id")
+
+	if err := s.userRepo.DeleteProposalQuota(ctx, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorpkg.ErrNotFound
+		}
+
+		traceID := log.ErrorWithTraceID(map[string]interface{}{
+			"error":        err.Error(),
+			"user.id":      userID,
+			"requester.id": requesterID,
+		}, "[UserService][ResetProposalQuota] Failed to reset proposal quota")
+		return errorpkg.ErrInternalServer.WithTraceID(traceID)
+	}
+
+	log.Info(map[string]interface{}{
+		"user.id":      userID,
+		"requester.id": requesterID,
+	}, "[UserService][ResetProposalQuota] Proposal quota reset")
+
+	return nil
+}
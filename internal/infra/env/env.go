@@ -3,37 +3,52 @@ package env
 import (
 	"fmt"
 	"github.com/iamolegga/enviper"
+	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
 
 type Env struct {
-	ServerPort               string        `mapstructure:"SERVER_PORT"`
-	AppEnv                   string        `mapstructure:"APP_ENV"`
-	AppURL                   string        `mapstructure:"APP_URL"`
-	AppName                  string        `mapstructure:"APP_NAME"`
-	DBHost                   string        `mapstructure:"DB_HOST"`
-	DBPort                   string        `mapstructure:"DB_PORT"`
-	DBUser                   string        `mapstructure:"DB_USER"`
-	DBPass                   string        `mapstructure:"DB_PASS"`
-	DBName                   string        `mapstructure:"DB_NAME"`
-	RedisHost                string        `mapstructure:"REDIS_HOST"`
-	RedisPort                string        `mapstructure:"REDIS_PORT"`
-	RedisPass                string        `mapstructure:"REDIS_PASS"`
-	RedisDB                  int           `mapstructure:"REDIS_DB"`
-	JwtAccessSecretKey       []byte        // JWT_ACCESS_SECRET_KEY
-	JwtAccessExpireDuration  time.Duration // JWT_ACCESS_EXPIRE_DURATION
-	JwtRefreshExpireDuration time.Duration // JWT_REFRESH_EXPIRE_DURATION
-	SmtpHost                 string        `mapstructure:"SMTP_HOST"`
-	SmtpPort                 int           `mapstructure:"SMTP_PORT"`
-	SmtpUsername             string        `mapstructure:"SMTP_USERNAME"`
-	SmtpEmail                string        `mapstructure:"SMTP_EMAIL"`
-	SmtpPassword             string        `mapstructure:"SMTP_PASSWORD"`
+	ServerPort               string                `mapstructure:"SERVER_PORT"`
+	AppEnv                   string                `mapstructure:"APP_ENV"`
+	AppURL                   string                `mapstructure:"APP_URL"`
+	AppName                  string                `mapstructure:"APP_NAME"`
+	DBHost                   string                `mapstructure:"DB_HOST"`
+	DBPort                   string                `mapstructure:"DB_PORT"`
+	DBUser                   string                `mapstructure:"DB_USER"`
+	DBPass                   string                `mapstructure:"DB_PASS"`
+	DBName                   string                `mapstructure:"DB_NAME"`
+	RedisHost                string                `mapstructure:"REDIS_HOST"`
+	RedisPort                string                `mapstructure:"REDIS_PORT"`
+	RedisPass                string                `mapstructure:"REDIS_PASS"`
+	RedisDB                  int                   `mapstructure:"REDIS_DB"`
+	JwtAccessSecretKey       []byte                // JWT_ACCESS_SECRET_KEY
+	JwtAccessExpireDuration  time.Duration         // JWT_ACCESS_EXPIRE_DURATION
+	JwtRefreshExpireDuration time.Duration         // JWT_REFRESH_EXPIRE_DURATION
+	SmtpHost                 string                `mapstructure:"SMTP_HOST"`
+	SmtpPort                 int                   `mapstructure:"SMTP_PORT"`
+	SmtpUsername             string                `mapstructure:"SMTP_USERNAME"`
+	SmtpEmail                string                `mapstructure:"SMTP_EMAIL"`
+	SmtpPassword             string                `mapstructure:"SMTP_PASSWORD"`
+	MailTransport            string                `mapstructure:"MAIL_TRANSPORT"`
+	MailFileDir              string                `mapstructure:"MAIL_FILE_DIR"`
+	HealthCheckSMTP          bool                  `mapstructure:"HEALTH_CHECK_SMTP"`
+	ShutdownTimeout          time.Duration         // SHUTDOWN_TIMEOUT
+	ReminderWindows          []time.Duration       // REMINDER_WINDOWS
+	ProposalQuotas           map[enum.UserRole]int // PROPOSAL_QUOTAS
 }
 
+const (
+	defaultShutdownTimeout = 30 * time.Second
+	defaultReminderWindows = "24h,1h"
+	defaultProposalQuotas  = "user:1"
+)
+
 var (
 	viperInstance *viper.Viper
 	env           *Env
@@ -102,7 +117,7 @@ func SetEnv(mockEnv *Env) {
 	env = mockEnv
 }
 
-// Helper function to parse JWT durations
+// Helper function to parse durations
 func parseDurations(env *Env) error {
 	var err error
 
@@ -116,5 +131,40 @@ func parseDurations(env *Env) error {
 		return fmt.Errorf("invalid JWT_REFRESH_EXPIRE_DURATION: %w", err)
 	}
 
+	env.ShutdownTimeout = defaultShutdownTimeout
+	if shutdownTimeout := viperInstance.GetString("SHUTDOWN_TIMEOUT"); shutdownTimeout != "" {
+		env.ShutdownTimeout, err = time.ParseDuration(shutdownTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid SHUTDOWN_TIMEOUT: %w", err)
+		}
+	}
+
+	reminderWindows := viperInstance.GetString("REMINDER_WINDOWS")
+	if reminderWindows == "" {
+		reminderWindows = defaultReminderWindows
+	}
+	env.ReminderWindows = nil
+	for _, window := range strings.Split(reminderWindows, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(window))
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid REMINDER_WINDOWS: %q", window)
+		}
+		env.ReminderWindows = append(env.ReminderWindows, d)
+	}
+
+	proposalQuotas := viperInstance.GetString("PROPOSAL_QUOTAS")
+	if proposalQuotas == "" {
+		proposalQuotas = defaultProposalQuotas
+	}
+	env.ProposalQuotas = make(map[enum.UserRole]int)
+	for _, entry := range strings.Split(proposalQuotas, ",") {
+		role, quota, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		n, err := strconv.Atoi(quota)
+		if !ok || err != nil || n < 0 {
+			return fmt.Errorf("invalid PROPOSAL_QUOTAS: %q", entry)
+		}
+		env.ProposalQuotas[enum.UserRole(role)] = n
+	}
+
 	return nil
 }
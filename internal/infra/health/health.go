@@ -0,0 +1,133 @@
+package health
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+type CheckFunc func(ctx context.Context) error
+
+type DependencyStatus struct {
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+type dependency struct {
+	name  string
+	check CheckFunc
+}
+
+type Checker struct {
+	timeout      time.Duration
+	dependencies []dependency
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+	}
+}
+
+func (c *Checker) Add(name string, check CheckFunc) {
+	c.dependencies = append(c.dependencies, dependency{
+		name:  name,
+		check: check,
+	})
+}
+
+// Check runs every dependency check concurrently, each bounded by the checker timeout.
+// It reports whether all dependencies are up, along with the status of each of them.
+func (c *Checker) Check(ctx context.Context) (bool, map[string]DependencyStatus) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		ready    = true
+		statuses = make(map[string]DependencyStatus, len(c.dependencies))
+	)
+
+	for _, dep := range c.dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := dep.check(checkCtx)
+			status := DependencyStatus{
+				Status:    StatusUp,
+				LatencyMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				status.Status = StatusDown
+				status.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			statuses[dep.name] = status
+			if err != nil {
+				ready = false
+			}
+		}()
+	}
+	wg.Wait()
+
+	return ready, statuses
+}
+
+// Handler serves the readiness probe, answering 503 when any dependency is down.
+func (c *Checker) Handler() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		ready, dependencies := c.Check(ctx.Context())
+		if !ready {
+			return ctx.Status(fiber.StatusServiceUnavailable).JSON(map[string]interface{}{
+				"status":       "not_ready",
+				"dependencies": dependencies,
+			})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(map[string]interface{}{
+			"status":       "ready",
+			"dependencies": dependencies,
+		})
+	}
+}
+
+func PostgresCheck(db *sqlx.DB) CheckFunc {
+	return func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	}
+}
+
+func RedisCheck(rds *redis.Client) CheckFunc {
+	return func(ctx context.Context) error {
+		return rds.Ping(ctx).Err()
+	}
+}
+
+// TCPCheck only verifies that the address accepts connections, e.g. for SMTP where a full handshake is too costly.
+func TCPCheck(address string) CheckFunc {
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	}
+}
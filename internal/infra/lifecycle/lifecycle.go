@@ -0,0 +1,79 @@
+package lifecycle
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+// HookFunc releases a resource. It should give up once the context is done.
+type HookFunc func(ctx context.Context) error
+
+type hook struct {
+	name string
+	fn   HookFunc
+}
+
+type Manager struct {
+	timeout time.Duration
+	hooks   []hook
+}
+
+func NewManager(timeout time.Duration) *Manager {
+	return &Manager{
+		timeout: timeout,
+	}
+}
+
+// OnShutdown registers a hook. Hooks run in the order they were registered,
+// so resources that others depend on should be registered last.
+func (m *Manager) OnShutdown(name string, fn HookFunc) {
+	m.hooks = append(m.hooks, hook{
+		name: name,
+		fn:   fn,
+	})
+}
+
+// Wait blocks until SIGINT or SIGTERM is received, then shuts down.
+func (m *Manager) Wait() {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-quit
+	signal.Stop(quit)
+
+	log.Info(map[string]interface{}{
+		"signal":  sig.String(),
+		"timeout": m.timeout.String(),
+	}, "[Lifecycle][Wait] shutting down")
+
+	m.Shutdown()
+}
+
+// Shutdown runs every hook within the shared timeout. A failing hook doesn't stop the ones after it,
+// so the pools are still closed when draining takes too long.
+func (m *Manager) Shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	for _, h := range m.hooks {
+		start := time.Now()
+		if err := h.fn(ctx); err != nil {
+			log.Error(map[string]interface{}{
+				"error": err.Error(),
+				"hook":  h.name,
+			}, "[Lifecycle][Shutdown] hook failed")
+			continue
+		}
+
+		log.Info(map[string]interface{}{
+			"hook":     h.name,
+			"duration": time.Since(start).String(),
+		}, "[Lifecycle][Shutdown] hook done")
+	}
+
+	log.Info(nil, "[Lifecycle][Shutdown] shutdown complete")
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/nathakusuma/conference-backend/pkg/metrics"
 	"github.com/nathakusuma/conference-backend/pkg/validator"
 )
 
@@ -12,11 +13,13 @@ func ErrorHandler() fiber.ErrorHandler {
 	return func(ctx *fiber.Ctx, err error) error {
 		var apiErr *errorpkg.ErrorResponse
 		if errors.As(err, &apiErr) {
+			metrics.ErrorResponses.WithLabelValues(apiErr.ErrorCode).Inc()
 			return ctx.Status(apiErr.HttpStatusCode).JSON(apiErr)
 		}
 
 		var validationErr validator.ValidationErrorsResponse
 		if errors.As(err, &validationErr) {
+			metrics.ErrorResponses.WithLabelValues("VALIDATION_ERROR").Inc()
 			return ctx.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
 				"message":    "There are invalid fields in your request. Please check and try again",
 				"detail":     validationErr,
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/internal/infra/env"
+	"github.com/nathakusuma/conference-backend/internal/infra/health"
+	"github.com/nathakusuma/conference-backend/pkg/mail"
+	"github.com/redis/go-redis/v9"
+)
+
+// mountHealthRoutes mounts the liveness probe, which only tells the process is serving,
+// and the readiness probe, which tells whether the dependencies can be reached.
+func (s *httpServer) mountHealthRoutes(db *sqlx.DB, rds *redis.Client) {
+	readiness := health.NewChecker(2 * time.Second)
+	readiness.Add("postgres", health.PostgresCheck(db))
+	readiness.Add("redis", health.RedisCheck(rds))
+	smtpTransport := env.GetEnv().MailTransport == "" || env.GetEnv().MailTransport == mail.TransportSMTP
+	if env.GetEnv().HealthCheckSMTP && smtpTransport {
+		readiness.Add("smtp", health.TCPCheck(
+			net.JoinHostPort(env.GetEnv().SmtpHost, strconv.Itoa(env.GetEnv().SmtpPort))))
+	}
+
+	s.app.Get("/healthz", func(ctx *fiber.Ctx) error {
+		return ctx.Status(fiber.StatusOK).JSON(map[string]interface{}{
+			"status": "ok",
+		})
+	})
+
+	s.app.Get("/readyz", readiness.Handler())
+}
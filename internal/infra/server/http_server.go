@@ -1,38 +1,67 @@
 package server
 
 import (
+	"context"
 	"github.com/bytedance/sonic"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
+	"time"
 
+	"github.com/nathakusuma/conference-backend/domain/event"
+	audithnd "github.com/nathakusuma/conference-backend/internal/app/audit/handler"
+	auditrepo "github.com/nathakusuma/conference-backend/internal/app/audit/repository"
+	auditsvc "github.com/nathakusuma/conference-backend/internal/app/audit/service"
 	authhnd "github.com/nathakusuma/conference-backend/internal/app/auth/handler"
 	authrepo "github.com/nathakusuma/conference-backend/internal/app/auth/repository"
 	authsvc "github.com/nathakusuma/conference-backend/internal/app/auth/service"
 	conferencehnd "github.com/nathakusuma/conference-backend/internal/app/conference/handler"
 	conferencerepo "github.com/nathakusuma/conference-backend/internal/app/conference/repository"
 	conferencesvc "github.com/nathakusuma/conference-backend/internal/app/conference/service"
+	emailhnd "github.com/nathakusuma/conference-backend/internal/app/email/handler"
+	emailrepo "github.com/nathakusuma/conference-backend/internal/app/email/repository"
+	emailsvc "github.com/nathakusuma/conference-backend/internal/app/email/service"
+	eventhnd "github.com/nathakusuma/conference-backend/internal/app/event/handler"
+	eventrepo "github.com/nathakusuma/conference-backend/internal/app/event/repository"
+	eventsvc "github.com/nathakusuma/conference-backend/internal/app/event/service"
 	feedbackhnd "github.com/nathakusuma/conference-backend/internal/app/feedback/handler"
 	feedbackrepo "github.com/nathakusuma/conference-backend/internal/app/feedback/repository"
 	feedbacksvc "github.com/nathakusuma/conference-backend/internal/app/feedback/service"
+	notificationhnd "github.com/nathakusuma/conference-backend/internal/app/notification/handler"
+	notificationrepo "github.com/nathakusuma/conference-backend/internal/app/notification/repository"
+	notificationsvc "github.com/nathakusuma/conference-backend/internal/app/notification/service"
 	registrationhnd "github.com/nathakusuma/conference-backend/internal/app/registration/handler"
 	registrationrepo "github.com/nathakusuma/conference-backend/internal/app/registration/repository"
 	registrationsvc "github.com/nathakusuma/conference-backend/internal/app/registration/service"
+	reminderhnd "github.com/nathakusuma/conference-backend/internal/app/reminder/handler"
+	reminderrepo "github.com/nathakusuma/conference-backend/internal/app/reminder/repository"
+	remindersvc "github.com/nathakusuma/conference-backend/internal/app/reminder/service"
+	roomhnd "github.com/nathakusuma/conference-backend/internal/app/room/handler"
+	roomrepo "github.com/nathakusuma/conference-backend/internal/app/room/repository"
+	roomsvc "github.com/nathakusuma/conference-backend/internal/app/room/service"
 	userhnd "github.com/nathakusuma/conference-backend/internal/app/user/handler"
 	userrepo "github.com/nathakusuma/conference-backend/internal/app/user/repository"
 	usersvc "github.com/nathakusuma/conference-backend/internal/app/user/service"
 	"github.com/nathakusuma/conference-backend/internal/infra/env"
 	"github.com/nathakusuma/conference-backend/internal/middleware"
 	"github.com/nathakusuma/conference-backend/pkg/bcrypt"
+	"github.com/nathakusuma/conference-backend/pkg/eventbus"
 	"github.com/nathakusuma/conference-backend/pkg/jwt"
 	"github.com/nathakusuma/conference-backend/pkg/log"
 	"github.com/nathakusuma/conference-backend/pkg/mail"
+	"github.com/nathakusuma/conference-backend/pkg/metrics"
+	"github.com/nathakusuma/conference-backend/pkg/randgen"
 	"github.com/nathakusuma/conference-backend/pkg/uuidpkg"
 	"github.com/nathakusuma/conference-backend/pkg/validator"
 )
 
+const emailOutboxWorkers = 4
+
 type HttpServer interface {
 	Start(part string)
+	Shutdown(ctx context.Context) error
 	MountMiddlewares()
 	MountRoutes(db *sqlx.DB, rds *redis.Client)
 	GetApp() *fiber.App
@@ -40,6 +69,8 @@ type HttpServer interface {
 
 type httpServer struct {
 	app *fiber.App
+	// stopWorkers stops the background workers started by MountRoutes
+	stopWorkers context.CancelFunc
 }
 
 func NewHttpServer() HttpServer {
@@ -48,12 +79,17 @@ func NewHttpServer() HttpServer {
 		JSONEncoder:  sonic.Marshal,
 		JSONDecoder:  sonic.Unmarshal,
 		ErrorHandler: ErrorHandler(),
+		// The app runs behind nginx, which passes the client address in X-Real-IP
+		ProxyHeader:             "X-Real-IP",
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          []string{"127.0.0.1", "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"},
 	}
 
 	app := fiber.New(config)
 
 	return &httpServer{
-		app: app,
+		app:         app,
+		stopWorkers: func() {},
 	}
 }
 
@@ -75,8 +111,22 @@ func (s *httpServer) Start(port string) {
 	}
 }
 
+// Shutdown stops accepting connections and waits for in-flight requests until the context deadline,
+// then tells the background workers to stop. Wait for them with background.Wait.
+func (s *httpServer) Shutdown(ctx context.Context) error {
+	defer s.stopWorkers()
+
+	timeout := time.Duration(0)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	return s.app.ShutdownWithTimeout(timeout)
+}
+
 func (s *httpServer) MountMiddlewares() {
 	s.app.Use(middleware.LoggerConfig())
+	s.app.Use(middleware.Metrics())
 	s.app.Use(middleware.Helmet())
 	s.app.Use(middleware.Compress())
 	s.app.Use(middleware.Cors())
@@ -86,34 +136,93 @@ func (s *httpServer) MountMiddlewares() {
 func (s *httpServer) MountRoutes(db *sqlx.DB, rds *redis.Client) {
 	bcryptInstance := bcrypt.GetBcrypt()
 	jwtAccess := jwt.NewJwt(env.GetEnv().JwtAccessExpireDuration, env.GetEnv().JwtAccessSecretKey)
-	mailer := mail.NewMailDialer()
+	mailer := mail.NewMailer(mail.NewTransport())
 	uuidInstance := uuidpkg.GetUUID()
+	randGenInstance := randgen.GetRandGen()
 	validatorInstance := validator.NewValidator()
-	middlewareInstance := middleware.NewMiddleware(jwtAccess)
+	auditService := auditsvc.NewAuditService(auditrepo.NewAuditRepository(db), uuidInstance)
 
 	s.app.Get("/", func(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusOK).SendString("Healthy")
 	})
 
-	api := s.app.Group("/api")
-	v1 := api.Group("/v1")
+	s.mountHealthRoutes(db, rds)
+
+	metrics.RegisterDBStats(db.DB, env.GetEnv().DBName)
+	metrics.RegisterRedisStats(rds)
+	s.app.Get("/metrics", adaptor.HTTPHandler(promhttp.Handler()))
 
 	userRepository := userrepo.NewUserRepository(db)
 	authRepository := authrepo.NewAuthRepository(db, rds)
+	roomRepository := roomrepo.NewRoomRepository(db)
+	eventRepository := eventrepo.NewEventRepository(db)
 	conferenceRepository := conferencerepo.NewConferenceRepository(db)
 	registrationRepository := registrationrepo.NewRegistrationRepository(db)
 	feedbackRepository := feedbackrepo.NewFeedbackRepository(db)
-
-	userService := usersvc.NewUserService(userRepository, bcryptInstance, uuidInstance)
-	authService := authsvc.NewAuthService(authRepository, userService, bcryptInstance, jwtAccess, mailer, uuidInstance)
-	conferenceService := conferencesvc.NewConferenceService(conferenceRepository, uuidInstance)
-	registrationService := registrationsvc.NewRegistrationService(registrationRepository, conferenceService)
+	emailRepository := emailrepo.NewEmailRepository(db)
+	notificationRepository := notificationrepo.NewNotificationRepository(db)
+	reminderRepository := reminderrepo.NewReminderRepository(db)
+
+	eventBus := eventbus.NewEventBus()
+
+	emailService := emailsvc.NewEmailService(emailRepository, mailer, uuidInstance)
+	userService := usersvc.NewUserService(userRepository, bcryptInstance, uuidInstance,
+		env.GetEnv().ProposalQuotas)
+	authService := authsvc.NewAuthService(authRepository, userService, bcryptInstance, jwtAccess, emailService,
+		uuidInstance, randGenInstance)
+	roomService := roomsvc.NewRoomService(roomRepository, uuidInstance)
+	eventService := eventsvc.NewEventService(eventRepository, userService, uuidInstance)
+	conferenceService := conferencesvc.NewConferenceService(conferenceRepository, roomService, eventService,
+		userService, uuidInstance, eventBus)
+	registrationService := registrationsvc.NewRegistrationService(registrationRepository, conferenceService,
+		eventBus, uuidInstance)
 	feedbackService := feedbacksvc.NewFeedbackService(feedbackRepository, registrationService, conferenceService,
-		uuidInstance)
+		eventService, uuidInstance)
+	notificationService := notificationsvc.NewNotificationService(notificationRepository, userService,
+		registrationService, emailService, uuidInstance)
+	reminderService := remindersvc.NewReminderService(reminderRepository, conferenceService, registrationService,
+		emailService, env.GetEnv().ReminderWindows)
+
+	for _, eventName := range []string{
+		event.NameConferenceApproved,
+		event.NameConferenceRejected,
+		event.NameConferenceCancelled,
+		event.NameConferenceRescheduled,
+		event.NameRescheduleReviewed,
+		event.NameRegistrationConfirmed,
+		event.NameRegistrationConflict,
+	} {
+		eventBus.Subscribe(eventName, notificationService.HandleEvent)
+	}
+	eventBus.Subscribe(event.NameConferenceRescheduled, registrationService.HandleEvent)
+
+	// The middleware records privileged requests and checks that sessions weren't revoked,
+	// so it needs the audit and auth services
+	middlewareInstance := middleware.NewMiddleware(jwtAccess, rds, auditService, authService)
+
+	api := s.app.Group("/api", middlewareInstance.RateLimit(middleware.RateLimitConfig{
+		Name:   "global",
+		Max:    300,
+		Window: time.Minute,
+		KeyBy:  middleware.KeyByIP(),
+	}))
+	v1 := api.Group("/v1")
 
 	userhnd.InitUserHandler(v1, middlewareInstance, validatorInstance, userService)
 	authhnd.InitAuthHandler(v1, middlewareInstance, validatorInstance, authService)
+	roomhnd.InitRoomHandler(v1, middlewareInstance, validatorInstance, roomService)
+	eventhnd.InitEventHandler(v1, middlewareInstance, validatorInstance, eventService)
 	conferencehnd.InitConferenceHandler(v1, middlewareInstance, validatorInstance, conferenceService)
 	registrationhnd.InitRegistrationHandler(v1, middlewareInstance, validatorInstance, registrationService)
 	feedbackhnd.InitFeedbackHandler(v1, middlewareInstance, validatorInstance, feedbackService)
+	emailhnd.InitEmailHandler(v1, middlewareInstance, validatorInstance, emailService)
+	notificationhnd.InitNotificationHandler(v1, middlewareInstance, validatorInstance, notificationService)
+	reminderhnd.InitReminderHandler(v1, middlewareInstance, validatorInstance, reminderService)
+	audithnd.InitAuditHandler(v1, middlewareInstance, validatorInstance, auditService)
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	s.stopWorkers = stopWorkers
+	emailsvc.StartOutboxWorkers(workerCtx, emailService, emailOutboxWorkers)
+	remindersvc.StartReminderScheduler(workerCtx, reminderService)
+	authsvc.StartSessionCleanup(workerCtx, authService)
 }
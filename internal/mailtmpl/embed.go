@@ -2,5 +2,8 @@ package mailtmpl
 
 import "embed"
 
-//go:embed *.html
+// Templates holds every email as an HTML template and a plain-text template with the same base name,
+// e.g. otp_register_user.html and otp_register_user.txt.
+//
+//go:embed *.html *.txt
 var Templates embed.FS
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+)
+
+// AuditTargetFunc returns the ID of what a request acted on, or an empty string when it's unknown.
+type AuditTargetFunc func(ctx *fiber.Ctx) string
+
+type AuditConfig struct {
+	Action     enum.AuditAction
+	TargetType string
+	TargetBy   AuditTargetFunc
+}
+
+// TargetByParam takes the target from a route parameter, such as the ID in /users/:id.
+func TargetByParam(param string) AuditTargetFunc {
+	return func(ctx *fiber.Ctx) string {
+		return ctx.Params(param)
+	}
+}
+
+// TargetByBodyField takes the target from a JSON body field, such as the email of a login.
+func TargetByBodyField(field string) AuditTargetFunc {
+	return func(ctx *fiber.Ctx) string {
+		var body map[string]interface{}
+		if err := ctx.BodyParser(&body); err == nil {
+			if value, ok := body[field].(string); ok {
+				return strings.ToLower(strings.TrimSpace(value))
+			}
+		}
+
+		return ""
+	}
+}
+
+// TargetByLocal takes the target from a local the handler sets, for targets that only exist once the
+// request is done, such as a newly created user.
+func TargetByLocal(key string) AuditTargetFunc {
+	return func(ctx *fiber.Ctx) string {
+		if value := ctx.Locals(key); value != nil {
+			return fmt.Sprint(value)
+		}
+
+		return ""
+	}
+}
+
+// TargetByUserID targets the requester themselves, such as when they sign out everywhere.
+// dependency: RequireAuthenticated
+func TargetByUserID() AuditTargetFunc {
+	return func(ctx *fiber.Ctx) string {
+		if userID, ok := ctx.Locals("user.id").(uuid.UUID); ok {
+			return userID.String()
+		}
+
+		return ""
+	}
+}
+
+// Audit records the request in the audit log once it's handled, whether it succeeded or not. Put it
+// before RequireOneOfRoles, so requests turned away for their role are recorded too.
+func (m *Middleware) Audit(config AuditConfig) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		err := ctx.Next()
+
+		outcome := enum.AuditOutcomeSuccess
+		if err != nil || ctx.Response().StatusCode() >= fiber.StatusBadRequest {
+			outcome = enum.AuditOutcomeFailure
+		}
+
+		req := dto.RecordAuditEventRequest{
+			IPAddress:  ctx.IP(),
+			Action:     config.Action,
+			TargetType: config.TargetType,
+			Outcome:    outcome,
+		}
+
+		if userID, ok := ctx.Locals("user.id").(uuid.UUID); ok {
+			req.ActorID = &userID
+		}
+		if role, ok := ctx.Locals("user.role").(enum.UserRole); ok {
+			req.ActorRole = &role
+		}
+		if config.TargetBy != nil {
+			if targetID := config.TargetBy(ctx); targetID != "" {
+				req.TargetID = &targetID
+			}
+		}
+
+		// A failed record is logged by the service, it must not fail the request itself
+		_ = m.auditSvc.Record(ctx.Context(), req)
+
+		return err
+	}
+}
@@ -39,8 +39,19 @@ func (m *Middleware) RequireAuthenticated() fiber.Handler {
 			return errorpkg.ErrInvalidBearerToken
 		}
 
+		// Access tokens outlive a revoked session until they expire, unless we check it
+		revoked, err := m.authSvc.IsSessionRevoked(ctx.Context(), claims.SessionID)
+		if err != nil {
+			return err
+		}
+
+		if revoked {
+			return errorpkg.ErrInvalidBearerToken
+		}
+
 		ctx.Locals("user.id", uuid.MustParse(claims.Subject))
 		ctx.Locals("user.role", claims.Role)
+		ctx.Locals("session.id", claims.SessionID)
 
 		return ctx.Next()
 	}
@@ -9,7 +9,7 @@ func Cors() fiber.Handler {
 	config := cors.Config{
 		AllowMethods:  "GET,POST,PUT,DELETE,PATCH,OPTIONS,HEAD",
 		AllowHeaders:  "Content-Type,Authorization,Accept,Origin,X-Requested-With,X-XSRF-Token,X-Cursor,Token-Type",
-		ExposeHeaders: "Content-Length",
+		ExposeHeaders: "Content-Length,RateLimit-Limit,RateLimit-Remaining,RateLimit-Reset,Retry-After",
 	}
 
 	return cors.New(config)
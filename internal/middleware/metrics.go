@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/nathakusuma/conference-backend/pkg/metrics"
+)
+
+// Metrics records the request duration labeled by route template, so /conferences/:id is a single series.
+func Metrics() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		start := time.Now()
+
+		err := ctx.Next()
+		if err != nil {
+			// Render the error now, otherwise the status code is unknown until the error handler runs
+			if err = ctx.App().ErrorHandler(ctx, err); err != nil {
+				_ = ctx.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		metrics.HttpRequestDuration.
+			WithLabelValues(ctx.Method(), ctx.Route().Path, strconv.Itoa(ctx.Response().StatusCode())).
+			Observe(time.Since(start).Seconds())
+
+		return nil
+	}
+}
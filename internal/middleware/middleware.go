@@ -1,15 +1,28 @@
 package middleware
 
-import "github.com/nathakusuma/conference-backend/pkg/jwt"
+import (
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/pkg/jwt"
+	"github.com/redis/go-redis/v9"
+)
 
 type Middleware struct {
-	jwt jwt.IJwt
+	jwt      jwt.IJwt
+	rds      *redis.Client
+	auditSvc contract.IAuditService
+	authSvc  contract.IAuthService
 }
 
 func NewMiddleware(
 	jwt jwt.IJwt,
+	rds *redis.Client,
+	auditSvc contract.IAuditService,
+	authSvc contract.IAuthService,
 ) *Middleware {
 	return &Middleware{
-		jwt: jwt,
+		jwt:      jwt,
+		rds:      rds,
+		auditSvc: auditSvc,
+		authSvc:  authSvc,
 	}
 }
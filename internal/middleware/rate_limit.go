@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// RateLimitKeyFunc returns the identity a request is counted against.
+type RateLimitKeyFunc func(ctx *fiber.Ctx) string
+
+type RateLimitConfig struct {
+	// Name separates the counters of different limiters that share the same key.
+	Name   string
+	Max    int
+	Window time.Duration
+	KeyBy  RateLimitKeyFunc
+}
+
+// slidingWindowScript drops the hits that left the window, then records the current hit if there is room.
+// It returns whether the hit is allowed, the hit count in the window and the milliseconds until the oldest hit expires.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+
+local count = redis.call("ZCARD", KEYS[1])
+local allowed = 0
+if count < limit then
+	redis.call("ZADD", KEYS[1], now, ARGV[4])
+	count = count + 1
+	allowed = 1
+end
+redis.call("PEXPIRE", KEYS[1], window)
+
+local reset = window
+local oldest = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+
+return {allowed, count, reset}
+`)
+
+// KeyByIP counts requests per client IP.
+func KeyByIP() RateLimitKeyFunc {
+	return func(ctx *fiber.Ctx) string {
+		return "ip:" + ctx.IP()
+	}
+}
+
+// KeyByUserID counts requests per authenticated user and falls back to the client IP.
+// dependency: RequireAuthenticated
+func KeyByUserID() RateLimitKeyFunc {
+	return func(ctx *fiber.Ctx) string {
+		if userID, ok := ctx.Locals("user.id").(uuid.UUID); ok {
+			return "user:" + userID.String()
+		}
+
+		return "ip:" + ctx.IP()
+	}
+}
+
+// KeyByBodyField counts requests per value of a JSON body field, such as an email, and falls back to the client IP.
+func KeyByBodyField(field string) RateLimitKeyFunc {
+	return func(ctx *fiber.Ctx) string {
+		var body map[string]interface{}
+		if err := ctx.BodyParser(&body); err == nil {
+			if value, ok := body[field].(string); ok && value != "" {
+				return field + ":" + strings.ToLower(strings.TrimSpace(value))
+			}
+		}
+
+		return "ip:" + ctx.IP()
+	}
+}
+
+func (m *Middleware) RateLimit(config RateLimitConfig) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		key := "rate_limit:" + config.Name + ":" + config.KeyBy(ctx)
+		now := time.Now().UnixMilli()
+
+		res, err := slidingWindowScript.Run(ctx.Context(), m.rds, []string{key},
+			now, config.Window.Milliseconds(), config.Max, strconv.FormatInt(now, 10)+"-"+uuid.NewString(),
+		).Int64Slice()
+		if err != nil {
+			// Don't take the whole API down when Redis is unavailable
+			log.Error(map[string]interface{}{
+				"error": err.Error(),
+				"key":   key,
+			}, "[Middleware][RateLimit] failed to run rate limit script")
+
+			return ctx.Next()
+		}
+
+		allowed, count, resetMillis := res[0] == 1, res[1], res[2]
+		reset := int(math.Ceil(float64(resetMillis) / 1000))
+
+		ctx.Set("RateLimit-Limit", strconv.Itoa(config.Max))
+		ctx.Set("RateLimit-Remaining", strconv.FormatInt(max(int64(config.Max)-count, 0), 10))
+		ctx.Set("RateLimit-Reset", strconv.Itoa(reset))
+
+		if !allowed {
+			ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(reset))
+
+			return errorpkg.ErrRateLimited.WithDetail(map[string]interface{}{
+				"retry_after": reset,
+			})
+		}
+
+		return ctx.Next()
+	}
+}
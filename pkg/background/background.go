@@ -0,0 +1,69 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+var (
+	mu      sync.Mutex
+	running int
+	// idle is closed once the last running job finishes
+	idle chan struct{}
+)
+
+// Go runs fn in a goroutine that is tracked, so shutdown can wait for it instead of killing it mid-way.
+// A panic in fn is recovered and logged, because nothing else would catch it.
+func Go(name string, fn func()) {
+	mu.Lock()
+	if running == 0 {
+		idle = make(chan struct{})
+	}
+	running++
+	mu.Unlock()
+
+	go func() {
+		defer finish()
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error(map[string]interface{}{
+					"job":   name,
+					"panic": fmt.Sprint(r),
+				}, "[Background][Go] job panicked")
+			}
+		}()
+
+		fn()
+	}()
+}
+
+func finish() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	running--
+	if running == 0 {
+		close(idle)
+	}
+}
+
+// Wait blocks until every tracked goroutine has finished or the context is done.
+func Wait(ctx context.Context) error {
+	mu.Lock()
+	if running == 0 {
+		mu.Unlock()
+		return nil
+	}
+	done := idle
+	mu.Unlock()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,54 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nathakusuma/conference-backend/domain/event"
+	"github.com/nathakusuma/conference-backend/pkg/background"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+type Handler func(ctx context.Context, e event.Event) error
+
+type IEventBus interface {
+	// Publish hands the event to every subscriber in the background and returns right away.
+	Publish(ctx context.Context, e event.Event)
+	Subscribe(eventName string, handler Handler)
+}
+
+type eventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+func NewEventBus() IEventBus {
+	return &eventBus{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+func (b *eventBus) Subscribe(eventName string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventName] = append(b.handlers[eventName], handler)
+}
+
+func (b *eventBus) Publish(_ context.Context, e event.Event) {
+	b.mu.RLock()
+	handlers := b.handlers[e.EventName()]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		// The request context is done, and recycled by fiber, by the time the handler runs
+		background.Go("event."+e.EventName(), func() {
+			if err := handler(context.Background(), e); err != nil {
+				log.Error(map[string]interface{}{
+					"error": err.Error(),
+					"event": e.EventName(),
+				}, "[EventBus][Publish] event handler failed")
+			}
+		})
+	}
+}
@@ -9,13 +9,14 @@ import (
 )
 
 type IJwt interface {
-	Create(userID uuid.UUID, role enum.UserRole) (string, error)
+	Create(userID uuid.UUID, role enum.UserRole, sessionID uuid.UUID) (string, error)
 	Decode(tokenString string, claims *Claims) error
 }
 
 type Claims struct {
 	jwt.RegisteredClaims
-	Role enum.UserRole `json:"role"`
+	Role      enum.UserRole `json:"role"`
+	SessionID uuid.UUID     `json:"sid"`
 }
 
 type JwtStruct struct {
@@ -30,7 +31,7 @@ func NewJwt(exp time.Duration, secret []byte) IJwt {
 	}
 }
 
-func (j *JwtStruct) Create(userID uuid.UUID, role enum.UserRole) (string, error) {
+func (j *JwtStruct) Create(userID uuid.UUID, role enum.UserRole, sessionID uuid.UUID) (string, error) {
 	claims := Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    "conference-backend",
@@ -38,7 +39,8 @@ func (j *JwtStruct) Create(userID uuid.UUID, role enum.UserRole) (string, error)
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.exp)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
-		Role: role,
+		Role:      role,
+		SessionID: sessionID,
 	}
 
 	unsignedJWT := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
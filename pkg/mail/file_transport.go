@@ -0,0 +1,51 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+type fileTransport struct {
+	dir string
+}
+
+// NewFileTransport writes every message as an .eml file under dir, which most mail clients can open.
+func NewFileTransport(dir string) Transport {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		log.Fatal(map[string]interface{}{
+			"error": err.Error(),
+			"dir":   dir,
+		}, "[MAIL][NewFileTransport] failed to create mail directory")
+	}
+
+	return &fileTransport{
+		dir: dir,
+	}
+}
+
+func (t *fileTransport) Send(msg Message) error {
+	// Sortable by time, and the recipient makes the file easy to find
+	name := fmt.Sprintf("%s_%s_%s.eml",
+		time.Now().UTC().Format("20060102T150405.000Z"),
+		strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(msg.To),
+		uuid.NewString()[:8],
+	)
+
+	file, err := os.Create(filepath.Join(t.dir, name))
+	if err != nil {
+		return fmt.Errorf("failed to create mail file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err = msg.toGomail().WriteTo(file); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	return nil
+}
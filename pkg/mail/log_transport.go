@@ -0,0 +1,22 @@
+package mail
+
+import (
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+type logTransport struct{}
+
+// NewLogTransport logs the plain-text part of every message instead of sending it.
+func NewLogTransport() Transport {
+	return &logTransport{}
+}
+
+func (t *logTransport) Send(msg Message) error {
+	log.Info(map[string]interface{}{
+		"to":      msg.To,
+		"subject": msg.Subject,
+		"body":    msg.TextBody,
+	}, "[MAIL][LogTransport] email")
+
+	return nil
+}
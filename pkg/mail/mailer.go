@@ -0,0 +1,91 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/nathakusuma/conference-backend/internal/infra/env"
+	"github.com/nathakusuma/conference-backend/internal/mailtmpl"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+)
+
+// ErrPermanent marks failures that will fail again on retry, such as a broken template or a rejected recipient.
+var ErrPermanent = errors.New("permanent mail failure")
+
+type IMailer interface {
+	Send(recipientEmail, subject, templateName string, data map[string]any) error
+}
+
+// Message is a rendered email, ready to be handed to a Transport.
+type Message struct {
+	From     string
+	To       string
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+type mailer struct {
+	transport     Transport
+	htmlTemplates *htmltemplate.Template
+	textTemplates *texttemplate.Template
+}
+
+func NewMailer(transport Transport) IMailer {
+	// Parse all templates at startup
+	htmlTemplates, err := htmltemplate.ParseFS(mailtmpl.Templates, "*.html")
+	if err != nil {
+		log.Fatal(map[string]interface{}{
+			"error": err.Error(),
+		}, "[MAIL][NewMailer] failed to parse html templates")
+		return nil
+	}
+
+	textTemplates, err := texttemplate.ParseFS(mailtmpl.Templates, "*.txt")
+	if err != nil {
+		log.Fatal(map[string]interface{}{
+			"error": err.Error(),
+		}, "[MAIL][NewMailer] failed to parse text templates")
+		return nil
+	}
+
+	return &mailer{
+		transport:     transport,
+		htmlTemplates: htmlTemplates,
+		textTemplates: textTemplates,
+	}
+}
+
+// Send renders the HTML template and its plain-text sibling, e.g. otp_register_user.html and
+// otp_register_user.txt, and sends both as alternatives of one message.
+func (m *mailer) Send(recipientEmail, subject, templateName string, data map[string]any) error {
+	var htmlBody bytes.Buffer
+	err := m.htmlTemplates.ExecuteTemplate(&htmlBody, templateName, data)
+	if err != nil {
+		return fmt.Errorf("%w: failed to execute template %s: %w", ErrPermanent, templateName, err)
+	}
+
+	textTemplateName := TextTemplateName(templateName)
+	var textBody bytes.Buffer
+	err = m.textTemplates.ExecuteTemplate(&textBody, textTemplateName, data)
+	if err != nil {
+		return fmt.Errorf("%w: failed to execute template %s: %w", ErrPermanent, textTemplateName, err)
+	}
+
+	return m.transport.Send(Message{
+		From:     "Conference App <" + env.GetEnv().SmtpEmail + ">",
+		To:       recipientEmail,
+		Subject:  subject,
+		TextBody: textBody.String(),
+		HTMLBody: htmlBody.String(),
+	})
+}
+
+// TextTemplateName returns the name of the plain-text template that goes with an HTML template.
+func TextTemplateName(htmlTemplateName string) string {
+	return strings.TrimSuffix(htmlTemplateName, ".html") + ".txt"
+}
@@ -0,0 +1,38 @@
+package mail
+
+import (
+	"errors"
+	"fmt"
+	"net/textproto"
+
+	"gopkg.in/gomail.v2"
+)
+
+type smtpTransport struct {
+	dialer       *gomail.Dialer
+	envelopeFrom string
+}
+
+func NewSMTPTransport(host string, port int, username, password, envelopeFrom string) Transport {
+	return &smtpTransport{
+		dialer:       gomail.NewDialer(host, port, username, password),
+		envelopeFrom: envelopeFrom,
+	}
+}
+
+func (t *smtpTransport) Send(msg Message) error {
+	sender, err := t.dialer.Dial()
+	if err != nil {
+		return fmt.Errorf("failed to dial smtp server: %w", err)
+	}
+	defer sender.Close()
+
+	// Send through the connection directly, gomail.Send flattens the SMTP reply code into a string
+	err = sender.Send(t.envelopeFrom, []string{msg.To}, msg.toGomail())
+	var smtpErr *textproto.Error
+	if errors.As(err, &smtpErr) && smtpErr.Code >= 500 {
+		return fmt.Errorf("%w: %w", ErrPermanent, err)
+	}
+
+	return err
+}
@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"errors"
+
+	"github.com/nathakusuma/conference-backend/internal/infra/env"
+	"github.com/nathakusuma/conference-backend/pkg/log"
+	"gopkg.in/gomail.v2"
+)
+
+const (
+	TransportSMTP   = "smtp"
+	TransportFile   = "file"
+	TransportLog    = "log"
+	TransportMemory = "memory"
+)
+
+const defaultMailFileDir = "storage/mail"
+
+// ErrTransportNotForProduction is returned for the transports that never deliver the emails.
+var ErrTransportNotForProduction = errors.New("mail transport can't be used in production")
+
+// Transport delivers a rendered message.
+type Transport interface {
+	Send(msg Message) error
+}
+
+// ValidateTransport checks that the transport can be used in the app environment.
+func ValidateTransport(appEnv, transport string) error {
+	if appEnv == "production" && (transport == TransportLog || transport == TransportMemory) {
+		return ErrTransportNotForProduction
+	}
+
+	return nil
+}
+
+// NewTransport returns the transport selected by MAIL_TRANSPORT, SMTP by default.
+// It stops the app when the transport can't be used in its environment.
+func NewTransport() Transport {
+	if err := ValidateTransport(env.GetEnv().AppEnv, env.GetEnv().MailTransport); err != nil {
+		log.Fatal(map[string]interface{}{
+			"error":     err.Error(),
+			"app_env":   env.GetEnv().AppEnv,
+			"transport": env.GetEnv().MailTransport,
+		}, "[MAIL][NewTransport] invalid mail transport")
+		return nil
+	}
+
+	switch env.GetEnv().MailTransport {
+	case "", TransportSMTP:
+		return NewSMTPTransport(
+			env.GetEnv().SmtpHost,
+			env.GetEnv().SmtpPort,
+			env.GetEnv().SmtpUsername,
+			env.GetEnv().SmtpPassword,
+			env.GetEnv().SmtpEmail,
+		)
+	case TransportFile:
+		dir := env.GetEnv().MailFileDir
+		if dir == "" {
+			dir = defaultMailFileDir
+		}
+		return NewFileTransport(dir)
+	case TransportLog:
+		return NewLogTransport()
+	case TransportMemory:
+		return NewMemoryTransport()
+	}
+
+	log.Fatal(map[string]interface{}{
+		"transport": env.GetEnv().MailTransport,
+	}, "[MAIL][NewTransport] unknown mail transport")
+	return nil
+}
+
+// toGomail builds the MIME message, with the plain-text part first so clients prefer the HTML one.
+func (msg Message) toGomail() *gomail.Message {
+	m := gomail.NewMessage()
+	m.SetHeader("From", msg.From)
+	m.SetHeader("To", msg.To)
+	m.SetHeader("Subject", msg.Subject)
+	m.SetBody("text/plain", msg.TextBody)
+	m.AddAlternative("text/html", msg.HTMLBody)
+
+	return m
+}
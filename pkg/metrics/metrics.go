@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/go-redis/v9"
+)
+
+const namespace = "conference"
+
+var (
+	HttpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of HTTP requests by route template and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	ErrorResponses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "error_responses_total",
+		Help:      "Number of error responses by error code.",
+	}, []string{"error_code"})
+
+	Registrations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "registrations_total",
+		Help:      "Number of conference registrations by source.",
+	}, []string{"source"})
+
+	ProposalsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "proposals_created_total",
+		Help:      "Number of conference proposals created.",
+	})
+
+	ConferenceStatusChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "conference_status_changes_total",
+		Help:      "Number of conference status changes by new status.",
+	}, []string{"status"})
+
+	EmailsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "emails_sent_total",
+		Help:      "Number of emails sent from the outbox by template.",
+	}, []string{"template"})
+
+	EmailsDeadLettered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "emails_dead_lettered_total",
+		Help:      "Number of emails given up on after a permanent failure or too many attempts, by template.",
+	}, []string{"template"})
+
+	EmailOutboxDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "email_outbox_depth",
+		Help:      "Number of emails in the outbox by status.",
+	}, []string{"status"})
+
+	RemindersQueued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reminders_queued_total",
+		Help:      "Number of conference reminder emails queued by reminder type.",
+	}, []string{"type"})
+)
+
+// RegisterDBStats exposes the connection pool stats of the database.
+func RegisterDBStats(db *sql.DB, dbName string) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// RegisterRedisStats exposes the connection pool stats of the Redis client.
+func RegisterRedisStats(rds *redis.Client) {
+	prometheus.MustRegister(&redisPoolCollector{rds: rds})
+}
+
+type redisPoolCollector struct {
+	rds *redis.Client
+}
+
+var (
+	redisHitsDesc = prometheus.NewDesc(namespace+"_redis_pool_hits_total",
+		"Number of times a free connection was found in the pool.", nil, nil)
+	redisMissesDesc = prometheus.NewDesc(namespace+"_redis_pool_misses_total",
+		"Number of times a free connection was not found in the pool.", nil, nil)
+	redisTimeoutsDesc = prometheus.NewDesc(namespace+"_redis_pool_timeouts_total",
+		"Number of times a wait timeout occurred.", nil, nil)
+	redisTotalConnsDesc = prometheus.NewDesc(namespace+"_redis_pool_total_connections",
+		"Number of total connections in the pool.", nil, nil)
+	redisIdleConnsDesc = prometheus.NewDesc(namespace+"_redis_pool_idle_connections",
+		"Number of idle connections in the pool.", nil, nil)
+	redisStaleConnsDesc = prometheus.NewDesc(namespace+"_redis_pool_stale_connections_total",
+		"Number of stale connections removed from the pool.", nil, nil)
+)
+
+func (c *redisPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- redisHitsDesc
+	ch <- redisMissesDesc
+	ch <- redisTimeoutsDesc
+	ch <- redisTotalConnsDesc
+	ch <- redisIdleConnsDesc
+	ch <- redisStaleConnsDesc
+}
+
+func (c *redisPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.rds.PoolStats()
+
+	ch <- prometheus.MustNewConstMetric(redisHitsDesc, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(redisMissesDesc, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(redisTimeoutsDesc, prometheus.CounterValue, float64(stats.Timeouts))
+	ch <- prometheus.MustNewConstMetric(redisTotalConnsDesc, prometheus.GaugeValue, float64(stats.TotalConns))
+	ch <- prometheus.MustNewConstMetric(redisIdleConnsDesc, prometheus.GaugeValue, float64(stats.IdleConns))
+	ch <- prometheus.MustNewConstMetric(redisStaleConnsDesc, prometheus.CounterValue, float64(stats.StaleConns))
+}
@@ -1,23 +1,72 @@
 package randgen
 
 import (
+	"crypto/rand"
 	"math"
-	"math/rand"
+	"math/big"
+	"sync"
 )
 
-func RandomNumber(digits int) int {
-	low := int(math.Pow10(digits - 1))
-	high := int(math.Pow10(digits)) - 1
-	return low + rand.Intn(high-low+1)
+const alphaNum = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890"
+
+// maxUnbiasedByte is the largest multiple of len(alphaNum) that fits in a byte.
+// Bytes at or above it are rejected so every character is equally likely.
+const maxUnbiasedByte = 256 - 256%len(alphaNum)
+
+type IRandGen interface {
+	RandomNumber(digits int) (int, error)
+	RandomString(length int) (string, error)
+}
+
+type randGenStruct struct{}
+
+var (
+	randGenInstance IRandGen
+	once            sync.Once
+)
+
+func GetRandGen() IRandGen {
+	once.Do(func() {
+		randGenInstance = &randGenStruct{}
+	})
+
+	return randGenInstance
 }
 
-func RandomString(length int) string {
-	alphaNumRunes := []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890")
-	randomRune := make([]rune, length)
+// RandomNumber returns a number with exactly the given number of digits.
+func (r *randGenStruct) RandomNumber(digits int) (int, error) {
+	low := int64(math.Pow10(digits - 1))
+	high := int64(math.Pow10(digits)) - 1
+
+	n, err := rand.Int(rand.Reader, big.NewInt(high-low+1))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(low + n.Int64()), nil
+}
+
+// RandomString returns an alphanumeric string of the given length.
+func (r *randGenStruct) RandomString(length int) (string, error) {
+	result := make([]byte, 0, length)
+	buf := make([]byte, length)
+
+	for len(result) < length {
+		if _, err := rand.Read(buf); err != nil {
+			return "", err
+		}
+
+		for _, b := range buf {
+			if int(b) >= maxUnbiasedByte {
+				continue
+			}
 
-	for i := 0; i < length; i++ {
-		randomRune[i] = alphaNumRunes[rand.Intn(len(alphaNumRunes)-1)]
+			result = append(result, alphaNum[int(b)%len(alphaNum)])
+			if len(result) == length {
+				break
+			}
+		}
 	}
 
-	return string(randomRune)
+	return string(result), nil
 }
@@ -0,0 +1,147 @@
+package integration
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/app/registration/repository"
+	"github.com/nathakusuma/conference-backend/internal/app/registration/service"
+	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// seedConference inserts an approved conference with the given seats and returns it with its host.
+func seedConference(t *testing.T, db *sqlx.DB, seats int) *dto.ConferenceResponse {
+	t.Helper()
+
+	hostID := seedUser(t, db)
+	eventID, roomID, conferenceID := uuid.New(), uuid.New(), uuid.New()
+	startsAt := time.Now().Add(24 * time.Hour).UTC()
+	endsAt := startsAt.Add(2 * time.Hour)
+
+	db.MustExec(`INSERT INTO events (id, name, starts_at, ends_at, cfp_opens_at, cfp_closes_at)
+		VALUES ($1, 'Event', $2, $3, $4, $2)`, eventID, startsAt.Add(-time.Hour), endsAt.Add(time.Hour), time.Now())
+	db.MustExec(`INSERT INTO rooms (id, name, capacity) VALUES ($1, 'Room', $2)`, roomID, seats)
+	db.MustExec(`INSERT INTO conferences (
+			id, title, description, speaker_name, speaker_title, target_audience, seats,
+			starts_at, ends_at, host_id, room_id, event_id, status
+		) VALUES ($1, 'Conference', 'Description', 'Speaker', 'Title', 'Everyone', $2, $3, $4, $5, $6, $7, 'approved')`,
+		conferenceID, seats, startsAt, endsAt, hostID, roomID, eventID)
+
+	return &dto.ConferenceResponse{
+		ID:       conferenceID,
+		Title:    "Conference",
+		Seats:    seats,
+		StartsAt: &startsAt,
+		EndsAt:   &endsAt,
+		Host:     &dto.UserResponse{ID: hostID},
+		Status:   enum.ConferenceApproved,
+	}
+}
+
+func seedUser(t *testing.T, db *sqlx.DB) uuid.UUID {
+	t.Helper()
+
+	id := uuid.New()
+	db.MustExec(`INSERT INTO users (id, name, email, password_hash) VALUES ($1, 'User', $2, $3)`,
+		id, id.String()+"@example.com", "$2a$10$abcdefghijklmnopqrstuv1234567890abcdefghijklmnopqrstu")
+
+	return id
+}
+
+func Test_Registration_Concurrent(t *testing.T) {
+	ctx := context.Background()
+
+	register := func(t *testing.T, db *sqlx.DB, conference *dto.ConferenceResponse, userIDs []uuid.UUID) []error {
+		conferenceSvc := appmocks.NewMockIConferenceService(t)
+		conferenceSvc.EXPECT().GetConferenceByID(mock.Anything, conference.ID).Return(conference, nil)
+		eventBus := pkgmocks.NewMockIEventBus(t)
+		eventBus.EXPECT().Publish(mock.Anything, mock.Anything).Return().Maybe()
+
+		svc := service.NewRegistrationService(repository.NewRegistrationRepository(db), conferenceSvc, eventBus,
+			pkgmocks.NewMockIUUID(t))
+
+		// Release every goroutine at once to make them race for the seats
+		start := make(chan struct{})
+		errs := make([]error, len(userIDs))
+		var wg sync.WaitGroup
+		for i, userID := range userIDs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				errs[i] = svc.Register(ctx, conference.ID, userID)
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		return errs
+	}
+
+	countRegistrations := func(t *testing.T, db *sqlx.DB, conferenceID uuid.UUID) int {
+		var count int
+		require.NoError(t, db.Get(&count,
+			`SELECT COUNT(*) FROM registrations WHERE conference_id = $1 AND cancelled_at IS NULL`, conferenceID))
+		return count
+	}
+
+	t.Run("never oversells seats", func(t *testing.T) {
+		db := setupPostgres(t)
+		conference := seedConference(t, db, 5)
+
+		userIDs := make([]uuid.UUID, 40)
+		for i := range userIDs {
+			userIDs[i] = seedUser(t, db)
+		}
+
+		errs := register(t, db, conference, userIDs)
+
+		registered := 0
+		for _, err := range errs {
+			if err == nil {
+				registered++
+				continue
+			}
+			assert.ErrorIs(t, err, errorpkg.ErrConferenceFull)
+		}
+
+		assert.Equal(t, conference.Seats, registered)
+		assert.Equal(t, conference.Seats, countRegistrations(t, db, conference.ID))
+	})
+
+	t.Run("duplicates of the same user register once", func(t *testing.T) {
+		db := setupPostgres(t)
+		conference := seedConference(t, db, 5)
+		userID := seedUser(t, db)
+
+		userIDs := make([]uuid.UUID, 20)
+		for i := range userIDs {
+			userIDs[i] = userID
+		}
+
+		errs := register(t, db, conference, userIDs)
+
+		registered := 0
+		for _, err := range errs {
+			if err == nil {
+				registered++
+				continue
+			}
+			assert.ErrorIs(t, err, errorpkg.ErrUserAlreadyRegisteredToConference)
+		}
+
+		assert.Equal(t, 1, registered)
+		assert.Equal(t, 1, countRegistrations(t, db, conference.ID))
+	})
+}
@@ -0,0 +1,75 @@
+// Package integration holds tests that run against a real PostgreSQL server. They are skipped unless
+// TEST_POSTGRES_URL points to a database the tests may create schemas in.
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPostgres migrates a fresh schema and returns a pool that only sees it. The schema is dropped
+// when the test ends.
+func setupPostgres(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_POSTGRES_URL")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_URL is not set")
+	}
+
+	admin, err := sqlx.Connect("pgx", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = admin.Close() })
+
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	_, err = admin.Exec("CREATE SCHEMA " + schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = admin.Exec("DROP SCHEMA " + schema + " CASCADE")
+	})
+
+	config, err := pgx.ParseConfig(dsn)
+	require.NoError(t, err)
+	// Extensions such as pg_trgm may already live in public
+	config.RuntimeParams["search_path"] = schema + ",public"
+
+	db := sqlx.NewDb(stdlib.OpenDB(*config), "pgx")
+	db.SetMaxOpenConns(50)
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrate(t, db)
+
+	return db
+}
+
+// migrate applies every up migration in order.
+func migrate(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+
+	_, file, _, _ := runtime.Caller(0)
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(file), "..", "..", "database", "migration", "*.up.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	sort.Strings(files)
+
+	for _, path := range files {
+		migration, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		_, err = db.ExecContext(context.Background(), string(migration))
+		require.NoError(t, err, fmt.Sprintf("migration %s", filepath.Base(path)))
+	}
+}
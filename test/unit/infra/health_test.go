@@ -0,0 +1,117 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/nathakusuma/conference-backend/internal/infra/health"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const checkTimeout = 50 * time.Millisecond
+
+func passingCheck(ctx context.Context) error {
+	return nil
+}
+
+func failingCheck(ctx context.Context) error {
+	return errors.New("connection refused")
+}
+
+// hangingCheck blocks until its context gives up, like a dependency that never answers.
+func hangingCheck(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+type readinessResponse struct {
+	Status       string                             `json:"status"`
+	Dependencies map[string]health.DependencyStatus `json:"dependencies"`
+}
+
+func getReadiness(t *testing.T, checker *health.Checker) (int, readinessResponse) {
+	app := fiber.New()
+	app.Get("/readyz", checker.Handler())
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/readyz", nil), -1)
+	require.NoError(t, err)
+
+	var body readinessResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+
+	return resp.StatusCode, body
+}
+
+func Test_HealthChecker_Check(t *testing.T) {
+	t.Run("ready when every dependency is up", func(t *testing.T) {
+		checker := health.NewChecker(checkTimeout)
+		checker.Add("postgres", passingCheck)
+		checker.Add("redis", passingCheck)
+
+		ready, statuses := checker.Check(context.Background())
+		assert.True(t, ready)
+		assert.Len(t, statuses, 2)
+		assert.Equal(t, health.StatusUp, statuses["postgres"].Status)
+		assert.Equal(t, health.StatusUp, statuses["redis"].Status)
+	})
+
+	t.Run("not ready when a dependency fails or hangs", func(t *testing.T) {
+		checker := health.NewChecker(checkTimeout)
+		checker.Add("postgres", passingCheck)
+		checker.Add("redis", failingCheck)
+		checker.Add("smtp", hangingCheck)
+
+		start := time.Now()
+		ready, statuses := checker.Check(context.Background())
+		assert.Less(t, time.Since(start), time.Second)
+
+		assert.False(t, ready)
+		assert.Equal(t, health.StatusUp, statuses["postgres"].Status)
+		assert.Empty(t, statuses["postgres"].Error)
+		assert.Equal(t, health.StatusDown, statuses["redis"].Status)
+		assert.Equal(t, "connection refused", statuses["redis"].Error)
+		assert.Equal(t, health.StatusDown, statuses["smtp"].Status)
+		assert.Equal(t, context.DeadlineExceeded.Error(), statuses["smtp"].Error)
+		assert.GreaterOrEqual(t, statuses["smtp"].LatencyMs, checkTimeout.Milliseconds())
+	})
+
+	t.Run("ready without dependencies", func(t *testing.T) {
+		ready, statuses := health.NewChecker(checkTimeout).Check(context.Background())
+		assert.True(t, ready)
+		assert.Empty(t, statuses)
+	})
+}
+
+func Test_HealthChecker_Handler(t *testing.T) {
+	t.Run("200 when every dependency is up", func(t *testing.T) {
+		checker := health.NewChecker(checkTimeout)
+		checker.Add("postgres", passingCheck)
+
+		status, body := getReadiness(t, checker)
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.Equal(t, "ready", body.Status)
+		assert.Equal(t, health.StatusUp, body.Dependencies["postgres"].Status)
+	})
+
+	t.Run("503 with the failing dependencies", func(t *testing.T) {
+		checker := health.NewChecker(checkTimeout)
+		checker.Add("postgres", passingCheck)
+		checker.Add("redis", failingCheck)
+		checker.Add("smtp", hangingCheck)
+
+		status, body := getReadiness(t, checker)
+		assert.Equal(t, fiber.StatusServiceUnavailable, status)
+		assert.Equal(t, "not_ready", body.Status)
+		assert.Equal(t, health.StatusUp, body.Dependencies["postgres"].Status)
+		assert.Equal(t, health.StatusDown, body.Dependencies["redis"].Status)
+		assert.Equal(t, health.StatusDown, body.Dependencies["smtp"].Status)
+	})
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	jwtlib "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/infra/server"
+	"github.com/nathakusuma/conference-backend/internal/middleware"
+	"github.com/nathakusuma/conference-backend/pkg/jwt"
+	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRequireAuthenticatedTest(t *testing.T, userID, sessionID uuid.UUID) (*fiber.App,
+	*appmocks.MockIAuthService) {
+	jwtMock := pkgmocks.NewMockIJwt(t)
+	authSvc := appmocks.NewMockIAuthService(t)
+
+	jwtMock.EXPECT().
+		Decode("access_token", mock.AnythingOfType("*jwt.Claims")).
+		Run(func(_ string, claims *jwt.Claims) {
+			claims.Subject = userID.String()
+			claims.ExpiresAt = jwtlib.NewNumericDate(time.Now().Add(time.Minute))
+			claims.Role = enum.RoleUser
+			claims.SessionID = sessionID
+		}).
+		Return(nil)
+
+	m := middleware.NewMiddleware(jwtMock, nil, nil, authSvc)
+
+	app := fiber.New(fiber.Config{ErrorHandler: server.ErrorHandler()})
+	app.Get("/", m.RequireAuthenticated(), func(ctx *fiber.Ctx) error {
+		return ctx.SendString(ctx.Locals("session.id").(uuid.UUID).String())
+	})
+
+	return app, authSvc
+}
+
+func newAuthenticatedRequest() *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer access_token")
+	return req
+}
+
+func Test_RequireAuthenticated(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	t.Run("lets tokens of active sessions through", func(t *testing.T) {
+		app, authSvc := setupRequireAuthenticatedTest(t, userID, sessionID)
+
+		authSvc.EXPECT().
+			IsSessionRevoked(mock.Anything, sessionID).
+			Return(false, nil)
+
+		resp, err := app.Test(newAuthenticatedRequest())
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("rejects tokens of revoked sessions", func(t *testing.T) {
+		app, authSvc := setupRequireAuthenticatedTest(t, userID, sessionID)
+
+		authSvc.EXPECT().
+			IsSessionRevoked(mock.Anything, sessionID).
+			Return(true, nil)
+
+		resp, err := app.Test(newAuthenticatedRequest())
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("fails when the session can't be checked", func(t *testing.T) {
+		app, authSvc := setupRequireAuthenticatedTest(t, userID, sessionID)
+
+		authSvc.EXPECT().
+			IsSessionRevoked(mock.Anything, sessionID).
+			Return(false, errorpkg.ErrInternalServer.WithTraceID(uuid.New()))
+
+		resp, err := app.Test(newAuthenticatedRequest())
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+	})
+}
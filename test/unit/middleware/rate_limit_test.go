@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/internal/infra/server"
+	"github.com/nathakusuma/conference-backend/internal/middleware"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRateLimitTest(t *testing.T) (*miniredis.Miniredis, *middleware.Middleware) {
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rds.Close() })
+
+	return mr, middleware.NewMiddleware(nil, rds, nil, nil)
+}
+
+func newRateLimitApp(m *middleware.Middleware, config middleware.RateLimitConfig) *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: server.ErrorHandler()})
+	app.Get("/", m.RateLimit(config), func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+
+	return app
+}
+
+// keyOf runs the key function inside a request and returns the key it derived.
+func keyOf(t *testing.T, keyBy middleware.RateLimitKeyFunc, userID *uuid.UUID, req *http.Request) string {
+	app := fiber.New()
+	app.All("/", func(ctx *fiber.Ctx) error {
+		if userID != nil {
+			ctx.Locals("user.id", *userID)
+		}
+		return ctx.SendString(keyBy(ctx))
+	})
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func Test_RateLimit_KeyFuncs(t *testing.T) {
+	t.Run("key by ip", func(t *testing.T) {
+		key := keyOf(t, middleware.KeyByIP(), nil, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, "ip:0.0.0.0", key)
+	})
+
+	t.Run("key by user id", func(t *testing.T) {
+		userID := uuid.New()
+
+		key := keyOf(t, middleware.KeyByUserID(), &userID, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, "user:"+userID.String(), key)
+	})
+
+	t.Run("key by user id falls back to ip", func(t *testing.T) {
+		key := keyOf(t, middleware.KeyByUserID(), nil, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, "ip:0.0.0.0", key)
+	})
+
+	t.Run("key by body field is normalized", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":"  John@Example.COM "}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		key := keyOf(t, middleware.KeyByBodyField("email"), nil, req)
+		assert.Equal(t, "email:john@example.com", key)
+	})
+
+	t.Run("key by body field falls back to ip", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":""}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		key := keyOf(t, middleware.KeyByBodyField("email"), nil, req)
+		assert.Equal(t, "ip:0.0.0.0", key)
+	})
+}
+
+func Test_RateLimit(t *testing.T) {
+	config := middleware.RateLimitConfig{
+		Name:   "test",
+		Max:    2,
+		Window: time.Minute,
+		KeyBy:  middleware.KeyByIP(),
+	}
+
+	t.Run("sets the rate limit headers while under the limit", func(t *testing.T) {
+		mr, m := setupRateLimitTest(t)
+		app := newRateLimitApp(m, config)
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, "2", resp.Header.Get("RateLimit-Limit"))
+		assert.Equal(t, "1", resp.Header.Get("RateLimit-Remaining"))
+		assert.Equal(t, "60", resp.Header.Get("RateLimit-Reset"))
+		assert.Empty(t, resp.Header.Get(fiber.HeaderRetryAfter))
+
+		assert.True(t, mr.Exists("rate_limit:test:ip:0.0.0.0"))
+	})
+
+	t.Run("rejects requests over the limit", func(t *testing.T) {
+		_, m := setupRateLimitTest(t)
+		app := newRateLimitApp(m, config)
+
+		for i := 0; i < config.Max; i++ {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+			require.NoError(t, err)
+			require.Equal(t, fiber.StatusOK, resp.StatusCode)
+		}
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, "0", resp.Header.Get("RateLimit-Remaining"))
+		assert.Equal(t, "60", resp.Header.Get(fiber.HeaderRetryAfter))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, "RATE_LIMITED", body["error_code"])
+	})
+
+	t.Run("counts each key separately", func(t *testing.T) {
+		_, m := setupRateLimitTest(t)
+		app := newRateLimitApp(m, middleware.RateLimitConfig{
+			Name:   "test",
+			Max:    1,
+			Window: time.Minute,
+			KeyBy:  middleware.KeyByBodyField("email"),
+		})
+
+		newRequest := func(email string) *http.Request {
+			req := httptest.NewRequest(http.MethodGet, "/", strings.NewReader(`{"email":"`+email+`"}`))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			return req
+		}
+
+		resp, err := app.Test(newRequest("first@example.com"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		resp, err = app.Test(newRequest("second@example.com"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		resp, err = app.Test(newRequest("first@example.com"))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusTooManyRequests, resp.StatusCode)
+	})
+
+	t.Run("lets requests through when redis is down", func(t *testing.T) {
+		mr, m := setupRateLimitTest(t)
+		app := newRateLimitApp(m, config)
+		mr.Close()
+
+		for i := 0; i < config.Max+1; i++ {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+			require.NoError(t, err)
+			assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+			assert.Empty(t, resp.Header.Get("RateLimit-Limit"))
+		}
+	})
+}
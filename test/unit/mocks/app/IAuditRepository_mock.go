@@ -0,0 +1,154 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	entity "github.com/nathakusuma/conference-backend/domain/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIAuditRepository is an autogenerated mock type for the IAuditRepository type
+type MockIAuditRepository struct {
+	mock.Mock
+}
+
+type MockIAuditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIAuditRepository) EXPECT() *MockIAuditRepository_Expecter {
+	return &MockIAuditRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateEvent provides a mock function with given fields: ctx, event
+func (_m *MockIAuditRepository) CreateEvent(ctx context.Context, event *entity.AuditEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.AuditEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuditRepository_CreateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEvent'
+type MockIAuditRepository_CreateEvent_Call struct {
+	*mock.Call
+}
+
+// CreateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entity.AuditEvent
+func (_e *MockIAuditRepository_Expecter) CreateEvent(ctx interface{}, event interface{}) *MockIAuditRepository_CreateEvent_Call {
+	return &MockIAuditRepository_CreateEvent_Call{Call: _e.mock.On("CreateEvent", ctx, event)}
+}
+
+func (_c *MockIAuditRepository_CreateEvent_Call) Run(run func(ctx context.Context, event *entity.AuditEvent)) *MockIAuditRepository_CreateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *MockIAuditRepository_CreateEvent_Call) Return(_a0 error) *MockIAuditRepository_CreateEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuditRepository_CreateEvent_Call) RunAndReturn(run func(context.Context, *entity.AuditEvent) error) *MockIAuditRepository_CreateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEvents provides a mock function with given fields: ctx, query, lazyReq
+func (_m *MockIAuditRepository) GetEvents(ctx context.Context, query dto.GetAuditEventsQuery, lazyReq dto.LazyLoadQuery) ([]entity.AuditEvent, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, query, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []entity.AuditEvent
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) ([]entity.AuditEvent, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, query, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) []entity.AuditEvent); ok {
+		r0 = rf(ctx, query, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, query, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, query, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIAuditRepository_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type MockIAuditRepository_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dto.GetAuditEventsQuery
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockIAuditRepository_Expecter) GetEvents(ctx interface{}, query interface{}, lazyReq interface{}) *MockIAuditRepository_GetEvents_Call {
+	return &MockIAuditRepository_GetEvents_Call{Call: _e.mock.On("GetEvents", ctx, query, lazyReq)}
+}
+
+func (_c *MockIAuditRepository_GetEvents_Call) Run(run func(ctx context.Context, query dto.GetAuditEventsQuery, lazyReq dto.LazyLoadQuery)) *MockIAuditRepository_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.GetAuditEventsQuery), args[2].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockIAuditRepository_GetEvents_Call) Return(_a0 []entity.AuditEvent, _a1 dto.LazyLoadResponse, _a2 error) *MockIAuditRepository_GetEvents_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIAuditRepository_GetEvents_Call) RunAndReturn(run func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) ([]entity.AuditEvent, dto.LazyLoadResponse, error)) *MockIAuditRepository_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIAuditRepository creates a new instance of MockIAuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIAuditRepository {
+	mock := &MockIAuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIAuditService is an autogenerated mock type for the IAuditService type
+type MockIAuditService struct {
+	mock.Mock
+}
+
+type MockIAuditService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIAuditService) EXPECT() *MockIAuditService_Expecter {
+	return &MockIAuditService_Expecter{mock: &_m.Mock}
+}
+
+// GetEvents provides a mock function with given fields: ctx, query, lazyReq
+func (_m *MockIAuditService) GetEvents(ctx context.Context, query dto.GetAuditEventsQuery, lazyReq dto.LazyLoadQuery) ([]dto.AuditEventResponse, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, query, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []dto.AuditEventResponse
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) ([]dto.AuditEventResponse, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, query, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) []dto.AuditEventResponse); ok {
+		r0 = rf(ctx, query, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.AuditEventResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, query, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, query, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIAuditService_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type MockIAuditService_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dto.GetAuditEventsQuery
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockIAuditService_Expecter) GetEvents(ctx interface{}, query interface{}, lazyReq interface{}) *MockIAuditService_GetEvents_Call {
+	return &MockIAuditService_GetEvents_Call{Call: _e.mock.On("GetEvents", ctx, query, lazyReq)}
+}
+
+func (_c *MockIAuditService_GetEvents_Call) Run(run func(ctx context.Context, query dto.GetAuditEventsQuery, lazyReq dto.LazyLoadQuery)) *MockIAuditService_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.GetAuditEventsQuery), args[2].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockIAuditService_GetEvents_Call) Return(_a0 []dto.AuditEventResponse, _a1 dto.LazyLoadResponse, _a2 error) *MockIAuditService_GetEvents_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIAuditService_GetEvents_Call) RunAndReturn(run func(context.Context, dto.GetAuditEventsQuery, dto.LazyLoadQuery) ([]dto.AuditEventResponse, dto.LazyLoadResponse, error)) *MockIAuditService_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, req
+func (_m *MockIAuditService) Record(ctx context.Context, req dto.RecordAuditEventRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.RecordAuditEventRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuditService_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockIAuditService_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.RecordAuditEventRequest
+func (_e *MockIAuditService_Expecter) Record(ctx interface{}, req interface{}) *MockIAuditService_Record_Call {
+	return &MockIAuditService_Record_Call{Call: _e.mock.On("Record", ctx, req)}
+}
+
+func (_c *MockIAuditService_Record_Call) Run(run func(ctx context.Context, req dto.RecordAuditEventRequest)) *MockIAuditService_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.RecordAuditEventRequest))
+	})
+	return _c
+}
+
+func (_c *MockIAuditService_Record_Call) Return(_a0 error) *MockIAuditService_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuditService_Record_Call) RunAndReturn(run func(context.Context, dto.RecordAuditEventRequest) error) *MockIAuditService_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIAuditService creates a new instance of MockIAuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIAuditService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIAuditService {
+	mock := &MockIAuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
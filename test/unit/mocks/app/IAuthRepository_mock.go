@@ -9,6 +9,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -72,17 +74,65 @@ func (_c *MockIAuthRepository_CreateAuthSession_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// DeleteAuthSession provides a mock function with given fields: ctx, userID
-func (_m *MockIAuthRepository) DeleteAuthSession(ctx context.Context, userID uuid.UUID) error {
-	ret := _m.Called(ctx, userID)
+// DeleteAttempts provides a mock function with given fields: ctx, scope, subject
+func (_m *MockIAuthRepository) DeleteAttempts(ctx context.Context, scope string, subject string) error {
+	ret := _m.Called(ctx, scope, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttempts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, scope, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuthRepository_DeleteAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttempts'
+type MockIAuthRepository_DeleteAttempts_Call struct {
+	*mock.Call
+}
+
+// DeleteAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope string
+//   - subject string
+func (_e *MockIAuthRepository_Expecter) DeleteAttempts(ctx interface{}, scope interface{}, subject interface{}) *MockIAuthRepository_DeleteAttempts_Call {
+	return &MockIAuthRepository_DeleteAttempts_Call{Call: _e.mock.On("DeleteAttempts", ctx, scope, subject)}
+}
+
+func (_c *MockIAuthRepository_DeleteAttempts_Call) Run(run func(ctx context.Context, scope string, subject string)) *MockIAuthRepository_DeleteAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_DeleteAttempts_Call) Return(_a0 error) *MockIAuthRepository_DeleteAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuthRepository_DeleteAttempts_Call) RunAndReturn(run func(context.Context, string, string) error) *MockIAuthRepository_DeleteAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAuthSession provides a mock function with given fields: ctx, familyID, userID
+func (_m *MockIAuthRepository) DeleteAuthSession(ctx context.Context, familyID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, familyID, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteAuthSession")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, familyID, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -97,14 +147,15 @@ type MockIAuthRepository_DeleteAuthSession_Call struct {
 
 // DeleteAuthSession is a helper method to define mock.On call
 //   - ctx context.Context
+//   - familyID uuid.UUID
 //   - userID uuid.UUID
-func (_e *MockIAuthRepository_Expecter) DeleteAuthSession(ctx interface{}, userID interface{}) *MockIAuthRepository_DeleteAuthSession_Call {
-	return &MockIAuthRepository_DeleteAuthSession_Call{Call: _e.mock.On("DeleteAuthSession", ctx, userID)}
+func (_e *MockIAuthRepository_Expecter) DeleteAuthSession(ctx interface{}, familyID interface{}, userID interface{}) *MockIAuthRepository_DeleteAuthSession_Call {
+	return &MockIAuthRepository_DeleteAuthSession_Call{Call: _e.mock.On("DeleteAuthSession", ctx, familyID, userID)}
 }
 
-func (_c *MockIAuthRepository_DeleteAuthSession_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIAuthRepository_DeleteAuthSession_Call {
+func (_c *MockIAuthRepository_DeleteAuthSession_Call) Run(run func(ctx context.Context, familyID uuid.UUID, userID uuid.UUID)) *MockIAuthRepository_DeleteAuthSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -114,7 +165,110 @@ func (_c *MockIAuthRepository_DeleteAuthSession_Call) Return(_a0 error) *MockIAu
 	return _c
 }
 
-func (_c *MockIAuthRepository_DeleteAuthSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIAuthRepository_DeleteAuthSession_Call {
+func (_c *MockIAuthRepository_DeleteAuthSession_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIAuthRepository_DeleteAuthSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAuthSessionsByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockIAuthRepository) DeleteAuthSessionsByUserID(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAuthSessionsByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuthRepository_DeleteAuthSessionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthSessionsByUserID'
+type MockIAuthRepository_DeleteAuthSessionsByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteAuthSessionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIAuthRepository_Expecter) DeleteAuthSessionsByUserID(ctx interface{}, userID interface{}) *MockIAuthRepository_DeleteAuthSessionsByUserID_Call {
+	return &MockIAuthRepository_DeleteAuthSessionsByUserID_Call{Call: _e.mock.On("DeleteAuthSessionsByUserID", ctx, userID)}
+}
+
+func (_c *MockIAuthRepository_DeleteAuthSessionsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIAuthRepository_DeleteAuthSessionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_DeleteAuthSessionsByUserID_Call) Return(_a0 error) *MockIAuthRepository_DeleteAuthSessionsByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuthRepository_DeleteAuthSessionsByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIAuthRepository_DeleteAuthSessionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredAuthSessions provides a mock function with given fields: ctx
+func (_m *MockIAuthRepository) DeleteExpiredAuthSessions(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredAuthSessions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAuthRepository_DeleteExpiredAuthSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredAuthSessions'
+type MockIAuthRepository_DeleteExpiredAuthSessions_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredAuthSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIAuthRepository_Expecter) DeleteExpiredAuthSessions(ctx interface{}) *MockIAuthRepository_DeleteExpiredAuthSessions_Call {
+	return &MockIAuthRepository_DeleteExpiredAuthSessions_Call{Call: _e.mock.On("DeleteExpiredAuthSessions", ctx)}
+}
+
+func (_c *MockIAuthRepository_DeleteExpiredAuthSessions_Call) Run(run func(ctx context.Context)) *MockIAuthRepository_DeleteExpiredAuthSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_DeleteExpiredAuthSessions_Call) Return(_a0 int64, _a1 error) *MockIAuthRepository_DeleteExpiredAuthSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAuthRepository_DeleteExpiredAuthSessions_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockIAuthRepository_DeleteExpiredAuthSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -213,6 +367,71 @@ func (_c *MockIAuthRepository_DeleteOTPResetPassword_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetAttempts provides a mock function with given fields: ctx, scope, subject
+func (_m *MockIAuthRepository) GetAttempts(ctx context.Context, scope string, subject string) (int64, time.Duration, error) {
+	ret := _m.Called(ctx, scope, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttempts")
+	}
+
+	var r0 int64
+	var r1 time.Duration
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, time.Duration, error)); ok {
+		return rf(ctx, scope, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, scope, subject)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) time.Duration); ok {
+		r1 = rf(ctx, scope, subject)
+	} else {
+		r1 = ret.Get(1).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, scope, subject)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIAuthRepository_GetAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttempts'
+type MockIAuthRepository_GetAttempts_Call struct {
+	*mock.Call
+}
+
+// GetAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope string
+//   - subject string
+func (_e *MockIAuthRepository_Expecter) GetAttempts(ctx interface{}, scope interface{}, subject interface{}) *MockIAuthRepository_GetAttempts_Call {
+	return &MockIAuthRepository_GetAttempts_Call{Call: _e.mock.On("GetAttempts", ctx, scope, subject)}
+}
+
+func (_c *MockIAuthRepository_GetAttempts_Call) Run(run func(ctx context.Context, scope string, subject string)) *MockIAuthRepository_GetAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_GetAttempts_Call) Return(_a0 int64, _a1 time.Duration, _a2 error) *MockIAuthRepository_GetAttempts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIAuthRepository_GetAttempts_Call) RunAndReturn(run func(context.Context, string, string) (int64, time.Duration, error)) *MockIAuthRepository_GetAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAuthSessionByToken provides a mock function with given fields: ctx, token
 func (_m *MockIAuthRepository) GetAuthSessionByToken(ctx context.Context, token string) (*entity.AuthSession, error) {
 	ret := _m.Called(ctx, token)
@@ -272,6 +491,65 @@ func (_c *MockIAuthRepository_GetAuthSessionByToken_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetAuthSessionsByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockIAuthRepository) GetAuthSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]entity.AuthSession, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthSessionsByUserID")
+	}
+
+	var r0 []entity.AuthSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.AuthSession, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.AuthSession); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AuthSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAuthRepository_GetAuthSessionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthSessionsByUserID'
+type MockIAuthRepository_GetAuthSessionsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetAuthSessionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIAuthRepository_Expecter) GetAuthSessionsByUserID(ctx interface{}, userID interface{}) *MockIAuthRepository_GetAuthSessionsByUserID_Call {
+	return &MockIAuthRepository_GetAuthSessionsByUserID_Call{Call: _e.mock.On("GetAuthSessionsByUserID", ctx, userID)}
+}
+
+func (_c *MockIAuthRepository_GetAuthSessionsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIAuthRepository_GetAuthSessionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_GetAuthSessionsByUserID_Call) Return(_a0 []entity.AuthSession, _a1 error) *MockIAuthRepository_GetAuthSessionsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAuthRepository_GetAuthSessionsByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.AuthSession, error)) *MockIAuthRepository_GetAuthSessionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOTPRegisterUser provides a mock function with given fields: ctx, email
 func (_m *MockIAuthRepository) GetOTPRegisterUser(ctx context.Context, email string) (string, error) {
 	ret := _m.Called(ctx, email)
@@ -386,6 +664,225 @@ func (_c *MockIAuthRepository_GetOTPResetPassword_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// IncrementAttempts provides a mock function with given fields: ctx, scope, subject, window
+func (_m *MockIAuthRepository) IncrementAttempts(ctx context.Context, scope string, subject string, window time.Duration) (int64, time.Duration, error) {
+	ret := _m.Called(ctx, scope, subject, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementAttempts")
+	}
+
+	var r0 int64
+	var r1 time.Duration
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (int64, time.Duration, error)); ok {
+		return rf(ctx, scope, subject, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) int64); ok {
+		r0 = rf(ctx, scope, subject, window)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) time.Duration); ok {
+		r1 = rf(ctx, scope, subject, window)
+	} else {
+		r1 = ret.Get(1).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, time.Duration) error); ok {
+		r2 = rf(ctx, scope, subject, window)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIAuthRepository_IncrementAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementAttempts'
+type MockIAuthRepository_IncrementAttempts_Call struct {
+	*mock.Call
+}
+
+// IncrementAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope string
+//   - subject string
+//   - window time.Duration
+func (_e *MockIAuthRepository_Expecter) IncrementAttempts(ctx interface{}, scope interface{}, subject interface{}, window interface{}) *MockIAuthRepository_IncrementAttempts_Call {
+	return &MockIAuthRepository_IncrementAttempts_Call{Call: _e.mock.On("IncrementAttempts", ctx, scope, subject, window)}
+}
+
+func (_c *MockIAuthRepository_IncrementAttempts_Call) Run(run func(ctx context.Context, scope string, subject string, window time.Duration)) *MockIAuthRepository_IncrementAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_IncrementAttempts_Call) Return(_a0 int64, _a1 time.Duration, _a2 error) *MockIAuthRepository_IncrementAttempts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIAuthRepository_IncrementAttempts_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) (int64, time.Duration, error)) *MockIAuthRepository_IncrementAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAccessTokenRevoked provides a mock function with given fields: ctx, familyID
+func (_m *MockIAuthRepository) IsAccessTokenRevoked(ctx context.Context, familyID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAccessTokenRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAuthRepository_IsAccessTokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAccessTokenRevoked'
+type MockIAuthRepository_IsAccessTokenRevoked_Call struct {
+	*mock.Call
+}
+
+// IsAccessTokenRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID uuid.UUID
+func (_e *MockIAuthRepository_Expecter) IsAccessTokenRevoked(ctx interface{}, familyID interface{}) *MockIAuthRepository_IsAccessTokenRevoked_Call {
+	return &MockIAuthRepository_IsAccessTokenRevoked_Call{Call: _e.mock.On("IsAccessTokenRevoked", ctx, familyID)}
+}
+
+func (_c *MockIAuthRepository_IsAccessTokenRevoked_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *MockIAuthRepository_IsAccessTokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_IsAccessTokenRevoked_Call) Return(_a0 bool, _a1 error) *MockIAuthRepository_IsAccessTokenRevoked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAuthRepository_IsAccessTokenRevoked_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockIAuthRepository_IsAccessTokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAccessTokens provides a mock function with given fields: ctx, familyIDs, ttl
+func (_m *MockIAuthRepository) RevokeAccessTokens(ctx context.Context, familyIDs []uuid.UUID, ttl time.Duration) error {
+	ret := _m.Called(ctx, familyIDs, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAccessTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, time.Duration) error); ok {
+		r0 = rf(ctx, familyIDs, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuthRepository_RevokeAccessTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccessTokens'
+type MockIAuthRepository_RevokeAccessTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeAccessTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyIDs []uuid.UUID
+//   - ttl time.Duration
+func (_e *MockIAuthRepository_Expecter) RevokeAccessTokens(ctx interface{}, familyIDs interface{}, ttl interface{}) *MockIAuthRepository_RevokeAccessTokens_Call {
+	return &MockIAuthRepository_RevokeAccessTokens_Call{Call: _e.mock.On("RevokeAccessTokens", ctx, familyIDs, ttl)}
+}
+
+func (_c *MockIAuthRepository_RevokeAccessTokens_Call) Run(run func(ctx context.Context, familyIDs []uuid.UUID, ttl time.Duration)) *MockIAuthRepository_RevokeAccessTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_RevokeAccessTokens_Call) Return(_a0 error) *MockIAuthRepository_RevokeAccessTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuthRepository_RevokeAccessTokens_Call) RunAndReturn(run func(context.Context, []uuid.UUID, time.Duration) error) *MockIAuthRepository_RevokeAccessTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateAuthSession provides a mock function with given fields: ctx, oldSession, newSession
+func (_m *MockIAuthRepository) RotateAuthSession(ctx context.Context, oldSession *entity.AuthSession, newSession *entity.AuthSession) error {
+	ret := _m.Called(ctx, oldSession, newSession)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateAuthSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.AuthSession, *entity.AuthSession) error); ok {
+		r0 = rf(ctx, oldSession, newSession)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuthRepository_RotateAuthSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAuthSession'
+type MockIAuthRepository_RotateAuthSession_Call struct {
+	*mock.Call
+}
+
+// RotateAuthSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldSession *entity.AuthSession
+//   - newSession *entity.AuthSession
+func (_e *MockIAuthRepository_Expecter) RotateAuthSession(ctx interface{}, oldSession interface{}, newSession interface{}) *MockIAuthRepository_RotateAuthSession_Call {
+	return &MockIAuthRepository_RotateAuthSession_Call{Call: _e.mock.On("RotateAuthSession", ctx, oldSession, newSession)}
+}
+
+func (_c *MockIAuthRepository_RotateAuthSession_Call) Run(run func(ctx context.Context, oldSession *entity.AuthSession, newSession *entity.AuthSession)) *MockIAuthRepository_RotateAuthSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.AuthSession), args[2].(*entity.AuthSession))
+	})
+	return _c
+}
+
+func (_c *MockIAuthRepository_RotateAuthSession_Call) Return(_a0 error) *MockIAuthRepository_RotateAuthSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuthRepository_RotateAuthSession_Call) RunAndReturn(run func(context.Context, *entity.AuthSession, *entity.AuthSession) error) *MockIAuthRepository_RotateAuthSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetOTPRegisterUser provides a mock function with given fields: ctx, email, otp
 func (_m *MockIAuthRepository) SetOTPRegisterUser(ctx context.Context, email string, otp string) error {
 	ret := _m.Called(ctx, email, otp)
@@ -8,6 +8,8 @@ import (
 	dto "github.com/nathakusuma/conference-backend/domain/dto"
 
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // MockIAuthService is an autogenerated mock type for the IAuthService type
@@ -23,17 +25,17 @@ func (_m *MockIAuthService) EXPECT() *MockIAuthService_Expecter {
 	return &MockIAuthService_Expecter{mock: &_m.Mock}
 }
 
-// CheckOTPRegisterUser provides a mock function with given fields: ctx, email, otp
-func (_m *MockIAuthService) CheckOTPRegisterUser(ctx context.Context, email string, otp string) error {
-	ret := _m.Called(ctx, email, otp)
+// CheckOTPRegisterUser provides a mock function with given fields: ctx, req
+func (_m *MockIAuthService) CheckOTPRegisterUser(ctx context.Context, req dto.CheckOTPRegisterUserRequest) error {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CheckOTPRegisterUser")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, email, otp)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CheckOTPRegisterUserRequest) error); ok {
+		r0 = rf(ctx, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,15 +50,14 @@ type MockIAuthService_CheckOTPRegisterUser_Call struct {
 
 // CheckOTPRegisterUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-//   - otp string
-func (_e *MockIAuthService_Expecter) CheckOTPRegisterUser(ctx interface{}, email interface{}, otp interface{}) *MockIAuthService_CheckOTPRegisterUser_Call {
-	return &MockIAuthService_CheckOTPRegisterUser_Call{Call: _e.mock.On("CheckOTPRegisterUser", ctx, email, otp)}
+//   - req dto.CheckOTPRegisterUserRequest
+func (_e *MockIAuthService_Expecter) CheckOTPRegisterUser(ctx interface{}, req interface{}) *MockIAuthService_CheckOTPRegisterUser_Call {
+	return &MockIAuthService_CheckOTPRegisterUser_Call{Call: _e.mock.On("CheckOTPRegisterUser", ctx, req)}
 }
 
-func (_c *MockIAuthService_CheckOTPRegisterUser_Call) Run(run func(ctx context.Context, email string, otp string)) *MockIAuthService_CheckOTPRegisterUser_Call {
+func (_c *MockIAuthService_CheckOTPRegisterUser_Call) Run(run func(ctx context.Context, req dto.CheckOTPRegisterUserRequest)) *MockIAuthService_CheckOTPRegisterUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(dto.CheckOTPRegisterUserRequest))
 	})
 	return _c
 }
@@ -66,7 +67,168 @@ func (_c *MockIAuthService_CheckOTPRegisterUser_Call) Return(_a0 error) *MockIAu
 	return _c
 }
 
-func (_c *MockIAuthService_CheckOTPRegisterUser_Call) RunAndReturn(run func(context.Context, string, string) error) *MockIAuthService_CheckOTPRegisterUser_Call {
+func (_c *MockIAuthService_CheckOTPRegisterUser_Call) RunAndReturn(run func(context.Context, dto.CheckOTPRegisterUserRequest) error) *MockIAuthService_CheckOTPRegisterUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredSessions provides a mock function with given fields: ctx
+func (_m *MockIAuthService) DeleteExpiredSessions(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuthService_DeleteExpiredSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredSessions'
+type MockIAuthService_DeleteExpiredSessions_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIAuthService_Expecter) DeleteExpiredSessions(ctx interface{}) *MockIAuthService_DeleteExpiredSessions_Call {
+	return &MockIAuthService_DeleteExpiredSessions_Call{Call: _e.mock.On("DeleteExpiredSessions", ctx)}
+}
+
+func (_c *MockIAuthService_DeleteExpiredSessions_Call) Run(run func(ctx context.Context)) *MockIAuthService_DeleteExpiredSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIAuthService_DeleteExpiredSessions_Call) Return(_a0 error) *MockIAuthService_DeleteExpiredSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuthService_DeleteExpiredSessions_Call) RunAndReturn(run func(context.Context) error) *MockIAuthService_DeleteExpiredSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessions provides a mock function with given fields: ctx
+func (_m *MockIAuthService) GetSessions(ctx context.Context) ([]dto.AuthSessionResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessions")
+	}
+
+	var r0 []dto.AuthSessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dto.AuthSessionResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dto.AuthSessionResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.AuthSessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAuthService_GetSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessions'
+type MockIAuthService_GetSessions_Call struct {
+	*mock.Call
+}
+
+// GetSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIAuthService_Expecter) GetSessions(ctx interface{}) *MockIAuthService_GetSessions_Call {
+	return &MockIAuthService_GetSessions_Call{Call: _e.mock.On("GetSessions", ctx)}
+}
+
+func (_c *MockIAuthService_GetSessions_Call) Run(run func(ctx context.Context)) *MockIAuthService_GetSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIAuthService_GetSessions_Call) Return(_a0 []dto.AuthSessionResponse, _a1 error) *MockIAuthService_GetSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAuthService_GetSessions_Call) RunAndReturn(run func(context.Context) ([]dto.AuthSessionResponse, error)) *MockIAuthService_GetSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsSessionRevoked provides a mock function with given fields: ctx, sessionID
+func (_m *MockIAuthService) IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSessionRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAuthService_IsSessionRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSessionRevoked'
+type MockIAuthService_IsSessionRevoked_Call struct {
+	*mock.Call
+}
+
+// IsSessionRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *MockIAuthService_Expecter) IsSessionRevoked(ctx interface{}, sessionID interface{}) *MockIAuthService_IsSessionRevoked_Call {
+	return &MockIAuthService_IsSessionRevoked_Call{Call: _e.mock.On("IsSessionRevoked", ctx, sessionID)}
+}
+
+func (_c *MockIAuthService_IsSessionRevoked_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *MockIAuthService_IsSessionRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIAuthService_IsSessionRevoked_Call) Return(_a0 bool, _a1 error) *MockIAuthService_IsSessionRevoked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAuthService_IsSessionRevoked_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockIAuthService_IsSessionRevoked_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -174,6 +336,52 @@ func (_c *MockIAuthService_Logout_Call) RunAndReturn(run func(context.Context) e
 	return _c
 }
 
+// LogoutAll provides a mock function with given fields: ctx
+func (_m *MockIAuthService) LogoutAll(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuthService_LogoutAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAll'
+type MockIAuthService_LogoutAll_Call struct {
+	*mock.Call
+}
+
+// LogoutAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIAuthService_Expecter) LogoutAll(ctx interface{}) *MockIAuthService_LogoutAll_Call {
+	return &MockIAuthService_LogoutAll_Call{Call: _e.mock.On("LogoutAll", ctx)}
+}
+
+func (_c *MockIAuthService_LogoutAll_Call) Run(run func(ctx context.Context)) *MockIAuthService_LogoutAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIAuthService_LogoutAll_Call) Return(_a0 error) *MockIAuthService_LogoutAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuthService_LogoutAll_Call) RunAndReturn(run func(context.Context) error) *MockIAuthService_LogoutAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshToken provides a mock function with given fields: ctx, refreshToken
 func (_m *MockIAuthService) RefreshToken(ctx context.Context, refreshToken string) (dto.LoginResponse, error) {
 	ret := _m.Called(ctx, refreshToken)
@@ -439,6 +647,53 @@ func (_c *MockIAuthService_ResetPassword_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RevokeSession provides a mock function with given fields: ctx, sessionID
+func (_m *MockIAuthService) RevokeSession(ctx context.Context, sessionID uuid.UUID) error {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIAuthService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockIAuthService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *MockIAuthService_Expecter) RevokeSession(ctx interface{}, sessionID interface{}) *MockIAuthService_RevokeSession_Call {
+	return &MockIAuthService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, sessionID)}
+}
+
+func (_c *MockIAuthService_RevokeSession_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *MockIAuthService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIAuthService_RevokeSession_Call) Return(_a0 error) *MockIAuthService_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIAuthService_RevokeSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIAuthService_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIAuthService creates a new instance of MockIAuthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIAuthService(t interface {
@@ -76,17 +76,64 @@ func (_c *MockIConferenceRepository_CreateConference_Call) RunAndReturn(run func
 	return _c
 }
 
-// DeleteConference provides a mock function with given fields: ctx, id
-func (_m *MockIConferenceRepository) DeleteConference(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// CreateReschedule provides a mock function with given fields: ctx, reschedule
+func (_m *MockIConferenceRepository) CreateReschedule(ctx context.Context, reschedule *entity.ConferenceReschedule) error {
+	ret := _m.Called(ctx, reschedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReschedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ConferenceReschedule) error); ok {
+		r0 = rf(ctx, reschedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIConferenceRepository_CreateReschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReschedule'
+type MockIConferenceRepository_CreateReschedule_Call struct {
+	*mock.Call
+}
+
+// CreateReschedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reschedule *entity.ConferenceReschedule
+func (_e *MockIConferenceRepository_Expecter) CreateReschedule(ctx interface{}, reschedule interface{}) *MockIConferenceRepository_CreateReschedule_Call {
+	return &MockIConferenceRepository_CreateReschedule_Call{Call: _e.mock.On("CreateReschedule", ctx, reschedule)}
+}
+
+func (_c *MockIConferenceRepository_CreateReschedule_Call) Run(run func(ctx context.Context, reschedule *entity.ConferenceReschedule)) *MockIConferenceRepository_CreateReschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ConferenceReschedule))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_CreateReschedule_Call) Return(_a0 error) *MockIConferenceRepository_CreateReschedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_CreateReschedule_Call) RunAndReturn(run func(context.Context, *entity.ConferenceReschedule) error) *MockIConferenceRepository_CreateReschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteConference provides a mock function with given fields: ctx, id, audit
+func (_m *MockIConferenceRepository) DeleteConference(ctx context.Context, id uuid.UUID, audit *entity.ConferenceAudit) error {
+	ret := _m.Called(ctx, id, audit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteConference")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.ConferenceAudit) error); ok {
+		r0 = rf(ctx, id, audit)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -102,13 +149,14 @@ type MockIConferenceRepository_DeleteConference_Call struct {
 // DeleteConference is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockIConferenceRepository_Expecter) DeleteConference(ctx interface{}, id interface{}) *MockIConferenceRepository_DeleteConference_Call {
-	return &MockIConferenceRepository_DeleteConference_Call{Call: _e.mock.On("DeleteConference", ctx, id)}
+//   - audit *entity.ConferenceAudit
+func (_e *MockIConferenceRepository_Expecter) DeleteConference(ctx interface{}, id interface{}, audit interface{}) *MockIConferenceRepository_DeleteConference_Call {
+	return &MockIConferenceRepository_DeleteConference_Call{Call: _e.mock.On("DeleteConference", ctx, id, audit)}
 }
 
-func (_c *MockIConferenceRepository_DeleteConference_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIConferenceRepository_DeleteConference_Call {
+func (_c *MockIConferenceRepository_DeleteConference_Call) Run(run func(ctx context.Context, id uuid.UUID, audit *entity.ConferenceAudit)) *MockIConferenceRepository_DeleteConference_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*entity.ConferenceAudit))
 	})
 	return _c
 }
@@ -118,7 +166,125 @@ func (_c *MockIConferenceRepository_DeleteConference_Call) Return(_a0 error) *Mo
 	return _c
 }
 
-func (_c *MockIConferenceRepository_DeleteConference_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIConferenceRepository_DeleteConference_Call {
+func (_c *MockIConferenceRepository_DeleteConference_Call) RunAndReturn(run func(context.Context, uuid.UUID, *entity.ConferenceAudit) error) *MockIConferenceRepository_DeleteConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveProposalsByHost provides a mock function with given fields: ctx, hostID
+func (_m *MockIConferenceRepository) GetActiveProposalsByHost(ctx context.Context, hostID uuid.UUID) ([]entity.Conference, error) {
+	ret := _m.Called(ctx, hostID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveProposalsByHost")
+	}
+
+	var r0 []entity.Conference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Conference, error)); ok {
+		return rf(ctx, hostID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Conference); ok {
+		r0 = rf(ctx, hostID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Conference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, hostID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_GetActiveProposalsByHost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveProposalsByHost'
+type MockIConferenceRepository_GetActiveProposalsByHost_Call struct {
+	*mock.Call
+}
+
+// GetActiveProposalsByHost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hostID uuid.UUID
+func (_e *MockIConferenceRepository_Expecter) GetActiveProposalsByHost(ctx interface{}, hostID interface{}) *MockIConferenceRepository_GetActiveProposalsByHost_Call {
+	return &MockIConferenceRepository_GetActiveProposalsByHost_Call{Call: _e.mock.On("GetActiveProposalsByHost", ctx, hostID)}
+}
+
+func (_c *MockIConferenceRepository_GetActiveProposalsByHost_Call) Run(run func(ctx context.Context, hostID uuid.UUID)) *MockIConferenceRepository_GetActiveProposalsByHost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetActiveProposalsByHost_Call) Return(_a0 []entity.Conference, _a1 error) *MockIConferenceRepository_GetActiveProposalsByHost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetActiveProposalsByHost_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.Conference, error)) *MockIConferenceRepository_GetActiveProposalsByHost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuditsByConference provides a mock function with given fields: ctx, conferenceID
+func (_m *MockIConferenceRepository) GetAuditsByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.ConferenceAudit, error) {
+	ret := _m.Called(ctx, conferenceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditsByConference")
+	}
+
+	var r0 []entity.ConferenceAudit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.ConferenceAudit, error)); ok {
+		return rf(ctx, conferenceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.ConferenceAudit); ok {
+		r0 = rf(ctx, conferenceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ConferenceAudit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_GetAuditsByConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditsByConference'
+type MockIConferenceRepository_GetAuditsByConference_Call struct {
+	*mock.Call
+}
+
+// GetAuditsByConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+func (_e *MockIConferenceRepository_Expecter) GetAuditsByConference(ctx interface{}, conferenceID interface{}) *MockIConferenceRepository_GetAuditsByConference_Call {
+	return &MockIConferenceRepository_GetAuditsByConference_Call{Call: _e.mock.On("GetAuditsByConference", ctx, conferenceID)}
+}
+
+func (_c *MockIConferenceRepository_GetAuditsByConference_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID)) *MockIConferenceRepository_GetAuditsByConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetAuditsByConference_Call) Return(_a0 []entity.ConferenceAudit, _a1 error) *MockIConferenceRepository_GetAuditsByConference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetAuditsByConference_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.ConferenceAudit, error)) *MockIConferenceRepository_GetAuditsByConference_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -248,9 +414,77 @@ func (_c *MockIConferenceRepository_GetConferences_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// GetConferencesConflictingWithTime provides a mock function with given fields: ctx, startsAt, endsAt, excludeID
-func (_m *MockIConferenceRepository) GetConferencesConflictingWithTime(ctx context.Context, startsAt time.Time, endsAt time.Time, excludeID uuid.UUID) ([]entity.Conference, error) {
-	ret := _m.Called(ctx, startsAt, endsAt, excludeID)
+// GetConferencesBySpeaker provides a mock function with given fields: ctx, userID, includePast, lazyReq
+func (_m *MockIConferenceRepository) GetConferencesBySpeaker(ctx context.Context, userID uuid.UUID, includePast bool, lazyReq dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, userID, includePast, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConferencesBySpeaker")
+	}
+
+	var r0 []entity.Conference
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, userID, includePast, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) []entity.Conference); ok {
+		r0 = rf(ctx, userID, includePast, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Conference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, userID, includePast, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, userID, includePast, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIConferenceRepository_GetConferencesBySpeaker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConferencesBySpeaker'
+type MockIConferenceRepository_GetConferencesBySpeaker_Call struct {
+	*mock.Call
+}
+
+// GetConferencesBySpeaker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - includePast bool
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockIConferenceRepository_Expecter) GetConferencesBySpeaker(ctx interface{}, userID interface{}, includePast interface{}, lazyReq interface{}) *MockIConferenceRepository_GetConferencesBySpeaker_Call {
+	return &MockIConferenceRepository_GetConferencesBySpeaker_Call{Call: _e.mock.On("GetConferencesBySpeaker", ctx, userID, includePast, lazyReq)}
+}
+
+func (_c *MockIConferenceRepository_GetConferencesBySpeaker_Call) Run(run func(ctx context.Context, userID uuid.UUID, includePast bool, lazyReq dto.LazyLoadQuery)) *MockIConferenceRepository_GetConferencesBySpeaker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetConferencesBySpeaker_Call) Return(_a0 []entity.Conference, _a1 dto.LazyLoadResponse, _a2 error) *MockIConferenceRepository_GetConferencesBySpeaker_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetConferencesBySpeaker_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error)) *MockIConferenceRepository_GetConferencesBySpeaker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConferencesConflictingWithTime provides a mock function with given fields: ctx, roomID, startsAt, endsAt, excludeID
+func (_m *MockIConferenceRepository) GetConferencesConflictingWithTime(ctx context.Context, roomID uuid.UUID, startsAt time.Time, endsAt time.Time, excludeID uuid.UUID) ([]entity.Conference, error) {
+	ret := _m.Called(ctx, roomID, startsAt, endsAt, excludeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetConferencesConflictingWithTime")
@@ -258,19 +492,19 @@ func (_m *MockIConferenceRepository) GetConferencesConflictingWithTime(ctx conte
 
 	var r0 []entity.Conference
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, uuid.UUID) ([]entity.Conference, error)); ok {
-		return rf(ctx, startsAt, endsAt, excludeID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time, uuid.UUID) ([]entity.Conference, error)); ok {
+		return rf(ctx, roomID, startsAt, endsAt, excludeID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, uuid.UUID) []entity.Conference); ok {
-		r0 = rf(ctx, startsAt, endsAt, excludeID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time, uuid.UUID) []entity.Conference); ok {
+		r0 = rf(ctx, roomID, startsAt, endsAt, excludeID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Conference)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, uuid.UUID) error); ok {
-		r1 = rf(ctx, startsAt, endsAt, excludeID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time, uuid.UUID) error); ok {
+		r1 = rf(ctx, roomID, startsAt, endsAt, excludeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -285,16 +519,17 @@ type MockIConferenceRepository_GetConferencesConflictingWithTime_Call struct {
 
 // GetConferencesConflictingWithTime is a helper method to define mock.On call
 //   - ctx context.Context
+//   - roomID uuid.UUID
 //   - startsAt time.Time
 //   - endsAt time.Time
 //   - excludeID uuid.UUID
-func (_e *MockIConferenceRepository_Expecter) GetConferencesConflictingWithTime(ctx interface{}, startsAt interface{}, endsAt interface{}, excludeID interface{}) *MockIConferenceRepository_GetConferencesConflictingWithTime_Call {
-	return &MockIConferenceRepository_GetConferencesConflictingWithTime_Call{Call: _e.mock.On("GetConferencesConflictingWithTime", ctx, startsAt, endsAt, excludeID)}
+func (_e *MockIConferenceRepository_Expecter) GetConferencesConflictingWithTime(ctx interface{}, roomID interface{}, startsAt interface{}, endsAt interface{}, excludeID interface{}) *MockIConferenceRepository_GetConferencesConflictingWithTime_Call {
+	return &MockIConferenceRepository_GetConferencesConflictingWithTime_Call{Call: _e.mock.On("GetConferencesConflictingWithTime", ctx, roomID, startsAt, endsAt, excludeID)}
 }
 
-func (_c *MockIConferenceRepository_GetConferencesConflictingWithTime_Call) Run(run func(ctx context.Context, startsAt time.Time, endsAt time.Time, excludeID uuid.UUID)) *MockIConferenceRepository_GetConferencesConflictingWithTime_Call {
+func (_c *MockIConferenceRepository_GetConferencesConflictingWithTime_Call) Run(run func(ctx context.Context, roomID uuid.UUID, startsAt time.Time, endsAt time.Time, excludeID uuid.UUID)) *MockIConferenceRepository_GetConferencesConflictingWithTime_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time), args[4].(uuid.UUID))
 	})
 	return _c
 }
@@ -304,22 +539,533 @@ func (_c *MockIConferenceRepository_GetConferencesConflictingWithTime_Call) Retu
 	return _c
 }
 
-func (_c *MockIConferenceRepository_GetConferencesConflictingWithTime_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, uuid.UUID) ([]entity.Conference, error)) *MockIConferenceRepository_GetConferencesConflictingWithTime_Call {
+func (_c *MockIConferenceRepository_GetConferencesConflictingWithTime_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time, uuid.UUID) ([]entity.Conference, error)) *MockIConferenceRepository_GetConferencesConflictingWithTime_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateConference provides a mock function with given fields: ctx, conference
-func (_m *MockIConferenceRepository) UpdateConference(ctx context.Context, conference *entity.Conference) error {
-	ret := _m.Called(ctx, conference)
+// GetLatestReview provides a mock function with given fields: ctx, conferenceID
+func (_m *MockIConferenceRepository) GetLatestReview(ctx context.Context, conferenceID uuid.UUID) (*entity.ConferenceReview, error) {
+	ret := _m.Called(ctx, conferenceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestReview")
+	}
+
+	var r0 *entity.ConferenceReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ConferenceReview, error)); ok {
+		return rf(ctx, conferenceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ConferenceReview); ok {
+		r0 = rf(ctx, conferenceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ConferenceReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_GetLatestReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestReview'
+type MockIConferenceRepository_GetLatestReview_Call struct {
+	*mock.Call
+}
+
+// GetLatestReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+func (_e *MockIConferenceRepository_Expecter) GetLatestReview(ctx interface{}, conferenceID interface{}) *MockIConferenceRepository_GetLatestReview_Call {
+	return &MockIConferenceRepository_GetLatestReview_Call{Call: _e.mock.On("GetLatestReview", ctx, conferenceID)}
+}
+
+func (_c *MockIConferenceRepository_GetLatestReview_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID)) *MockIConferenceRepository_GetLatestReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetLatestReview_Call) Return(_a0 *entity.ConferenceReview, _a1 error) *MockIConferenceRepository_GetLatestReview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetLatestReview_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.ConferenceReview, error)) *MockIConferenceRepository_GetLatestReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRescheduleByID provides a mock function with given fields: ctx, id
+func (_m *MockIConferenceRepository) GetRescheduleByID(ctx context.Context, id uuid.UUID) (*entity.ConferenceReschedule, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRescheduleByID")
+	}
+
+	var r0 *entity.ConferenceReschedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ConferenceReschedule, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ConferenceReschedule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ConferenceReschedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_GetRescheduleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRescheduleByID'
+type MockIConferenceRepository_GetRescheduleByID_Call struct {
+	*mock.Call
+}
+
+// GetRescheduleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIConferenceRepository_Expecter) GetRescheduleByID(ctx interface{}, id interface{}) *MockIConferenceRepository_GetRescheduleByID_Call {
+	return &MockIConferenceRepository_GetRescheduleByID_Call{Call: _e.mock.On("GetRescheduleByID", ctx, id)}
+}
+
+func (_c *MockIConferenceRepository_GetRescheduleByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIConferenceRepository_GetRescheduleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetRescheduleByID_Call) Return(_a0 *entity.ConferenceReschedule, _a1 error) *MockIConferenceRepository_GetRescheduleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetRescheduleByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.ConferenceReschedule, error)) *MockIConferenceRepository_GetRescheduleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReschedulesByConference provides a mock function with given fields: ctx, conferenceID
+func (_m *MockIConferenceRepository) GetReschedulesByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.ConferenceReschedule, error) {
+	ret := _m.Called(ctx, conferenceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReschedulesByConference")
+	}
+
+	var r0 []entity.ConferenceReschedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.ConferenceReschedule, error)); ok {
+		return rf(ctx, conferenceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.ConferenceReschedule); ok {
+		r0 = rf(ctx, conferenceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ConferenceReschedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_GetReschedulesByConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReschedulesByConference'
+type MockIConferenceRepository_GetReschedulesByConference_Call struct {
+	*mock.Call
+}
+
+// GetReschedulesByConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+func (_e *MockIConferenceRepository_Expecter) GetReschedulesByConference(ctx interface{}, conferenceID interface{}) *MockIConferenceRepository_GetReschedulesByConference_Call {
+	return &MockIConferenceRepository_GetReschedulesByConference_Call{Call: _e.mock.On("GetReschedulesByConference", ctx, conferenceID)}
+}
+
+func (_c *MockIConferenceRepository_GetReschedulesByConference_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID)) *MockIConferenceRepository_GetReschedulesByConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetReschedulesByConference_Call) Return(_a0 []entity.ConferenceReschedule, _a1 error) *MockIConferenceRepository_GetReschedulesByConference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetReschedulesByConference_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.ConferenceReschedule, error)) *MockIConferenceRepository_GetReschedulesByConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReviewsByConference provides a mock function with given fields: ctx, conferenceID
+func (_m *MockIConferenceRepository) GetReviewsByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.ConferenceReview, error) {
+	ret := _m.Called(ctx, conferenceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewsByConference")
+	}
+
+	var r0 []entity.ConferenceReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.ConferenceReview, error)); ok {
+		return rf(ctx, conferenceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.ConferenceReview); ok {
+		r0 = rf(ctx, conferenceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ConferenceReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_GetReviewsByConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReviewsByConference'
+type MockIConferenceRepository_GetReviewsByConference_Call struct {
+	*mock.Call
+}
+
+// GetReviewsByConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+func (_e *MockIConferenceRepository_Expecter) GetReviewsByConference(ctx interface{}, conferenceID interface{}) *MockIConferenceRepository_GetReviewsByConference_Call {
+	return &MockIConferenceRepository_GetReviewsByConference_Call{Call: _e.mock.On("GetReviewsByConference", ctx, conferenceID)}
+}
+
+func (_c *MockIConferenceRepository_GetReviewsByConference_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID)) *MockIConferenceRepository_GetReviewsByConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetReviewsByConference_Call) Return(_a0 []entity.ConferenceReview, _a1 error) *MockIConferenceRepository_GetReviewsByConference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetReviewsByConference_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.ConferenceReview, error)) *MockIConferenceRepository_GetReviewsByConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpeakersByConferences provides a mock function with given fields: ctx, conferenceIDs
+func (_m *MockIConferenceRepository) GetSpeakersByConferences(ctx context.Context, conferenceIDs []uuid.UUID) ([]entity.ConferenceSpeaker, error) {
+	ret := _m.Called(ctx, conferenceIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpeakersByConferences")
+	}
+
+	var r0 []entity.ConferenceSpeaker
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]entity.ConferenceSpeaker, error)); ok {
+		return rf(ctx, conferenceIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []entity.ConferenceSpeaker); ok {
+		r0 = rf(ctx, conferenceIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ConferenceSpeaker)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_GetSpeakersByConferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpeakersByConferences'
+type MockIConferenceRepository_GetSpeakersByConferences_Call struct {
+	*mock.Call
+}
+
+// GetSpeakersByConferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceIDs []uuid.UUID
+func (_e *MockIConferenceRepository_Expecter) GetSpeakersByConferences(ctx interface{}, conferenceIDs interface{}) *MockIConferenceRepository_GetSpeakersByConferences_Call {
+	return &MockIConferenceRepository_GetSpeakersByConferences_Call{Call: _e.mock.On("GetSpeakersByConferences", ctx, conferenceIDs)}
+}
+
+func (_c *MockIConferenceRepository_GetSpeakersByConferences_Call) Run(run func(ctx context.Context, conferenceIDs []uuid.UUID)) *MockIConferenceRepository_GetSpeakersByConferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetSpeakersByConferences_Call) Return(_a0 []entity.ConferenceSpeaker, _a1 error) *MockIConferenceRepository_GetSpeakersByConferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_GetSpeakersByConferences_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]entity.ConferenceSpeaker, error)) *MockIConferenceRepository_GetSpeakersByConferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserRegisteredToConference provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIConferenceRepository) IsUserRegisteredToConference(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserRegisteredToConference")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, conferenceID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_IsUserRegisteredToConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserRegisteredToConference'
+type MockIConferenceRepository_IsUserRegisteredToConference_Call struct {
+	*mock.Call
+}
+
+// IsUserRegisteredToConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIConferenceRepository_Expecter) IsUserRegisteredToConference(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIConferenceRepository_IsUserRegisteredToConference_Call {
+	return &MockIConferenceRepository_IsUserRegisteredToConference_Call{Call: _e.mock.On("IsUserRegisteredToConference", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIConferenceRepository_IsUserRegisteredToConference_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIConferenceRepository_IsUserRegisteredToConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_IsUserRegisteredToConference_Call) Return(_a0 bool, _a1 error) *MockIConferenceRepository_IsUserRegisteredToConference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_IsUserRegisteredToConference_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockIConferenceRepository_IsUserRegisteredToConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceSpeakers provides a mock function with given fields: ctx, conference, audit
+func (_m *MockIConferenceRepository) ReplaceSpeakers(ctx context.Context, conference *entity.Conference, audit *entity.ConferenceAudit) error {
+	ret := _m.Called(ctx, conference, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceSpeakers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Conference, *entity.ConferenceAudit) error); ok {
+		r0 = rf(ctx, conference, audit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIConferenceRepository_ReplaceSpeakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceSpeakers'
+type MockIConferenceRepository_ReplaceSpeakers_Call struct {
+	*mock.Call
+}
+
+// ReplaceSpeakers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conference *entity.Conference
+//   - audit *entity.ConferenceAudit
+func (_e *MockIConferenceRepository_Expecter) ReplaceSpeakers(ctx interface{}, conference interface{}, audit interface{}) *MockIConferenceRepository_ReplaceSpeakers_Call {
+	return &MockIConferenceRepository_ReplaceSpeakers_Call{Call: _e.mock.On("ReplaceSpeakers", ctx, conference, audit)}
+}
+
+func (_c *MockIConferenceRepository_ReplaceSpeakers_Call) Run(run func(ctx context.Context, conference *entity.Conference, audit *entity.ConferenceAudit)) *MockIConferenceRepository_ReplaceSpeakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Conference), args[2].(*entity.ConferenceAudit))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_ReplaceSpeakers_Call) Return(_a0 error) *MockIConferenceRepository_ReplaceSpeakers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_ReplaceSpeakers_Call) RunAndReturn(run func(context.Context, *entity.Conference, *entity.ConferenceAudit) error) *MockIConferenceRepository_ReplaceSpeakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReviewConference provides a mock function with given fields: ctx, conference, review, audit
+func (_m *MockIConferenceRepository) ReviewConference(ctx context.Context, conference *entity.Conference, review *entity.ConferenceReview, audit *entity.ConferenceAudit) error {
+	ret := _m.Called(ctx, conference, review, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewConference")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Conference, *entity.ConferenceReview, *entity.ConferenceAudit) error); ok {
+		r0 = rf(ctx, conference, review, audit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIConferenceRepository_ReviewConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReviewConference'
+type MockIConferenceRepository_ReviewConference_Call struct {
+	*mock.Call
+}
+
+// ReviewConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conference *entity.Conference
+//   - review *entity.ConferenceReview
+//   - audit *entity.ConferenceAudit
+func (_e *MockIConferenceRepository_Expecter) ReviewConference(ctx interface{}, conference interface{}, review interface{}, audit interface{}) *MockIConferenceRepository_ReviewConference_Call {
+	return &MockIConferenceRepository_ReviewConference_Call{Call: _e.mock.On("ReviewConference", ctx, conference, review, audit)}
+}
+
+func (_c *MockIConferenceRepository_ReviewConference_Call) Run(run func(ctx context.Context, conference *entity.Conference, review *entity.ConferenceReview, audit *entity.ConferenceAudit)) *MockIConferenceRepository_ReviewConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Conference), args[2].(*entity.ConferenceReview), args[3].(*entity.ConferenceAudit))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_ReviewConference_Call) Return(_a0 error) *MockIConferenceRepository_ReviewConference_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_ReviewConference_Call) RunAndReturn(run func(context.Context, *entity.Conference, *entity.ConferenceReview, *entity.ConferenceAudit) error) *MockIConferenceRepository_ReviewConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReviewReschedule provides a mock function with given fields: ctx, reschedule, conference, audit
+func (_m *MockIConferenceRepository) ReviewReschedule(ctx context.Context, reschedule *entity.ConferenceReschedule, conference *entity.Conference, audit *entity.ConferenceAudit) ([]entity.Conference, error) {
+	ret := _m.Called(ctx, reschedule, conference, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewReschedule")
+	}
+
+	var r0 []entity.Conference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ConferenceReschedule, *entity.Conference, *entity.ConferenceAudit) ([]entity.Conference, error)); ok {
+		return rf(ctx, reschedule, conference, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ConferenceReschedule, *entity.Conference, *entity.ConferenceAudit) []entity.Conference); ok {
+		r0 = rf(ctx, reschedule, conference, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Conference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.ConferenceReschedule, *entity.Conference, *entity.ConferenceAudit) error); ok {
+		r1 = rf(ctx, reschedule, conference, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceRepository_ReviewReschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReviewReschedule'
+type MockIConferenceRepository_ReviewReschedule_Call struct {
+	*mock.Call
+}
+
+// ReviewReschedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reschedule *entity.ConferenceReschedule
+//   - conference *entity.Conference
+//   - audit *entity.ConferenceAudit
+func (_e *MockIConferenceRepository_Expecter) ReviewReschedule(ctx interface{}, reschedule interface{}, conference interface{}, audit interface{}) *MockIConferenceRepository_ReviewReschedule_Call {
+	return &MockIConferenceRepository_ReviewReschedule_Call{Call: _e.mock.On("ReviewReschedule", ctx, reschedule, conference, audit)}
+}
+
+func (_c *MockIConferenceRepository_ReviewReschedule_Call) Run(run func(ctx context.Context, reschedule *entity.ConferenceReschedule, conference *entity.Conference, audit *entity.ConferenceAudit)) *MockIConferenceRepository_ReviewReschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ConferenceReschedule), args[2].(*entity.Conference), args[3].(*entity.ConferenceAudit))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceRepository_ReviewReschedule_Call) Return(_a0 []entity.Conference, _a1 error) *MockIConferenceRepository_ReviewReschedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceRepository_ReviewReschedule_Call) RunAndReturn(run func(context.Context, *entity.ConferenceReschedule, *entity.Conference, *entity.ConferenceAudit) ([]entity.Conference, error)) *MockIConferenceRepository_ReviewReschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConference provides a mock function with given fields: ctx, conference, audit
+func (_m *MockIConferenceRepository) UpdateConference(ctx context.Context, conference *entity.Conference, audit *entity.ConferenceAudit) error {
+	ret := _m.Called(ctx, conference, audit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateConference")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Conference) error); ok {
-		r0 = rf(ctx, conference)
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Conference, *entity.ConferenceAudit) error); ok {
+		r0 = rf(ctx, conference, audit)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -335,13 +1081,14 @@ type MockIConferenceRepository_UpdateConference_Call struct {
 // UpdateConference is a helper method to define mock.On call
 //   - ctx context.Context
 //   - conference *entity.Conference
-func (_e *MockIConferenceRepository_Expecter) UpdateConference(ctx interface{}, conference interface{}) *MockIConferenceRepository_UpdateConference_Call {
-	return &MockIConferenceRepository_UpdateConference_Call{Call: _e.mock.On("UpdateConference", ctx, conference)}
+//   - audit *entity.ConferenceAudit
+func (_e *MockIConferenceRepository_Expecter) UpdateConference(ctx interface{}, conference interface{}, audit interface{}) *MockIConferenceRepository_UpdateConference_Call {
+	return &MockIConferenceRepository_UpdateConference_Call{Call: _e.mock.On("UpdateConference", ctx, conference, audit)}
 }
 
-func (_c *MockIConferenceRepository_UpdateConference_Call) Run(run func(ctx context.Context, conference *entity.Conference)) *MockIConferenceRepository_UpdateConference_Call {
+func (_c *MockIConferenceRepository_UpdateConference_Call) Run(run func(ctx context.Context, conference *entity.Conference, audit *entity.ConferenceAudit)) *MockIConferenceRepository_UpdateConference_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*entity.Conference))
+		run(args[0].(context.Context), args[1].(*entity.Conference), args[2].(*entity.ConferenceAudit))
 	})
 	return _c
 }
@@ -351,7 +1098,7 @@ func (_c *MockIConferenceRepository_UpdateConference_Call) Return(_a0 error) *Mo
 	return _c
 }
 
-func (_c *MockIConferenceRepository_UpdateConference_Call) RunAndReturn(run func(context.Context, *entity.Conference) error) *MockIConferenceRepository_UpdateConference_Call {
+func (_c *MockIConferenceRepository_UpdateConference_Call) RunAndReturn(run func(context.Context, *entity.Conference, *entity.ConferenceAudit) error) *MockIConferenceRepository_UpdateConference_Call {
 	_c.Call.Return(run)
 	return _c
 }
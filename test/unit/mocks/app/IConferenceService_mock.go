@@ -7,8 +7,6 @@ import (
 
 	dto "github.com/nathakusuma/conference-backend/domain/dto"
 
-	enum "github.com/nathakusuma/conference-backend/domain/enum"
-
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -27,6 +25,54 @@ func (_m *MockIConferenceService) EXPECT() *MockIConferenceService_Expecter {
 	return &MockIConferenceService_Expecter{mock: &_m.Mock}
 }
 
+// CancelConference provides a mock function with given fields: ctx, id, req
+func (_m *MockIConferenceService) CancelConference(ctx context.Context, id uuid.UUID, req dto.CancelConferenceRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelConference")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CancelConferenceRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIConferenceService_CancelConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelConference'
+type MockIConferenceService_CancelConference_Call struct {
+	*mock.Call
+}
+
+// CancelConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - req dto.CancelConferenceRequest
+func (_e *MockIConferenceService_Expecter) CancelConference(ctx interface{}, id interface{}, req interface{}) *MockIConferenceService_CancelConference_Call {
+	return &MockIConferenceService_CancelConference_Call{Call: _e.mock.On("CancelConference", ctx, id, req)}
+}
+
+func (_c *MockIConferenceService_CancelConference_Call) Run(run func(ctx context.Context, id uuid.UUID, req dto.CancelConferenceRequest)) *MockIConferenceService_CancelConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.CancelConferenceRequest))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceService_CancelConference_Call) Return(_a0 error) *MockIConferenceService_CancelConference_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIConferenceService_CancelConference_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.CancelConferenceRequest) error) *MockIConferenceService_CancelConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateConferenceProposal provides a mock function with given fields: ctx, req
 func (_m *MockIConferenceService) CreateConferenceProposal(ctx context.Context, req *dto.CreateConferenceProposalRequest) (uuid.UUID, error) {
 	ret := _m.Called(ctx, req)
@@ -192,6 +238,124 @@ func (_c *MockIConferenceService_GetConferenceByID_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetConferenceHistory provides a mock function with given fields: ctx, id
+func (_m *MockIConferenceService) GetConferenceHistory(ctx context.Context, id uuid.UUID) ([]dto.ConferenceAuditResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConferenceHistory")
+	}
+
+	var r0 []dto.ConferenceAuditResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.ConferenceAuditResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.ConferenceAuditResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ConferenceAuditResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceService_GetConferenceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConferenceHistory'
+type MockIConferenceService_GetConferenceHistory_Call struct {
+	*mock.Call
+}
+
+// GetConferenceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIConferenceService_Expecter) GetConferenceHistory(ctx interface{}, id interface{}) *MockIConferenceService_GetConferenceHistory_Call {
+	return &MockIConferenceService_GetConferenceHistory_Call{Call: _e.mock.On("GetConferenceHistory", ctx, id)}
+}
+
+func (_c *MockIConferenceService_GetConferenceHistory_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIConferenceService_GetConferenceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceService_GetConferenceHistory_Call) Return(_a0 []dto.ConferenceAuditResponse, _a1 error) *MockIConferenceService_GetConferenceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceService_GetConferenceHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.ConferenceAuditResponse, error)) *MockIConferenceService_GetConferenceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConferenceReviews provides a mock function with given fields: ctx, id
+func (_m *MockIConferenceService) GetConferenceReviews(ctx context.Context, id uuid.UUID) ([]dto.ConferenceReviewResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConferenceReviews")
+	}
+
+	var r0 []dto.ConferenceReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.ConferenceReviewResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.ConferenceReviewResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ConferenceReviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceService_GetConferenceReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConferenceReviews'
+type MockIConferenceService_GetConferenceReviews_Call struct {
+	*mock.Call
+}
+
+// GetConferenceReviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIConferenceService_Expecter) GetConferenceReviews(ctx interface{}, id interface{}) *MockIConferenceService_GetConferenceReviews_Call {
+	return &MockIConferenceService_GetConferenceReviews_Call{Call: _e.mock.On("GetConferenceReviews", ctx, id)}
+}
+
+func (_c *MockIConferenceService_GetConferenceReviews_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIConferenceService_GetConferenceReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceService_GetConferenceReviews_Call) Return(_a0 []dto.ConferenceReviewResponse, _a1 error) *MockIConferenceService_GetConferenceReviews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceService_GetConferenceReviews_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.ConferenceReviewResponse, error)) *MockIConferenceService_GetConferenceReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConferences provides a mock function with given fields: ctx, query
 func (_m *MockIConferenceService) GetConferences(ctx context.Context, query *dto.GetConferenceQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error) {
 	ret := _m.Called(ctx, query)
@@ -258,6 +422,242 @@ func (_c *MockIConferenceService_GetConferences_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetReschedules provides a mock function with given fields: ctx, id
+func (_m *MockIConferenceService) GetReschedules(ctx context.Context, id uuid.UUID) ([]dto.ConferenceRescheduleResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReschedules")
+	}
+
+	var r0 []dto.ConferenceRescheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.ConferenceRescheduleResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.ConferenceRescheduleResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ConferenceRescheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceService_GetReschedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReschedules'
+type MockIConferenceService_GetReschedules_Call struct {
+	*mock.Call
+}
+
+// GetReschedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIConferenceService_Expecter) GetReschedules(ctx interface{}, id interface{}) *MockIConferenceService_GetReschedules_Call {
+	return &MockIConferenceService_GetReschedules_Call{Call: _e.mock.On("GetReschedules", ctx, id)}
+}
+
+func (_c *MockIConferenceService_GetReschedules_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIConferenceService_GetReschedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceService_GetReschedules_Call) Return(_a0 []dto.ConferenceRescheduleResponse, _a1 error) *MockIConferenceService_GetReschedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceService_GetReschedules_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.ConferenceRescheduleResponse, error)) *MockIConferenceService_GetReschedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpeakerConferences provides a mock function with given fields: ctx, userID, includePast, lazyReq
+func (_m *MockIConferenceService) GetSpeakerConferences(ctx context.Context, userID uuid.UUID, includePast bool, lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, userID, includePast, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpeakerConferences")
+	}
+
+	var r0 []dto.ConferenceResponse
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, userID, includePast, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) []dto.ConferenceResponse); ok {
+		r0 = rf(ctx, userID, includePast, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ConferenceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, userID, includePast, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, userID, includePast, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIConferenceService_GetSpeakerConferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpeakerConferences'
+type MockIConferenceService_GetSpeakerConferences_Call struct {
+	*mock.Call
+}
+
+// GetSpeakerConferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - includePast bool
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockIConferenceService_Expecter) GetSpeakerConferences(ctx interface{}, userID interface{}, includePast interface{}, lazyReq interface{}) *MockIConferenceService_GetSpeakerConferences_Call {
+	return &MockIConferenceService_GetSpeakerConferences_Call{Call: _e.mock.On("GetSpeakerConferences", ctx, userID, includePast, lazyReq)}
+}
+
+func (_c *MockIConferenceService_GetSpeakerConferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, includePast bool, lazyReq dto.LazyLoadQuery)) *MockIConferenceService_GetSpeakerConferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceService_GetSpeakerConferences_Call) Return(_a0 []dto.ConferenceResponse, _a1 dto.LazyLoadResponse, _a2 error) *MockIConferenceService_GetSpeakerConferences_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIConferenceService_GetSpeakerConferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)) *MockIConferenceService_GetSpeakerConferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestReschedule provides a mock function with given fields: ctx, id, req
+func (_m *MockIConferenceService) RequestReschedule(ctx context.Context, id uuid.UUID, req dto.RequestRescheduleRequest) (uuid.UUID, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestReschedule")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.RequestRescheduleRequest) (uuid.UUID, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.RequestRescheduleRequest) uuid.UUID); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.RequestRescheduleRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIConferenceService_RequestReschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestReschedule'
+type MockIConferenceService_RequestReschedule_Call struct {
+	*mock.Call
+}
+
+// RequestReschedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - req dto.RequestRescheduleRequest
+func (_e *MockIConferenceService_Expecter) RequestReschedule(ctx interface{}, id interface{}, req interface{}) *MockIConferenceService_RequestReschedule_Call {
+	return &MockIConferenceService_RequestReschedule_Call{Call: _e.mock.On("RequestReschedule", ctx, id, req)}
+}
+
+func (_c *MockIConferenceService_RequestReschedule_Call) Run(run func(ctx context.Context, id uuid.UUID, req dto.RequestRescheduleRequest)) *MockIConferenceService_RequestReschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.RequestRescheduleRequest))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceService_RequestReschedule_Call) Return(_a0 uuid.UUID, _a1 error) *MockIConferenceService_RequestReschedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIConferenceService_RequestReschedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.RequestRescheduleRequest) (uuid.UUID, error)) *MockIConferenceService_RequestReschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReviewReschedule provides a mock function with given fields: ctx, id, rescheduleID, req
+func (_m *MockIConferenceService) ReviewReschedule(ctx context.Context, id uuid.UUID, rescheduleID uuid.UUID, req dto.ReviewRescheduleRequest) error {
+	ret := _m.Called(ctx, id, rescheduleID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewReschedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dto.ReviewRescheduleRequest) error); ok {
+		r0 = rf(ctx, id, rescheduleID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIConferenceService_ReviewReschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReviewReschedule'
+type MockIConferenceService_ReviewReschedule_Call struct {
+	*mock.Call
+}
+
+// ReviewReschedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - rescheduleID uuid.UUID
+//   - req dto.ReviewRescheduleRequest
+func (_e *MockIConferenceService_Expecter) ReviewReschedule(ctx interface{}, id interface{}, rescheduleID interface{}, req interface{}) *MockIConferenceService_ReviewReschedule_Call {
+	return &MockIConferenceService_ReviewReschedule_Call{Call: _e.mock.On("ReviewReschedule", ctx, id, rescheduleID, req)}
+}
+
+func (_c *MockIConferenceService_ReviewReschedule_Call) Run(run func(ctx context.Context, id uuid.UUID, rescheduleID uuid.UUID, req dto.ReviewRescheduleRequest)) *MockIConferenceService_ReviewReschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(dto.ReviewRescheduleRequest))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceService_ReviewReschedule_Call) Return(_a0 error) *MockIConferenceService_ReviewReschedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIConferenceService_ReviewReschedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, dto.ReviewRescheduleRequest) error) *MockIConferenceService_ReviewReschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateConference provides a mock function with given fields: ctx, id, req
 func (_m *MockIConferenceService) UpdateConference(ctx context.Context, id uuid.UUID, req dto.UpdateConferenceRequest) error {
 	ret := _m.Called(ctx, id, req)
@@ -306,17 +706,65 @@ func (_c *MockIConferenceService_UpdateConference_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// UpdateConferenceStatus provides a mock function with given fields: ctx, id, status
-func (_m *MockIConferenceService) UpdateConferenceStatus(ctx context.Context, id uuid.UUID, status enum.ConferenceStatus) error {
-	ret := _m.Called(ctx, id, status)
+// UpdateConferenceSpeakers provides a mock function with given fields: ctx, id, req
+func (_m *MockIConferenceService) UpdateConferenceSpeakers(ctx context.Context, id uuid.UUID, req dto.UpdateConferenceSpeakersRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConferenceSpeakers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.UpdateConferenceSpeakersRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIConferenceService_UpdateConferenceSpeakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConferenceSpeakers'
+type MockIConferenceService_UpdateConferenceSpeakers_Call struct {
+	*mock.Call
+}
+
+// UpdateConferenceSpeakers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - req dto.UpdateConferenceSpeakersRequest
+func (_e *MockIConferenceService_Expecter) UpdateConferenceSpeakers(ctx interface{}, id interface{}, req interface{}) *MockIConferenceService_UpdateConferenceSpeakers_Call {
+	return &MockIConferenceService_UpdateConferenceSpeakers_Call{Call: _e.mock.On("UpdateConferenceSpeakers", ctx, id, req)}
+}
+
+func (_c *MockIConferenceService_UpdateConferenceSpeakers_Call) Run(run func(ctx context.Context, id uuid.UUID, req dto.UpdateConferenceSpeakersRequest)) *MockIConferenceService_UpdateConferenceSpeakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.UpdateConferenceSpeakersRequest))
+	})
+	return _c
+}
+
+func (_c *MockIConferenceService_UpdateConferenceSpeakers_Call) Return(_a0 error) *MockIConferenceService_UpdateConferenceSpeakers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIConferenceService_UpdateConferenceSpeakers_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.UpdateConferenceSpeakersRequest) error) *MockIConferenceService_UpdateConferenceSpeakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConferenceStatus provides a mock function with given fields: ctx, id, req
+func (_m *MockIConferenceService) UpdateConferenceStatus(ctx context.Context, id uuid.UUID, req dto.UpdateConferenceStatusRequest) error {
+	ret := _m.Called(ctx, id, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateConferenceStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, enum.ConferenceStatus) error); ok {
-		r0 = rf(ctx, id, status)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.UpdateConferenceStatusRequest) error); ok {
+		r0 = rf(ctx, id, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -332,14 +780,14 @@ type MockIConferenceService_UpdateConferenceStatus_Call struct {
 // UpdateConferenceStatus is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-//   - status enum.ConferenceStatus
-func (_e *MockIConferenceService_Expecter) UpdateConferenceStatus(ctx interface{}, id interface{}, status interface{}) *MockIConferenceService_UpdateConferenceStatus_Call {
-	return &MockIConferenceService_UpdateConferenceStatus_Call{Call: _e.mock.On("UpdateConferenceStatus", ctx, id, status)}
+//   - req dto.UpdateConferenceStatusRequest
+func (_e *MockIConferenceService_Expecter) UpdateConferenceStatus(ctx interface{}, id interface{}, req interface{}) *MockIConferenceService_UpdateConferenceStatus_Call {
+	return &MockIConferenceService_UpdateConferenceStatus_Call{Call: _e.mock.On("UpdateConferenceStatus", ctx, id, req)}
 }
 
-func (_c *MockIConferenceService_UpdateConferenceStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, status enum.ConferenceStatus)) *MockIConferenceService_UpdateConferenceStatus_Call {
+func (_c *MockIConferenceService_UpdateConferenceStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, req dto.UpdateConferenceStatusRequest)) *MockIConferenceService_UpdateConferenceStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(enum.ConferenceStatus))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.UpdateConferenceStatusRequest))
 	})
 	return _c
 }
@@ -349,7 +797,7 @@ func (_c *MockIConferenceService_UpdateConferenceStatus_Call) Return(_a0 error)
 	return _c
 }
 
-func (_c *MockIConferenceService_UpdateConferenceStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, enum.ConferenceStatus) error) *MockIConferenceService_UpdateConferenceStatus_Call {
+func (_c *MockIConferenceService_UpdateConferenceStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.UpdateConferenceStatusRequest) error) *MockIConferenceService_UpdateConferenceStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
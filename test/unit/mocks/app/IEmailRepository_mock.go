@@ -0,0 +1,469 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	entity "github.com/nathakusuma/conference-backend/domain/entity"
+
+	enum "github.com/nathakusuma/conference-backend/domain/enum"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIEmailRepository is an autogenerated mock type for the IEmailRepository type
+type MockIEmailRepository struct {
+	mock.Mock
+}
+
+type MockIEmailRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEmailRepository) EXPECT() *MockIEmailRepository_Expecter {
+	return &MockIEmailRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDueEmails provides a mock function with given fields: ctx, limit, lease
+func (_m *MockIEmailRepository) ClaimDueEmails(ctx context.Context, limit int, lease time.Duration) ([]entity.Email, error) {
+	ret := _m.Called(ctx, limit, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueEmails")
+	}
+
+	var r0 []entity.Email
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) ([]entity.Email, error)); ok {
+		return rf(ctx, limit, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) []entity.Email); ok {
+		r0 = rf(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Email)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = rf(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEmailRepository_ClaimDueEmails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueEmails'
+type MockIEmailRepository_ClaimDueEmails_Call struct {
+	*mock.Call
+}
+
+// ClaimDueEmails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - lease time.Duration
+func (_e *MockIEmailRepository_Expecter) ClaimDueEmails(ctx interface{}, limit interface{}, lease interface{}) *MockIEmailRepository_ClaimDueEmails_Call {
+	return &MockIEmailRepository_ClaimDueEmails_Call{Call: _e.mock.On("ClaimDueEmails", ctx, limit, lease)}
+}
+
+func (_c *MockIEmailRepository_ClaimDueEmails_Call) Run(run func(ctx context.Context, limit int, lease time.Duration)) *MockIEmailRepository_ClaimDueEmails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockIEmailRepository_ClaimDueEmails_Call) Return(_a0 []entity.Email, _a1 error) *MockIEmailRepository_ClaimDueEmails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEmailRepository_ClaimDueEmails_Call) RunAndReturn(run func(context.Context, int, time.Duration) ([]entity.Email, error)) *MockIEmailRepository_ClaimDueEmails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountEmailsByStatus provides a mock function with given fields: ctx
+func (_m *MockIEmailRepository) CountEmailsByStatus(ctx context.Context) (map[enum.EmailStatus]int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountEmailsByStatus")
+	}
+
+	var r0 map[enum.EmailStatus]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[enum.EmailStatus]int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[enum.EmailStatus]int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[enum.EmailStatus]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEmailRepository_CountEmailsByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountEmailsByStatus'
+type MockIEmailRepository_CountEmailsByStatus_Call struct {
+	*mock.Call
+}
+
+// CountEmailsByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIEmailRepository_Expecter) CountEmailsByStatus(ctx interface{}) *MockIEmailRepository_CountEmailsByStatus_Call {
+	return &MockIEmailRepository_CountEmailsByStatus_Call{Call: _e.mock.On("CountEmailsByStatus", ctx)}
+}
+
+func (_c *MockIEmailRepository_CountEmailsByStatus_Call) Run(run func(ctx context.Context)) *MockIEmailRepository_CountEmailsByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIEmailRepository_CountEmailsByStatus_Call) Return(_a0 map[enum.EmailStatus]int, _a1 error) *MockIEmailRepository_CountEmailsByStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEmailRepository_CountEmailsByStatus_Call) RunAndReturn(run func(context.Context) (map[enum.EmailStatus]int, error)) *MockIEmailRepository_CountEmailsByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEmail provides a mock function with given fields: ctx, email
+func (_m *MockIEmailRepository) CreateEmail(ctx context.Context, email *entity.Email) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Email) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEmailRepository_CreateEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmail'
+type MockIEmailRepository_CreateEmail_Call struct {
+	*mock.Call
+}
+
+// CreateEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email *entity.Email
+func (_e *MockIEmailRepository_Expecter) CreateEmail(ctx interface{}, email interface{}) *MockIEmailRepository_CreateEmail_Call {
+	return &MockIEmailRepository_CreateEmail_Call{Call: _e.mock.On("CreateEmail", ctx, email)}
+}
+
+func (_c *MockIEmailRepository_CreateEmail_Call) Run(run func(ctx context.Context, email *entity.Email)) *MockIEmailRepository_CreateEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Email))
+	})
+	return _c
+}
+
+func (_c *MockIEmailRepository_CreateEmail_Call) Return(_a0 error) *MockIEmailRepository_CreateEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEmailRepository_CreateEmail_Call) RunAndReturn(run func(context.Context, *entity.Email) error) *MockIEmailRepository_CreateEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEmails provides a mock function with given fields: ctx, status, lazyReq
+func (_m *MockIEmailRepository) GetEmails(ctx context.Context, status enum.EmailStatus, lazyReq dto.LazyLoadQuery) ([]entity.Email, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, status, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmails")
+	}
+
+	var r0 []entity.Email
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) ([]entity.Email, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, status, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) []entity.Email); ok {
+		r0 = rf(ctx, status, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Email)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, status, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, status, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIEmailRepository_GetEmails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmails'
+type MockIEmailRepository_GetEmails_Call struct {
+	*mock.Call
+}
+
+// GetEmails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status enum.EmailStatus
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockIEmailRepository_Expecter) GetEmails(ctx interface{}, status interface{}, lazyReq interface{}) *MockIEmailRepository_GetEmails_Call {
+	return &MockIEmailRepository_GetEmails_Call{Call: _e.mock.On("GetEmails", ctx, status, lazyReq)}
+}
+
+func (_c *MockIEmailRepository_GetEmails_Call) Run(run func(ctx context.Context, status enum.EmailStatus, lazyReq dto.LazyLoadQuery)) *MockIEmailRepository_GetEmails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enum.EmailStatus), args[2].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockIEmailRepository_GetEmails_Call) Return(_a0 []entity.Email, _a1 dto.LazyLoadResponse, _a2 error) *MockIEmailRepository_GetEmails_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIEmailRepository_GetEmails_Call) RunAndReturn(run func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) ([]entity.Email, dto.LazyLoadResponse, error)) *MockIEmailRepository_GetEmails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailFailed provides a mock function with given fields: ctx, id, lastError
+func (_m *MockIEmailRepository) MarkEmailFailed(ctx context.Context, id uuid.UUID, lastError string) error {
+	ret := _m.Called(ctx, id, lastError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, id, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEmailRepository_MarkEmailFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailFailed'
+type MockIEmailRepository_MarkEmailFailed_Call struct {
+	*mock.Call
+}
+
+// MarkEmailFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - lastError string
+func (_e *MockIEmailRepository_Expecter) MarkEmailFailed(ctx interface{}, id interface{}, lastError interface{}) *MockIEmailRepository_MarkEmailFailed_Call {
+	return &MockIEmailRepository_MarkEmailFailed_Call{Call: _e.mock.On("MarkEmailFailed", ctx, id, lastError)}
+}
+
+func (_c *MockIEmailRepository_MarkEmailFailed_Call) Run(run func(ctx context.Context, id uuid.UUID, lastError string)) *MockIEmailRepository_MarkEmailFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIEmailRepository_MarkEmailFailed_Call) Return(_a0 error) *MockIEmailRepository_MarkEmailFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEmailRepository_MarkEmailFailed_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockIEmailRepository_MarkEmailFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailRetry provides a mock function with given fields: ctx, id, retryIn, lastError
+func (_m *MockIEmailRepository) MarkEmailRetry(ctx context.Context, id uuid.UUID, retryIn time.Duration, lastError string) error {
+	ret := _m.Called(ctx, id, retryIn, lastError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailRetry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration, string) error); ok {
+		r0 = rf(ctx, id, retryIn, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEmailRepository_MarkEmailRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailRetry'
+type MockIEmailRepository_MarkEmailRetry_Call struct {
+	*mock.Call
+}
+
+// MarkEmailRetry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - retryIn time.Duration
+//   - lastError string
+func (_e *MockIEmailRepository_Expecter) MarkEmailRetry(ctx interface{}, id interface{}, retryIn interface{}, lastError interface{}) *MockIEmailRepository_MarkEmailRetry_Call {
+	return &MockIEmailRepository_MarkEmailRetry_Call{Call: _e.mock.On("MarkEmailRetry", ctx, id, retryIn, lastError)}
+}
+
+func (_c *MockIEmailRepository_MarkEmailRetry_Call) Run(run func(ctx context.Context, id uuid.UUID, retryIn time.Duration, lastError string)) *MockIEmailRepository_MarkEmailRetry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Duration), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockIEmailRepository_MarkEmailRetry_Call) Return(_a0 error) *MockIEmailRepository_MarkEmailRetry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEmailRepository_MarkEmailRetry_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Duration, string) error) *MockIEmailRepository_MarkEmailRetry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailSent provides a mock function with given fields: ctx, id
+func (_m *MockIEmailRepository) MarkEmailSent(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEmailRepository_MarkEmailSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailSent'
+type MockIEmailRepository_MarkEmailSent_Call struct {
+	*mock.Call
+}
+
+// MarkEmailSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIEmailRepository_Expecter) MarkEmailSent(ctx interface{}, id interface{}) *MockIEmailRepository_MarkEmailSent_Call {
+	return &MockIEmailRepository_MarkEmailSent_Call{Call: _e.mock.On("MarkEmailSent", ctx, id)}
+}
+
+func (_c *MockIEmailRepository_MarkEmailSent_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIEmailRepository_MarkEmailSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEmailRepository_MarkEmailSent_Call) Return(_a0 error) *MockIEmailRepository_MarkEmailSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEmailRepository_MarkEmailSent_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIEmailRepository_MarkEmailSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryFailedEmail provides a mock function with given fields: ctx, id
+func (_m *MockIEmailRepository) RetryFailedEmail(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryFailedEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEmailRepository_RetryFailedEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryFailedEmail'
+type MockIEmailRepository_RetryFailedEmail_Call struct {
+	*mock.Call
+}
+
+// RetryFailedEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIEmailRepository_Expecter) RetryFailedEmail(ctx interface{}, id interface{}) *MockIEmailRepository_RetryFailedEmail_Call {
+	return &MockIEmailRepository_RetryFailedEmail_Call{Call: _e.mock.On("RetryFailedEmail", ctx, id)}
+}
+
+func (_c *MockIEmailRepository_RetryFailedEmail_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIEmailRepository_RetryFailedEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEmailRepository_RetryFailedEmail_Call) Return(_a0 error) *MockIEmailRepository_RetryFailedEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEmailRepository_RetryFailedEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIEmailRepository_RetryFailedEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIEmailRepository creates a new instance of MockIEmailRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEmailRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEmailRepository {
+	mock := &MockIEmailRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,318 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	enum "github.com/nathakusuma/conference-backend/domain/enum"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIEmailService is an autogenerated mock type for the IEmailService type
+type MockIEmailService struct {
+	mock.Mock
+}
+
+type MockIEmailService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEmailService) EXPECT() *MockIEmailService_Expecter {
+	return &MockIEmailService_Expecter{mock: &_m.Mock}
+}
+
+// Enqueue provides a mock function with given fields: ctx, recipient, subject, templateName, data
+func (_m *MockIEmailService) Enqueue(ctx context.Context, recipient string, subject string, templateName string, data map[string]any) error {
+	ret := _m.Called(ctx, recipient, subject, templateName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]any) error); ok {
+		r0 = rf(ctx, recipient, subject, templateName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEmailService_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockIEmailService_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipient string
+//   - subject string
+//   - templateName string
+//   - data map[string]any
+func (_e *MockIEmailService_Expecter) Enqueue(ctx interface{}, recipient interface{}, subject interface{}, templateName interface{}, data interface{}) *MockIEmailService_Enqueue_Call {
+	return &MockIEmailService_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, recipient, subject, templateName, data)}
+}
+
+func (_c *MockIEmailService_Enqueue_Call) Run(run func(ctx context.Context, recipient string, subject string, templateName string, data map[string]any)) *MockIEmailService_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *MockIEmailService_Enqueue_Call) Return(_a0 error) *MockIEmailService_Enqueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEmailService_Enqueue_Call) RunAndReturn(run func(context.Context, string, string, string, map[string]any) error) *MockIEmailService_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEmails provides a mock function with given fields: ctx, status, lazyReq
+func (_m *MockIEmailService) GetEmails(ctx context.Context, status enum.EmailStatus, lazyReq dto.LazyLoadQuery) ([]dto.EmailResponse, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, status, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmails")
+	}
+
+	var r0 []dto.EmailResponse
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) ([]dto.EmailResponse, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, status, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) []dto.EmailResponse); ok {
+		r0 = rf(ctx, status, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.EmailResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, status, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, status, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIEmailService_GetEmails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmails'
+type MockIEmailService_GetEmails_Call struct {
+	*mock.Call
+}
+
+// GetEmails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status enum.EmailStatus
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockIEmailService_Expecter) GetEmails(ctx interface{}, status interface{}, lazyReq interface{}) *MockIEmailService_GetEmails_Call {
+	return &MockIEmailService_GetEmails_Call{Call: _e.mock.On("GetEmails", ctx, status, lazyReq)}
+}
+
+func (_c *MockIEmailService_GetEmails_Call) Run(run func(ctx context.Context, status enum.EmailStatus, lazyReq dto.LazyLoadQuery)) *MockIEmailService_GetEmails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enum.EmailStatus), args[2].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockIEmailService_GetEmails_Call) Return(_a0 []dto.EmailResponse, _a1 dto.LazyLoadResponse, _a2 error) *MockIEmailService_GetEmails_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIEmailService_GetEmails_Call) RunAndReturn(run func(context.Context, enum.EmailStatus, dto.LazyLoadQuery) ([]dto.EmailResponse, dto.LazyLoadResponse, error)) *MockIEmailService_GetEmails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueStats provides a mock function with given fields: ctx
+func (_m *MockIEmailService) GetQueueStats(ctx context.Context) (dto.EmailQueueStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueStats")
+	}
+
+	var r0 dto.EmailQueueStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dto.EmailQueueStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dto.EmailQueueStats); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dto.EmailQueueStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEmailService_GetQueueStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueStats'
+type MockIEmailService_GetQueueStats_Call struct {
+	*mock.Call
+}
+
+// GetQueueStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIEmailService_Expecter) GetQueueStats(ctx interface{}) *MockIEmailService_GetQueueStats_Call {
+	return &MockIEmailService_GetQueueStats_Call{Call: _e.mock.On("GetQueueStats", ctx)}
+}
+
+func (_c *MockIEmailService_GetQueueStats_Call) Run(run func(ctx context.Context)) *MockIEmailService_GetQueueStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIEmailService_GetQueueStats_Call) Return(_a0 dto.EmailQueueStats, _a1 error) *MockIEmailService_GetQueueStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEmailService_GetQueueStats_Call) RunAndReturn(run func(context.Context) (dto.EmailQueueStats, error)) *MockIEmailService_GetQueueStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessDueEmails provides a mock function with given fields: ctx
+func (_m *MockIEmailService) ProcessDueEmails(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessDueEmails")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEmailService_ProcessDueEmails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessDueEmails'
+type MockIEmailService_ProcessDueEmails_Call struct {
+	*mock.Call
+}
+
+// ProcessDueEmails is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIEmailService_Expecter) ProcessDueEmails(ctx interface{}) *MockIEmailService_ProcessDueEmails_Call {
+	return &MockIEmailService_ProcessDueEmails_Call{Call: _e.mock.On("ProcessDueEmails", ctx)}
+}
+
+func (_c *MockIEmailService_ProcessDueEmails_Call) Run(run func(ctx context.Context)) *MockIEmailService_ProcessDueEmails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIEmailService_ProcessDueEmails_Call) Return(_a0 int, _a1 error) *MockIEmailService_ProcessDueEmails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEmailService_ProcessDueEmails_Call) RunAndReturn(run func(context.Context) (int, error)) *MockIEmailService_ProcessDueEmails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryEmail provides a mock function with given fields: ctx, id
+func (_m *MockIEmailService) RetryEmail(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEmailService_RetryEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryEmail'
+type MockIEmailService_RetryEmail_Call struct {
+	*mock.Call
+}
+
+// RetryEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIEmailService_Expecter) RetryEmail(ctx interface{}, id interface{}) *MockIEmailService_RetryEmail_Call {
+	return &MockIEmailService_RetryEmail_Call{Call: _e.mock.On("RetryEmail", ctx, id)}
+}
+
+func (_c *MockIEmailService_RetryEmail_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIEmailService_RetryEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEmailService_RetryEmail_Call) Return(_a0 error) *MockIEmailService_RetryEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEmailService_RetryEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIEmailService_RetryEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIEmailService creates a new instance of MockIEmailService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEmailService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEmailService {
+	mock := &MockIEmailService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,679 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	entity "github.com/nathakusuma/conference-backend/domain/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIEventRepository is an autogenerated mock type for the IEventRepository type
+type MockIEventRepository struct {
+	mock.Mock
+}
+
+type MockIEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEventRepository) EXPECT() *MockIEventRepository_Expecter {
+	return &MockIEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddCoordinator provides a mock function with given fields: ctx, eventID, userID
+func (_m *MockIEventRepository) AddCoordinator(ctx context.Context, eventID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCoordinator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventRepository_AddCoordinator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCoordinator'
+type MockIEventRepository_AddCoordinator_Call struct {
+	*mock.Call
+}
+
+// AddCoordinator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIEventRepository_Expecter) AddCoordinator(ctx interface{}, eventID interface{}, userID interface{}) *MockIEventRepository_AddCoordinator_Call {
+	return &MockIEventRepository_AddCoordinator_Call{Call: _e.mock.On("AddCoordinator", ctx, eventID, userID)}
+}
+
+func (_c *MockIEventRepository_AddCoordinator_Call) Run(run func(ctx context.Context, eventID uuid.UUID, userID uuid.UUID)) *MockIEventRepository_AddCoordinator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_AddCoordinator_Call) Return(_a0 error) *MockIEventRepository_AddCoordinator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventRepository_AddCoordinator_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIEventRepository_AddCoordinator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEvent provides a mock function with given fields: ctx, event
+func (_m *MockIEventRepository) CreateEvent(ctx context.Context, event *entity.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventRepository_CreateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEvent'
+type MockIEventRepository_CreateEvent_Call struct {
+	*mock.Call
+}
+
+// CreateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entity.Event
+func (_e *MockIEventRepository_Expecter) CreateEvent(ctx interface{}, event interface{}) *MockIEventRepository_CreateEvent_Call {
+	return &MockIEventRepository_CreateEvent_Call{Call: _e.mock.On("CreateEvent", ctx, event)}
+}
+
+func (_c *MockIEventRepository_CreateEvent_Call) Run(run func(ctx context.Context, event *entity.Event)) *MockIEventRepository_CreateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Event))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_CreateEvent_Call) Return(_a0 error) *MockIEventRepository_CreateEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventRepository_CreateEvent_Call) RunAndReturn(run func(context.Context, *entity.Event) error) *MockIEventRepository_CreateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEvent provides a mock function with given fields: ctx, id
+func (_m *MockIEventRepository) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventRepository_DeleteEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEvent'
+type MockIEventRepository_DeleteEvent_Call struct {
+	*mock.Call
+}
+
+// DeleteEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIEventRepository_Expecter) DeleteEvent(ctx interface{}, id interface{}) *MockIEventRepository_DeleteEvent_Call {
+	return &MockIEventRepository_DeleteEvent_Call{Call: _e.mock.On("DeleteEvent", ctx, id)}
+}
+
+func (_c *MockIEventRepository_DeleteEvent_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIEventRepository_DeleteEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_DeleteEvent_Call) Return(_a0 error) *MockIEventRepository_DeleteEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventRepository_DeleteEvent_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIEventRepository_DeleteEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCoordinators provides a mock function with given fields: ctx, eventID
+func (_m *MockIEventRepository) GetCoordinators(ctx context.Context, eventID uuid.UUID) ([]entity.User, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCoordinators")
+	}
+
+	var r0 []entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.User, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.User); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventRepository_GetCoordinators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCoordinators'
+type MockIEventRepository_GetCoordinators_Call struct {
+	*mock.Call
+}
+
+// GetCoordinators is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+func (_e *MockIEventRepository_Expecter) GetCoordinators(ctx interface{}, eventID interface{}) *MockIEventRepository_GetCoordinators_Call {
+	return &MockIEventRepository_GetCoordinators_Call{Call: _e.mock.On("GetCoordinators", ctx, eventID)}
+}
+
+func (_c *MockIEventRepository_GetCoordinators_Call) Run(run func(ctx context.Context, eventID uuid.UUID)) *MockIEventRepository_GetCoordinators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_GetCoordinators_Call) Return(_a0 []entity.User, _a1 error) *MockIEventRepository_GetCoordinators_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventRepository_GetCoordinators_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.User, error)) *MockIEventRepository_GetCoordinators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEventByID provides a mock function with given fields: ctx, id
+func (_m *MockIEventRepository) GetEventByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventByID")
+	}
+
+	var r0 *entity.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Event, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Event); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventRepository_GetEventByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEventByID'
+type MockIEventRepository_GetEventByID_Call struct {
+	*mock.Call
+}
+
+// GetEventByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIEventRepository_Expecter) GetEventByID(ctx interface{}, id interface{}) *MockIEventRepository_GetEventByID_Call {
+	return &MockIEventRepository_GetEventByID_Call{Call: _e.mock.On("GetEventByID", ctx, id)}
+}
+
+func (_c *MockIEventRepository_GetEventByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIEventRepository_GetEventByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_GetEventByID_Call) Return(_a0 *entity.Event, _a1 error) *MockIEventRepository_GetEventByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventRepository_GetEventByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Event, error)) *MockIEventRepository_GetEventByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEvents provides a mock function with given fields: ctx, query
+func (_m *MockIEventRepository) GetEvents(ctx context.Context, query dto.GetEventsQuery) ([]entity.Event, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []entity.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetEventsQuery) ([]entity.Event, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetEventsQuery) []entity.Event); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GetEventsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventRepository_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type MockIEventRepository_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dto.GetEventsQuery
+func (_e *MockIEventRepository_Expecter) GetEvents(ctx interface{}, query interface{}) *MockIEventRepository_GetEvents_Call {
+	return &MockIEventRepository_GetEvents_Call{Call: _e.mock.On("GetEvents", ctx, query)}
+}
+
+func (_c *MockIEventRepository_GetEvents_Call) Run(run func(ctx context.Context, query dto.GetEventsQuery)) *MockIEventRepository_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.GetEventsQuery))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_GetEvents_Call) Return(_a0 []entity.Event, _a1 error) *MockIEventRepository_GetEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventRepository_GetEvents_Call) RunAndReturn(run func(context.Context, dto.GetEventsQuery) ([]entity.Event, error)) *MockIEventRepository_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasConferences provides a mock function with given fields: ctx, eventID
+func (_m *MockIEventRepository) HasConferences(ctx context.Context, eventID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasConferences")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventRepository_HasConferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasConferences'
+type MockIEventRepository_HasConferences_Call struct {
+	*mock.Call
+}
+
+// HasConferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+func (_e *MockIEventRepository_Expecter) HasConferences(ctx interface{}, eventID interface{}) *MockIEventRepository_HasConferences_Call {
+	return &MockIEventRepository_HasConferences_Call{Call: _e.mock.On("HasConferences", ctx, eventID)}
+}
+
+func (_c *MockIEventRepository_HasConferences_Call) Run(run func(ctx context.Context, eventID uuid.UUID)) *MockIEventRepository_HasConferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_HasConferences_Call) Return(_a0 bool, _a1 error) *MockIEventRepository_HasConferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventRepository_HasConferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockIEventRepository_HasConferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasConferencesOutside provides a mock function with given fields: ctx, eventID, startsAt, endsAt
+func (_m *MockIEventRepository) HasConferencesOutside(ctx context.Context, eventID uuid.UUID, startsAt time.Time, endsAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, eventID, startsAt, endsAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasConferencesOutside")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, eventID, startsAt, endsAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, eventID, startsAt, endsAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, eventID, startsAt, endsAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventRepository_HasConferencesOutside_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasConferencesOutside'
+type MockIEventRepository_HasConferencesOutside_Call struct {
+	*mock.Call
+}
+
+// HasConferencesOutside is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - startsAt time.Time
+//   - endsAt time.Time
+func (_e *MockIEventRepository_Expecter) HasConferencesOutside(ctx interface{}, eventID interface{}, startsAt interface{}, endsAt interface{}) *MockIEventRepository_HasConferencesOutside_Call {
+	return &MockIEventRepository_HasConferencesOutside_Call{Call: _e.mock.On("HasConferencesOutside", ctx, eventID, startsAt, endsAt)}
+}
+
+func (_c *MockIEventRepository_HasConferencesOutside_Call) Run(run func(ctx context.Context, eventID uuid.UUID, startsAt time.Time, endsAt time.Time)) *MockIEventRepository_HasConferencesOutside_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_HasConferencesOutside_Call) Return(_a0 bool, _a1 error) *MockIEventRepository_HasConferencesOutside_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventRepository_HasConferencesOutside_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) (bool, error)) *MockIEventRepository_HasConferencesOutside_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCoordinator provides a mock function with given fields: ctx, eventID, userID
+func (_m *MockIEventRepository) IsCoordinator(ctx context.Context, eventID uuid.UUID, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCoordinator")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, eventID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, eventID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventRepository_IsCoordinator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCoordinator'
+type MockIEventRepository_IsCoordinator_Call struct {
+	*mock.Call
+}
+
+// IsCoordinator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIEventRepository_Expecter) IsCoordinator(ctx interface{}, eventID interface{}, userID interface{}) *MockIEventRepository_IsCoordinator_Call {
+	return &MockIEventRepository_IsCoordinator_Call{Call: _e.mock.On("IsCoordinator", ctx, eventID, userID)}
+}
+
+func (_c *MockIEventRepository_IsCoordinator_Call) Run(run func(ctx context.Context, eventID uuid.UUID, userID uuid.UUID)) *MockIEventRepository_IsCoordinator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_IsCoordinator_Call) Return(_a0 bool, _a1 error) *MockIEventRepository_IsCoordinator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventRepository_IsCoordinator_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockIEventRepository_IsCoordinator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCoordinator provides a mock function with given fields: ctx, eventID, userID
+func (_m *MockIEventRepository) RemoveCoordinator(ctx context.Context, eventID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCoordinator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventRepository_RemoveCoordinator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCoordinator'
+type MockIEventRepository_RemoveCoordinator_Call struct {
+	*mock.Call
+}
+
+// RemoveCoordinator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIEventRepository_Expecter) RemoveCoordinator(ctx interface{}, eventID interface{}, userID interface{}) *MockIEventRepository_RemoveCoordinator_Call {
+	return &MockIEventRepository_RemoveCoordinator_Call{Call: _e.mock.On("RemoveCoordinator", ctx, eventID, userID)}
+}
+
+func (_c *MockIEventRepository_RemoveCoordinator_Call) Run(run func(ctx context.Context, eventID uuid.UUID, userID uuid.UUID)) *MockIEventRepository_RemoveCoordinator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_RemoveCoordinator_Call) Return(_a0 error) *MockIEventRepository_RemoveCoordinator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventRepository_RemoveCoordinator_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIEventRepository_RemoveCoordinator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCFP provides a mock function with given fields: ctx, event
+func (_m *MockIEventRepository) UpdateCFP(ctx context.Context, event *entity.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCFP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventRepository_UpdateCFP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCFP'
+type MockIEventRepository_UpdateCFP_Call struct {
+	*mock.Call
+}
+
+// UpdateCFP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entity.Event
+func (_e *MockIEventRepository_Expecter) UpdateCFP(ctx interface{}, event interface{}) *MockIEventRepository_UpdateCFP_Call {
+	return &MockIEventRepository_UpdateCFP_Call{Call: _e.mock.On("UpdateCFP", ctx, event)}
+}
+
+func (_c *MockIEventRepository_UpdateCFP_Call) Run(run func(ctx context.Context, event *entity.Event)) *MockIEventRepository_UpdateCFP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Event))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_UpdateCFP_Call) Return(_a0 error) *MockIEventRepository_UpdateCFP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventRepository_UpdateCFP_Call) RunAndReturn(run func(context.Context, *entity.Event) error) *MockIEventRepository_UpdateCFP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEvent provides a mock function with given fields: ctx, event
+func (_m *MockIEventRepository) UpdateEvent(ctx context.Context, event *entity.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventRepository_UpdateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEvent'
+type MockIEventRepository_UpdateEvent_Call struct {
+	*mock.Call
+}
+
+// UpdateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entity.Event
+func (_e *MockIEventRepository_Expecter) UpdateEvent(ctx interface{}, event interface{}) *MockIEventRepository_UpdateEvent_Call {
+	return &MockIEventRepository_UpdateEvent_Call{Call: _e.mock.On("UpdateEvent", ctx, event)}
+}
+
+func (_c *MockIEventRepository_UpdateEvent_Call) Run(run func(ctx context.Context, event *entity.Event)) *MockIEventRepository_UpdateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Event))
+	})
+	return _c
+}
+
+func (_c *MockIEventRepository_UpdateEvent_Call) Return(_a0 error) *MockIEventRepository_UpdateEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventRepository_UpdateEvent_Call) RunAndReturn(run func(context.Context, *entity.Event) error) *MockIEventRepository_UpdateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIEventRepository creates a new instance of MockIEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEventRepository {
+	mock := &MockIEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
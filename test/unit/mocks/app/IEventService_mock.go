@@ -0,0 +1,775 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIEventService is an autogenerated mock type for the IEventService type
+type MockIEventService struct {
+	mock.Mock
+}
+
+type MockIEventService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEventService) EXPECT() *MockIEventService_Expecter {
+	return &MockIEventService_Expecter{mock: &_m.Mock}
+}
+
+// AddCoordinator provides a mock function with given fields: ctx, eventID, userID
+func (_m *MockIEventService) AddCoordinator(ctx context.Context, eventID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCoordinator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventService_AddCoordinator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCoordinator'
+type MockIEventService_AddCoordinator_Call struct {
+	*mock.Call
+}
+
+// AddCoordinator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIEventService_Expecter) AddCoordinator(ctx interface{}, eventID interface{}, userID interface{}) *MockIEventService_AddCoordinator_Call {
+	return &MockIEventService_AddCoordinator_Call{Call: _e.mock.On("AddCoordinator", ctx, eventID, userID)}
+}
+
+func (_c *MockIEventService_AddCoordinator_Call) Run(run func(ctx context.Context, eventID uuid.UUID, userID uuid.UUID)) *MockIEventService_AddCoordinator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_AddCoordinator_Call) Return(_a0 error) *MockIEventService_AddCoordinator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventService_AddCoordinator_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIEventService_AddCoordinator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseCFP provides a mock function with given fields: ctx, eventID
+func (_m *MockIEventService) CloseCFP(ctx context.Context, eventID uuid.UUID) error {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseCFP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventService_CloseCFP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseCFP'
+type MockIEventService_CloseCFP_Call struct {
+	*mock.Call
+}
+
+// CloseCFP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+func (_e *MockIEventService_Expecter) CloseCFP(ctx interface{}, eventID interface{}) *MockIEventService_CloseCFP_Call {
+	return &MockIEventService_CloseCFP_Call{Call: _e.mock.On("CloseCFP", ctx, eventID)}
+}
+
+func (_c *MockIEventService_CloseCFP_Call) Run(run func(ctx context.Context, eventID uuid.UUID)) *MockIEventService_CloseCFP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_CloseCFP_Call) Return(_a0 error) *MockIEventService_CloseCFP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventService_CloseCFP_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIEventService_CloseCFP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEvent provides a mock function with given fields: ctx, req
+func (_m *MockIEventService) CreateEvent(ctx context.Context, req dto.CreateEventRequest) (uuid.UUID, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEvent")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateEventRequest) (uuid.UUID, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateEventRequest) uuid.UUID); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateEventRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventService_CreateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEvent'
+type MockIEventService_CreateEvent_Call struct {
+	*mock.Call
+}
+
+// CreateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.CreateEventRequest
+func (_e *MockIEventService_Expecter) CreateEvent(ctx interface{}, req interface{}) *MockIEventService_CreateEvent_Call {
+	return &MockIEventService_CreateEvent_Call{Call: _e.mock.On("CreateEvent", ctx, req)}
+}
+
+func (_c *MockIEventService_CreateEvent_Call) Run(run func(ctx context.Context, req dto.CreateEventRequest)) *MockIEventService_CreateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.CreateEventRequest))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_CreateEvent_Call) Return(_a0 uuid.UUID, _a1 error) *MockIEventService_CreateEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventService_CreateEvent_Call) RunAndReturn(run func(context.Context, dto.CreateEventRequest) (uuid.UUID, error)) *MockIEventService_CreateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEvent provides a mock function with given fields: ctx, id
+func (_m *MockIEventService) DeleteEvent(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventService_DeleteEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEvent'
+type MockIEventService_DeleteEvent_Call struct {
+	*mock.Call
+}
+
+// DeleteEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIEventService_Expecter) DeleteEvent(ctx interface{}, id interface{}) *MockIEventService_DeleteEvent_Call {
+	return &MockIEventService_DeleteEvent_Call{Call: _e.mock.On("DeleteEvent", ctx, id)}
+}
+
+func (_c *MockIEventService_DeleteEvent_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIEventService_DeleteEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_DeleteEvent_Call) Return(_a0 error) *MockIEventService_DeleteEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventService_DeleteEvent_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIEventService_DeleteEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtendCFP provides a mock function with given fields: ctx, eventID, req
+func (_m *MockIEventService) ExtendCFP(ctx context.Context, eventID uuid.UUID, req dto.ExtendCFPRequest) error {
+	ret := _m.Called(ctx, eventID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtendCFP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.ExtendCFPRequest) error); ok {
+		r0 = rf(ctx, eventID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventService_ExtendCFP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtendCFP'
+type MockIEventService_ExtendCFP_Call struct {
+	*mock.Call
+}
+
+// ExtendCFP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - req dto.ExtendCFPRequest
+func (_e *MockIEventService_Expecter) ExtendCFP(ctx interface{}, eventID interface{}, req interface{}) *MockIEventService_ExtendCFP_Call {
+	return &MockIEventService_ExtendCFP_Call{Call: _e.mock.On("ExtendCFP", ctx, eventID, req)}
+}
+
+func (_c *MockIEventService_ExtendCFP_Call) Run(run func(ctx context.Context, eventID uuid.UUID, req dto.ExtendCFPRequest)) *MockIEventService_ExtendCFP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.ExtendCFPRequest))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_ExtendCFP_Call) Return(_a0 error) *MockIEventService_ExtendCFP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventService_ExtendCFP_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.ExtendCFPRequest) error) *MockIEventService_ExtendCFP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCFP provides a mock function with given fields: ctx, eventID
+func (_m *MockIEventService) GetCFP(ctx context.Context, eventID uuid.UUID) (*dto.CFPResponse, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCFP")
+	}
+
+	var r0 *dto.CFPResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.CFPResponse, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.CFPResponse); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CFPResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventService_GetCFP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCFP'
+type MockIEventService_GetCFP_Call struct {
+	*mock.Call
+}
+
+// GetCFP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+func (_e *MockIEventService_Expecter) GetCFP(ctx interface{}, eventID interface{}) *MockIEventService_GetCFP_Call {
+	return &MockIEventService_GetCFP_Call{Call: _e.mock.On("GetCFP", ctx, eventID)}
+}
+
+func (_c *MockIEventService_GetCFP_Call) Run(run func(ctx context.Context, eventID uuid.UUID)) *MockIEventService_GetCFP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_GetCFP_Call) Return(_a0 *dto.CFPResponse, _a1 error) *MockIEventService_GetCFP_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventService_GetCFP_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.CFPResponse, error)) *MockIEventService_GetCFP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCoordinators provides a mock function with given fields: ctx, eventID
+func (_m *MockIEventService) GetCoordinators(ctx context.Context, eventID uuid.UUID) ([]dto.UserResponse, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCoordinators")
+	}
+
+	var r0 []dto.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.UserResponse, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.UserResponse); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventService_GetCoordinators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCoordinators'
+type MockIEventService_GetCoordinators_Call struct {
+	*mock.Call
+}
+
+// GetCoordinators is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+func (_e *MockIEventService_Expecter) GetCoordinators(ctx interface{}, eventID interface{}) *MockIEventService_GetCoordinators_Call {
+	return &MockIEventService_GetCoordinators_Call{Call: _e.mock.On("GetCoordinators", ctx, eventID)}
+}
+
+func (_c *MockIEventService_GetCoordinators_Call) Run(run func(ctx context.Context, eventID uuid.UUID)) *MockIEventService_GetCoordinators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_GetCoordinators_Call) Return(_a0 []dto.UserResponse, _a1 error) *MockIEventService_GetCoordinators_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventService_GetCoordinators_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.UserResponse, error)) *MockIEventService_GetCoordinators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEventByID provides a mock function with given fields: ctx, id
+func (_m *MockIEventService) GetEventByID(ctx context.Context, id uuid.UUID) (*dto.EventResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventByID")
+	}
+
+	var r0 *dto.EventResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.EventResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.EventResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.EventResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventService_GetEventByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEventByID'
+type MockIEventService_GetEventByID_Call struct {
+	*mock.Call
+}
+
+// GetEventByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIEventService_Expecter) GetEventByID(ctx interface{}, id interface{}) *MockIEventService_GetEventByID_Call {
+	return &MockIEventService_GetEventByID_Call{Call: _e.mock.On("GetEventByID", ctx, id)}
+}
+
+func (_c *MockIEventService_GetEventByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIEventService_GetEventByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_GetEventByID_Call) Return(_a0 *dto.EventResponse, _a1 error) *MockIEventService_GetEventByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventService_GetEventByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.EventResponse, error)) *MockIEventService_GetEventByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEvents provides a mock function with given fields: ctx, query
+func (_m *MockIEventService) GetEvents(ctx context.Context, query dto.GetEventsQuery) ([]dto.EventResponse, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []dto.EventResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetEventsQuery) ([]dto.EventResponse, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetEventsQuery) []dto.EventResponse); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.EventResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GetEventsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventService_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type MockIEventService_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dto.GetEventsQuery
+func (_e *MockIEventService_Expecter) GetEvents(ctx interface{}, query interface{}) *MockIEventService_GetEvents_Call {
+	return &MockIEventService_GetEvents_Call{Call: _e.mock.On("GetEvents", ctx, query)}
+}
+
+func (_c *MockIEventService_GetEvents_Call) Run(run func(ctx context.Context, query dto.GetEventsQuery)) *MockIEventService_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.GetEventsQuery))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_GetEvents_Call) Return(_a0 []dto.EventResponse, _a1 error) *MockIEventService_GetEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventService_GetEvents_Call) RunAndReturn(run func(context.Context, dto.GetEventsQuery) ([]dto.EventResponse, error)) *MockIEventService_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsEventCoordinator provides a mock function with given fields: ctx, eventID, userID
+func (_m *MockIEventService) IsEventCoordinator(ctx context.Context, eventID uuid.UUID, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsEventCoordinator")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, eventID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, eventID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIEventService_IsEventCoordinator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEventCoordinator'
+type MockIEventService_IsEventCoordinator_Call struct {
+	*mock.Call
+}
+
+// IsEventCoordinator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIEventService_Expecter) IsEventCoordinator(ctx interface{}, eventID interface{}, userID interface{}) *MockIEventService_IsEventCoordinator_Call {
+	return &MockIEventService_IsEventCoordinator_Call{Call: _e.mock.On("IsEventCoordinator", ctx, eventID, userID)}
+}
+
+func (_c *MockIEventService_IsEventCoordinator_Call) Run(run func(ctx context.Context, eventID uuid.UUID, userID uuid.UUID)) *MockIEventService_IsEventCoordinator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_IsEventCoordinator_Call) Return(_a0 bool, _a1 error) *MockIEventService_IsEventCoordinator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIEventService_IsEventCoordinator_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockIEventService_IsEventCoordinator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenCFP provides a mock function with given fields: ctx, eventID, req
+func (_m *MockIEventService) OpenCFP(ctx context.Context, eventID uuid.UUID, req dto.OpenCFPRequest) error {
+	ret := _m.Called(ctx, eventID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenCFP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.OpenCFPRequest) error); ok {
+		r0 = rf(ctx, eventID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventService_OpenCFP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenCFP'
+type MockIEventService_OpenCFP_Call struct {
+	*mock.Call
+}
+
+// OpenCFP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - req dto.OpenCFPRequest
+func (_e *MockIEventService_Expecter) OpenCFP(ctx interface{}, eventID interface{}, req interface{}) *MockIEventService_OpenCFP_Call {
+	return &MockIEventService_OpenCFP_Call{Call: _e.mock.On("OpenCFP", ctx, eventID, req)}
+}
+
+func (_c *MockIEventService_OpenCFP_Call) Run(run func(ctx context.Context, eventID uuid.UUID, req dto.OpenCFPRequest)) *MockIEventService_OpenCFP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.OpenCFPRequest))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_OpenCFP_Call) Return(_a0 error) *MockIEventService_OpenCFP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventService_OpenCFP_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.OpenCFPRequest) error) *MockIEventService_OpenCFP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCoordinator provides a mock function with given fields: ctx, eventID, userID
+func (_m *MockIEventService) RemoveCoordinator(ctx context.Context, eventID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, eventID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCoordinator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, eventID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventService_RemoveCoordinator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCoordinator'
+type MockIEventService_RemoveCoordinator_Call struct {
+	*mock.Call
+}
+
+// RemoveCoordinator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIEventService_Expecter) RemoveCoordinator(ctx interface{}, eventID interface{}, userID interface{}) *MockIEventService_RemoveCoordinator_Call {
+	return &MockIEventService_RemoveCoordinator_Call{Call: _e.mock.On("RemoveCoordinator", ctx, eventID, userID)}
+}
+
+func (_c *MockIEventService_RemoveCoordinator_Call) Run(run func(ctx context.Context, eventID uuid.UUID, userID uuid.UUID)) *MockIEventService_RemoveCoordinator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_RemoveCoordinator_Call) Return(_a0 error) *MockIEventService_RemoveCoordinator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventService_RemoveCoordinator_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIEventService_RemoveCoordinator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCFP provides a mock function with given fields: ctx, eventID, req
+func (_m *MockIEventService) UpdateCFP(ctx context.Context, eventID uuid.UUID, req dto.UpdateCFPRequest) error {
+	ret := _m.Called(ctx, eventID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCFP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.UpdateCFPRequest) error); ok {
+		r0 = rf(ctx, eventID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventService_UpdateCFP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCFP'
+type MockIEventService_UpdateCFP_Call struct {
+	*mock.Call
+}
+
+// UpdateCFP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+//   - req dto.UpdateCFPRequest
+func (_e *MockIEventService_Expecter) UpdateCFP(ctx interface{}, eventID interface{}, req interface{}) *MockIEventService_UpdateCFP_Call {
+	return &MockIEventService_UpdateCFP_Call{Call: _e.mock.On("UpdateCFP", ctx, eventID, req)}
+}
+
+func (_c *MockIEventService_UpdateCFP_Call) Run(run func(ctx context.Context, eventID uuid.UUID, req dto.UpdateCFPRequest)) *MockIEventService_UpdateCFP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.UpdateCFPRequest))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_UpdateCFP_Call) Return(_a0 error) *MockIEventService_UpdateCFP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventService_UpdateCFP_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.UpdateCFPRequest) error) *MockIEventService_UpdateCFP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEvent provides a mock function with given fields: ctx, id, req
+func (_m *MockIEventService) UpdateEvent(ctx context.Context, id uuid.UUID, req dto.UpdateEventRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.UpdateEventRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIEventService_UpdateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEvent'
+type MockIEventService_UpdateEvent_Call struct {
+	*mock.Call
+}
+
+// UpdateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - req dto.UpdateEventRequest
+func (_e *MockIEventService_Expecter) UpdateEvent(ctx interface{}, id interface{}, req interface{}) *MockIEventService_UpdateEvent_Call {
+	return &MockIEventService_UpdateEvent_Call{Call: _e.mock.On("UpdateEvent", ctx, id, req)}
+}
+
+func (_c *MockIEventService_UpdateEvent_Call) Run(run func(ctx context.Context, id uuid.UUID, req dto.UpdateEventRequest)) *MockIEventService_UpdateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.UpdateEventRequest))
+	})
+	return _c
+}
+
+func (_c *MockIEventService_UpdateEvent_Call) Return(_a0 error) *MockIEventService_UpdateEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIEventService_UpdateEvent_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.UpdateEventRequest) error) *MockIEventService_UpdateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIEventService creates a new instance of MockIEventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEventService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEventService {
+	mock := &MockIEventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
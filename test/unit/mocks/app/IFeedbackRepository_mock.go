@@ -121,6 +121,65 @@ func (_c *MockIFeedbackRepository_DeleteFeedback_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetFeedbackByID provides a mock function with given fields: ctx, id
+func (_m *MockIFeedbackRepository) GetFeedbackByID(ctx context.Context, id uuid.UUID) (*entity.Feedback, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeedbackByID")
+	}
+
+	var r0 *entity.Feedback
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Feedback, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Feedback); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Feedback)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIFeedbackRepository_GetFeedbackByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeedbackByID'
+type MockIFeedbackRepository_GetFeedbackByID_Call struct {
+	*mock.Call
+}
+
+// GetFeedbackByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIFeedbackRepository_Expecter) GetFeedbackByID(ctx interface{}, id interface{}) *MockIFeedbackRepository_GetFeedbackByID_Call {
+	return &MockIFeedbackRepository_GetFeedbackByID_Call{Call: _e.mock.On("GetFeedbackByID", ctx, id)}
+}
+
+func (_c *MockIFeedbackRepository_GetFeedbackByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIFeedbackRepository_GetFeedbackByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIFeedbackRepository_GetFeedbackByID_Call) Return(_a0 *entity.Feedback, _a1 error) *MockIFeedbackRepository_GetFeedbackByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIFeedbackRepository_GetFeedbackByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Feedback, error)) *MockIFeedbackRepository_GetFeedbackByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFeedbacksByConferenceID provides a mock function with given fields: ctx, conferenceID, lazyReq
 func (_m *MockIFeedbackRepository) GetFeedbacksByConferenceID(ctx context.Context, conferenceID uuid.UUID, lazyReq dto.LazyLoadQuery) ([]entity.Feedback, dto.LazyLoadResponse, error) {
 	ret := _m.Called(ctx, conferenceID, lazyReq)
@@ -0,0 +1,156 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	entity "github.com/nathakusuma/conference-backend/domain/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockINotificationRepository is an autogenerated mock type for the INotificationRepository type
+type MockINotificationRepository struct {
+	mock.Mock
+}
+
+type MockINotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockINotificationRepository) EXPECT() *MockINotificationRepository_Expecter {
+	return &MockINotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateNotifications provides a mock function with given fields: ctx, notifications
+func (_m *MockINotificationRepository) CreateNotifications(ctx context.Context, notifications []entity.Notification) error {
+	ret := _m.Called(ctx, notifications)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Notification) error); ok {
+		r0 = rf(ctx, notifications)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockINotificationRepository_CreateNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotifications'
+type MockINotificationRepository_CreateNotifications_Call struct {
+	*mock.Call
+}
+
+// CreateNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notifications []entity.Notification
+func (_e *MockINotificationRepository_Expecter) CreateNotifications(ctx interface{}, notifications interface{}) *MockINotificationRepository_CreateNotifications_Call {
+	return &MockINotificationRepository_CreateNotifications_Call{Call: _e.mock.On("CreateNotifications", ctx, notifications)}
+}
+
+func (_c *MockINotificationRepository_CreateNotifications_Call) Run(run func(ctx context.Context, notifications []entity.Notification)) *MockINotificationRepository_CreateNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.Notification))
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_CreateNotifications_Call) Return(_a0 error) *MockINotificationRepository_CreateNotifications_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockINotificationRepository_CreateNotifications_Call) RunAndReturn(run func(context.Context, []entity.Notification) error) *MockINotificationRepository_CreateNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationsByUser provides a mock function with given fields: ctx, userID, lazyReq
+func (_m *MockINotificationRepository) GetNotificationsByUser(ctx context.Context, userID uuid.UUID, lazyReq dto.LazyLoadQuery) ([]entity.Notification, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, userID, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationsByUser")
+	}
+
+	var r0 []entity.Notification
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) ([]entity.Notification, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, userID, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) []entity.Notification); ok {
+		r0 = rf(ctx, userID, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, userID, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, userID, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockINotificationRepository_GetNotificationsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationsByUser'
+type MockINotificationRepository_GetNotificationsByUser_Call struct {
+	*mock.Call
+}
+
+// GetNotificationsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockINotificationRepository_Expecter) GetNotificationsByUser(ctx interface{}, userID interface{}, lazyReq interface{}) *MockINotificationRepository_GetNotificationsByUser_Call {
+	return &MockINotificationRepository_GetNotificationsByUser_Call{Call: _e.mock.On("GetNotificationsByUser", ctx, userID, lazyReq)}
+}
+
+func (_c *MockINotificationRepository_GetNotificationsByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, lazyReq dto.LazyLoadQuery)) *MockINotificationRepository_GetNotificationsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_GetNotificationsByUser_Call) Return(_a0 []entity.Notification, _a1 dto.LazyLoadResponse, _a2 error) *MockINotificationRepository_GetNotificationsByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockINotificationRepository_GetNotificationsByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.LazyLoadQuery) ([]entity.Notification, dto.LazyLoadResponse, error)) *MockINotificationRepository_GetNotificationsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockINotificationRepository creates a new instance of MockINotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockINotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockINotificationRepository {
+	mock := &MockINotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
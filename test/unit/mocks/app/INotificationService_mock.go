@@ -0,0 +1,156 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	event "github.com/nathakusuma/conference-backend/domain/event"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockINotificationService is an autogenerated mock type for the INotificationService type
+type MockINotificationService struct {
+	mock.Mock
+}
+
+type MockINotificationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockINotificationService) EXPECT() *MockINotificationService_Expecter {
+	return &MockINotificationService_Expecter{mock: &_m.Mock}
+}
+
+// GetNotificationsByUser provides a mock function with given fields: ctx, userID, lazyReq
+func (_m *MockINotificationService) GetNotificationsByUser(ctx context.Context, userID uuid.UUID, lazyReq dto.LazyLoadQuery) ([]dto.NotificationResponse, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, userID, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationsByUser")
+	}
+
+	var r0 []dto.NotificationResponse
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) ([]dto.NotificationResponse, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, userID, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) []dto.NotificationResponse); ok {
+		r0 = rf(ctx, userID, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.NotificationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, userID, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, userID, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockINotificationService_GetNotificationsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationsByUser'
+type MockINotificationService_GetNotificationsByUser_Call struct {
+	*mock.Call
+}
+
+// GetNotificationsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockINotificationService_Expecter) GetNotificationsByUser(ctx interface{}, userID interface{}, lazyReq interface{}) *MockINotificationService_GetNotificationsByUser_Call {
+	return &MockINotificationService_GetNotificationsByUser_Call{Call: _e.mock.On("GetNotificationsByUser", ctx, userID, lazyReq)}
+}
+
+func (_c *MockINotificationService_GetNotificationsByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, lazyReq dto.LazyLoadQuery)) *MockINotificationService_GetNotificationsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockINotificationService_GetNotificationsByUser_Call) Return(_a0 []dto.NotificationResponse, _a1 dto.LazyLoadResponse, _a2 error) *MockINotificationService_GetNotificationsByUser_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockINotificationService_GetNotificationsByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.LazyLoadQuery) ([]dto.NotificationResponse, dto.LazyLoadResponse, error)) *MockINotificationService_GetNotificationsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HandleEvent provides a mock function with given fields: ctx, e
+func (_m *MockINotificationService) HandleEvent(ctx context.Context, e event.Event) error {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, event.Event) error); ok {
+		r0 = rf(ctx, e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockINotificationService_HandleEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleEvent'
+type MockINotificationService_HandleEvent_Call struct {
+	*mock.Call
+}
+
+// HandleEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e event.Event
+func (_e *MockINotificationService_Expecter) HandleEvent(ctx interface{}, e interface{}) *MockINotificationService_HandleEvent_Call {
+	return &MockINotificationService_HandleEvent_Call{Call: _e.mock.On("HandleEvent", ctx, e)}
+}
+
+func (_c *MockINotificationService_HandleEvent_Call) Run(run func(ctx context.Context, e event.Event)) *MockINotificationService_HandleEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(event.Event))
+	})
+	return _c
+}
+
+func (_c *MockINotificationService_HandleEvent_Call) Return(_a0 error) *MockINotificationService_HandleEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockINotificationService_HandleEvent_Call) RunAndReturn(run func(context.Context, event.Event) error) *MockINotificationService_HandleEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockINotificationService creates a new instance of MockINotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockINotificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockINotificationService {
+	mock := &MockINotificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -29,6 +29,103 @@ func (_m *MockIRegistrationRepository) EXPECT() *MockIRegistrationRepository_Exp
 	return &MockIRegistrationRepository_Expecter{mock: &_m.Mock}
 }
 
+// CancelRegistration provides a mock function with given fields: ctx, conferenceID, userID, cancelledBy
+func (_m *MockIRegistrationRepository) CancelRegistration(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID, cancelledBy uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID, userID, cancelledBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRegistration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID, userID, cancelledBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationRepository_CancelRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRegistration'
+type MockIRegistrationRepository_CancelRegistration_Call struct {
+	*mock.Call
+}
+
+// CancelRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+//   - cancelledBy uuid.UUID
+func (_e *MockIRegistrationRepository_Expecter) CancelRegistration(ctx interface{}, conferenceID interface{}, userID interface{}, cancelledBy interface{}) *MockIRegistrationRepository_CancelRegistration_Call {
+	return &MockIRegistrationRepository_CancelRegistration_Call{Call: _e.mock.On("CancelRegistration", ctx, conferenceID, userID, cancelledBy)}
+}
+
+func (_c *MockIRegistrationRepository_CancelRegistration_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID, cancelledBy uuid.UUID)) *MockIRegistrationRepository_CancelRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_CancelRegistration_Call) Return(_a0 error) *MockIRegistrationRepository_CancelRegistration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_CancelRegistration_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockIRegistrationRepository_CancelRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClearScheduleConflict provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIRegistrationRepository) ClearScheduleConflict(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearScheduleConflict")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationRepository_ClearScheduleConflict_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearScheduleConflict'
+type MockIRegistrationRepository_ClearScheduleConflict_Call struct {
+	*mock.Call
+}
+
+// ClearScheduleConflict is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIRegistrationRepository_Expecter) ClearScheduleConflict(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIRegistrationRepository_ClearScheduleConflict_Call {
+	return &MockIRegistrationRepository_ClearScheduleConflict_Call{Call: _e.mock.On("ClearScheduleConflict", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIRegistrationRepository_ClearScheduleConflict_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIRegistrationRepository_ClearScheduleConflict_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_ClearScheduleConflict_Call) Return(_a0 error) *MockIRegistrationRepository_ClearScheduleConflict_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_ClearScheduleConflict_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIRegistrationRepository_ClearScheduleConflict_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountRegistrationsByConference provides a mock function with given fields: ctx, conferenceID
 func (_m *MockIRegistrationRepository) CountRegistrationsByConference(ctx context.Context, conferenceID uuid.UUID) (int, error) {
 	ret := _m.Called(ctx, conferenceID)
@@ -133,6 +230,208 @@ func (_c *MockIRegistrationRepository_CreateRegistration_Call) RunAndReturn(run
 	return _c
 }
 
+// CreateWaitlistEntry provides a mock function with given fields: ctx, entry
+func (_m *MockIRegistrationRepository) CreateWaitlistEntry(ctx context.Context, entry *entity.WaitlistEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWaitlistEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.WaitlistEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationRepository_CreateWaitlistEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWaitlistEntry'
+type MockIRegistrationRepository_CreateWaitlistEntry_Call struct {
+	*mock.Call
+}
+
+// CreateWaitlistEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *entity.WaitlistEntry
+func (_e *MockIRegistrationRepository_Expecter) CreateWaitlistEntry(ctx interface{}, entry interface{}) *MockIRegistrationRepository_CreateWaitlistEntry_Call {
+	return &MockIRegistrationRepository_CreateWaitlistEntry_Call{Call: _e.mock.On("CreateWaitlistEntry", ctx, entry)}
+}
+
+func (_c *MockIRegistrationRepository_CreateWaitlistEntry_Call) Run(run func(ctx context.Context, entry *entity.WaitlistEntry)) *MockIRegistrationRepository_CreateWaitlistEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.WaitlistEntry))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_CreateWaitlistEntry_Call) Return(_a0 error) *MockIRegistrationRepository_CreateWaitlistEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_CreateWaitlistEntry_Call) RunAndReturn(run func(context.Context, *entity.WaitlistEntry) error) *MockIRegistrationRepository_CreateWaitlistEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWaitlistEntry provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIRegistrationRepository) DeleteWaitlistEntry(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWaitlistEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationRepository_DeleteWaitlistEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWaitlistEntry'
+type MockIRegistrationRepository_DeleteWaitlistEntry_Call struct {
+	*mock.Call
+}
+
+// DeleteWaitlistEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIRegistrationRepository_Expecter) DeleteWaitlistEntry(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIRegistrationRepository_DeleteWaitlistEntry_Call {
+	return &MockIRegistrationRepository_DeleteWaitlistEntry_Call{Call: _e.mock.On("DeleteWaitlistEntry", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIRegistrationRepository_DeleteWaitlistEntry_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIRegistrationRepository_DeleteWaitlistEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_DeleteWaitlistEntry_Call) Return(_a0 error) *MockIRegistrationRepository_DeleteWaitlistEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_DeleteWaitlistEntry_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIRegistrationRepository_DeleteWaitlistEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlagScheduleConflicts provides a mock function with given fields: ctx, conferenceID, userIDs
+func (_m *MockIRegistrationRepository) FlagScheduleConflicts(ctx context.Context, conferenceID uuid.UUID, userIDs []uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlagScheduleConflicts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID, userIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationRepository_FlagScheduleConflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlagScheduleConflicts'
+type MockIRegistrationRepository_FlagScheduleConflicts_Call struct {
+	*mock.Call
+}
+
+// FlagScheduleConflicts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userIDs []uuid.UUID
+func (_e *MockIRegistrationRepository_Expecter) FlagScheduleConflicts(ctx interface{}, conferenceID interface{}, userIDs interface{}) *MockIRegistrationRepository_FlagScheduleConflicts_Call {
+	return &MockIRegistrationRepository_FlagScheduleConflicts_Call{Call: _e.mock.On("FlagScheduleConflicts", ctx, conferenceID, userIDs)}
+}
+
+func (_c *MockIRegistrationRepository_FlagScheduleConflicts_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userIDs []uuid.UUID)) *MockIRegistrationRepository_FlagScheduleConflicts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_FlagScheduleConflicts_Call) Return(_a0 error) *MockIRegistrationRepository_FlagScheduleConflicts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_FlagScheduleConflicts_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) error) *MockIRegistrationRepository_FlagScheduleConflicts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttendeesByConference provides a mock function with given fields: ctx, conferenceID
+func (_m *MockIRegistrationRepository) GetAttendeesByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.User, error) {
+	ret := _m.Called(ctx, conferenceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttendeesByConference")
+	}
+
+	var r0 []entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.User, error)); ok {
+		return rf(ctx, conferenceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.User); ok {
+		r0 = rf(ctx, conferenceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRegistrationRepository_GetAttendeesByConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttendeesByConference'
+type MockIRegistrationRepository_GetAttendeesByConference_Call struct {
+	*mock.Call
+}
+
+// GetAttendeesByConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+func (_e *MockIRegistrationRepository_Expecter) GetAttendeesByConference(ctx interface{}, conferenceID interface{}) *MockIRegistrationRepository_GetAttendeesByConference_Call {
+	return &MockIRegistrationRepository_GetAttendeesByConference_Call{Call: _e.mock.On("GetAttendeesByConference", ctx, conferenceID)}
+}
+
+func (_c *MockIRegistrationRepository_GetAttendeesByConference_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID)) *MockIRegistrationRepository_GetAttendeesByConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_GetAttendeesByConference_Call) Return(_a0 []entity.User, _a1 error) *MockIRegistrationRepository_GetAttendeesByConference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_GetAttendeesByConference_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.User, error)) *MockIRegistrationRepository_GetAttendeesByConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConflictingRegistrations provides a mock function with given fields: ctx, userID, startsAt, endsAt
 func (_m *MockIRegistrationRepository) GetConflictingRegistrations(ctx context.Context, userID uuid.UUID, startsAt time.Time, endsAt time.Time) ([]entity.Conference, error) {
 	ret := _m.Called(ctx, userID, startsAt, endsAt)
@@ -194,9 +493,68 @@ func (_c *MockIRegistrationRepository_GetConflictingRegistrations_Call) RunAndRe
 	return _c
 }
 
-// GetRegisteredConferencesByUser provides a mock function with given fields: ctx, userID, includePast, lazyReq
-func (_m *MockIRegistrationRepository) GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID, includePast bool, lazyReq dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error) {
-	ret := _m.Called(ctx, userID, includePast, lazyReq)
+// GetFirstWaitlistEntry provides a mock function with given fields: ctx, conferenceID
+func (_m *MockIRegistrationRepository) GetFirstWaitlistEntry(ctx context.Context, conferenceID uuid.UUID) (*entity.WaitlistEntry, error) {
+	ret := _m.Called(ctx, conferenceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFirstWaitlistEntry")
+	}
+
+	var r0 *entity.WaitlistEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.WaitlistEntry, error)); ok {
+		return rf(ctx, conferenceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.WaitlistEntry); ok {
+		r0 = rf(ctx, conferenceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WaitlistEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRegistrationRepository_GetFirstWaitlistEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFirstWaitlistEntry'
+type MockIRegistrationRepository_GetFirstWaitlistEntry_Call struct {
+	*mock.Call
+}
+
+// GetFirstWaitlistEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+func (_e *MockIRegistrationRepository_Expecter) GetFirstWaitlistEntry(ctx interface{}, conferenceID interface{}) *MockIRegistrationRepository_GetFirstWaitlistEntry_Call {
+	return &MockIRegistrationRepository_GetFirstWaitlistEntry_Call{Call: _e.mock.On("GetFirstWaitlistEntry", ctx, conferenceID)}
+}
+
+func (_c *MockIRegistrationRepository_GetFirstWaitlistEntry_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID)) *MockIRegistrationRepository_GetFirstWaitlistEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_GetFirstWaitlistEntry_Call) Return(_a0 *entity.WaitlistEntry, _a1 error) *MockIRegistrationRepository_GetFirstWaitlistEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_GetFirstWaitlistEntry_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.WaitlistEntry, error)) *MockIRegistrationRepository_GetFirstWaitlistEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRegisteredConferencesByUser provides a mock function with given fields: ctx, userID, includePast, eventID, lazyReq
+func (_m *MockIRegistrationRepository) GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID, includePast bool, eventID *uuid.UUID, lazyReq dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, userID, includePast, eventID, lazyReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRegisteredConferencesByUser")
@@ -205,25 +563,25 @@ func (_m *MockIRegistrationRepository) GetRegisteredConferencesByUser(ctx contex
 	var r0 []entity.Conference
 	var r1 dto.LazyLoadResponse
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error)); ok {
-		return rf(ctx, userID, includePast, lazyReq)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, userID, includePast, eventID, lazyReq)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) []entity.Conference); ok {
-		r0 = rf(ctx, userID, includePast, lazyReq)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) []entity.Conference); ok {
+		r0 = rf(ctx, userID, includePast, eventID, lazyReq)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Conference)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
-		r1 = rf(ctx, userID, includePast, lazyReq)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, userID, includePast, eventID, lazyReq)
 	} else {
 		r1 = ret.Get(1).(dto.LazyLoadResponse)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) error); ok {
-		r2 = rf(ctx, userID, includePast, lazyReq)
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, userID, includePast, eventID, lazyReq)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -240,14 +598,15 @@ type MockIRegistrationRepository_GetRegisteredConferencesByUser_Call struct {
 //   - ctx context.Context
 //   - userID uuid.UUID
 //   - includePast bool
+//   - eventID *uuid.UUID
 //   - lazyReq dto.LazyLoadQuery
-func (_e *MockIRegistrationRepository_Expecter) GetRegisteredConferencesByUser(ctx interface{}, userID interface{}, includePast interface{}, lazyReq interface{}) *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call {
-	return &MockIRegistrationRepository_GetRegisteredConferencesByUser_Call{Call: _e.mock.On("GetRegisteredConferencesByUser", ctx, userID, includePast, lazyReq)}
+func (_e *MockIRegistrationRepository_Expecter) GetRegisteredConferencesByUser(ctx interface{}, userID interface{}, includePast interface{}, eventID interface{}, lazyReq interface{}) *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call {
+	return &MockIRegistrationRepository_GetRegisteredConferencesByUser_Call{Call: _e.mock.On("GetRegisteredConferencesByUser", ctx, userID, includePast, eventID, lazyReq)}
 }
 
-func (_c *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, includePast bool, lazyReq dto.LazyLoadQuery)) *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call {
+func (_c *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, includePast bool, eventID *uuid.UUID, lazyReq dto.LazyLoadQuery)) *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dto.LazyLoadQuery))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(*uuid.UUID), args[4].(dto.LazyLoadQuery))
 	})
 	return _c
 }
@@ -257,7 +616,7 @@ func (_c *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call) Retur
 	return _c
 }
 
-func (_c *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error)) *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call {
+func (_c *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) ([]entity.Conference, dto.LazyLoadResponse, error)) *MockIRegistrationRepository_GetRegisteredConferencesByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -329,6 +688,191 @@ func (_c *MockIRegistrationRepository_GetRegisteredUsersByConference_Call) RunAn
 	return _c
 }
 
+// GetRegistration provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIRegistrationRepository) GetRegistration(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) (*entity.Registration, error) {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegistration")
+	}
+
+	var r0 *entity.Registration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entity.Registration, error)); ok {
+		return rf(ctx, conferenceID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entity.Registration); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Registration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRegistrationRepository_GetRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRegistration'
+type MockIRegistrationRepository_GetRegistration_Call struct {
+	*mock.Call
+}
+
+// GetRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIRegistrationRepository_Expecter) GetRegistration(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIRegistrationRepository_GetRegistration_Call {
+	return &MockIRegistrationRepository_GetRegistration_Call{Call: _e.mock.On("GetRegistration", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIRegistrationRepository_GetRegistration_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIRegistrationRepository_GetRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_GetRegistration_Call) Return(_a0 *entity.Registration, _a1 error) *MockIRegistrationRepository_GetRegistration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_GetRegistration_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*entity.Registration, error)) *MockIRegistrationRepository_GetRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWaitlistByConference provides a mock function with given fields: ctx, conferenceID, lazyReq
+func (_m *MockIRegistrationRepository) GetWaitlistByConference(ctx context.Context, conferenceID uuid.UUID, lazyReq dto.LazyLoadQuery) ([]entity.WaitlistEntry, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, conferenceID, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWaitlistByConference")
+	}
+
+	var r0 []entity.WaitlistEntry
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) ([]entity.WaitlistEntry, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, conferenceID, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) []entity.WaitlistEntry); ok {
+		r0 = rf(ctx, conferenceID, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WaitlistEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, conferenceID, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, conferenceID, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIRegistrationRepository_GetWaitlistByConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWaitlistByConference'
+type MockIRegistrationRepository_GetWaitlistByConference_Call struct {
+	*mock.Call
+}
+
+// GetWaitlistByConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockIRegistrationRepository_Expecter) GetWaitlistByConference(ctx interface{}, conferenceID interface{}, lazyReq interface{}) *MockIRegistrationRepository_GetWaitlistByConference_Call {
+	return &MockIRegistrationRepository_GetWaitlistByConference_Call{Call: _e.mock.On("GetWaitlistByConference", ctx, conferenceID, lazyReq)}
+}
+
+func (_c *MockIRegistrationRepository_GetWaitlistByConference_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, lazyReq dto.LazyLoadQuery)) *MockIRegistrationRepository_GetWaitlistByConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_GetWaitlistByConference_Call) Return(_a0 []entity.WaitlistEntry, _a1 dto.LazyLoadResponse, _a2 error) *MockIRegistrationRepository_GetWaitlistByConference_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_GetWaitlistByConference_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.LazyLoadQuery) ([]entity.WaitlistEntry, dto.LazyLoadResponse, error)) *MockIRegistrationRepository_GetWaitlistByConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserInWaitlist provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIRegistrationRepository) IsUserInWaitlist(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserInWaitlist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, conferenceID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRegistrationRepository_IsUserInWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInWaitlist'
+type MockIRegistrationRepository_IsUserInWaitlist_Call struct {
+	*mock.Call
+}
+
+// IsUserInWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIRegistrationRepository_Expecter) IsUserInWaitlist(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIRegistrationRepository_IsUserInWaitlist_Call {
+	return &MockIRegistrationRepository_IsUserInWaitlist_Call{Call: _e.mock.On("IsUserInWaitlist", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIRegistrationRepository_IsUserInWaitlist_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIRegistrationRepository_IsUserInWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_IsUserInWaitlist_Call) Return(_a0 bool, _a1 error) *MockIRegistrationRepository_IsUserInWaitlist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_IsUserInWaitlist_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockIRegistrationRepository_IsUserInWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsUserRegisteredToConference provides a mock function with given fields: ctx, conferenceID, userID
 func (_m *MockIRegistrationRepository) IsUserRegisteredToConference(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) (bool, error) {
 	ret := _m.Called(ctx, conferenceID, userID)
@@ -387,6 +931,53 @@ func (_c *MockIRegistrationRepository_IsUserRegisteredToConference_Call) RunAndR
 	return _c
 }
 
+// PromoteWaitlistEntry provides a mock function with given fields: ctx, entry
+func (_m *MockIRegistrationRepository) PromoteWaitlistEntry(ctx context.Context, entry *entity.WaitlistEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteWaitlistEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.WaitlistEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationRepository_PromoteWaitlistEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteWaitlistEntry'
+type MockIRegistrationRepository_PromoteWaitlistEntry_Call struct {
+	*mock.Call
+}
+
+// PromoteWaitlistEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *entity.WaitlistEntry
+func (_e *MockIRegistrationRepository_Expecter) PromoteWaitlistEntry(ctx interface{}, entry interface{}) *MockIRegistrationRepository_PromoteWaitlistEntry_Call {
+	return &MockIRegistrationRepository_PromoteWaitlistEntry_Call{Call: _e.mock.On("PromoteWaitlistEntry", ctx, entry)}
+}
+
+func (_c *MockIRegistrationRepository_PromoteWaitlistEntry_Call) Run(run func(ctx context.Context, entry *entity.WaitlistEntry)) *MockIRegistrationRepository_PromoteWaitlistEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.WaitlistEntry))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_PromoteWaitlistEntry_Call) Return(_a0 error) *MockIRegistrationRepository_PromoteWaitlistEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationRepository_PromoteWaitlistEntry_Call) RunAndReturn(run func(context.Context, *entity.WaitlistEntry) error) *MockIRegistrationRepository_PromoteWaitlistEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIRegistrationRepository creates a new instance of MockIRegistrationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIRegistrationRepository(t interface {
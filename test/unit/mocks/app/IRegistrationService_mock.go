@@ -7,6 +7,10 @@ import (
 
 	dto "github.com/nathakusuma/conference-backend/domain/dto"
 
+	entity "github.com/nathakusuma/conference-backend/domain/entity"
+
+	event "github.com/nathakusuma/conference-backend/domain/event"
+
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -25,9 +29,116 @@ func (_m *MockIRegistrationService) EXPECT() *MockIRegistrationService_Expecter
 	return &MockIRegistrationService_Expecter{mock: &_m.Mock}
 }
 
-// GetRegisteredConferencesByUser provides a mock function with given fields: ctx, userID, includePast, lazyReq
-func (_m *MockIRegistrationService) GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID, includePast bool, lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error) {
-	ret := _m.Called(ctx, userID, includePast, lazyReq)
+// CancelRegistration provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIRegistrationService) CancelRegistration(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRegistration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationService_CancelRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRegistration'
+type MockIRegistrationService_CancelRegistration_Call struct {
+	*mock.Call
+}
+
+// CancelRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIRegistrationService_Expecter) CancelRegistration(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIRegistrationService_CancelRegistration_Call {
+	return &MockIRegistrationService_CancelRegistration_Call{Call: _e.mock.On("CancelRegistration", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIRegistrationService_CancelRegistration_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIRegistrationService_CancelRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationService_CancelRegistration_Call) Return(_a0 error) *MockIRegistrationService_CancelRegistration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationService_CancelRegistration_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIRegistrationService_CancelRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttendeesByConference provides a mock function with given fields: ctx, conferenceID
+func (_m *MockIRegistrationService) GetAttendeesByConference(ctx context.Context, conferenceID uuid.UUID) ([]entity.User, error) {
+	ret := _m.Called(ctx, conferenceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttendeesByConference")
+	}
+
+	var r0 []entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.User, error)); ok {
+		return rf(ctx, conferenceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.User); ok {
+		r0 = rf(ctx, conferenceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, conferenceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRegistrationService_GetAttendeesByConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttendeesByConference'
+type MockIRegistrationService_GetAttendeesByConference_Call struct {
+	*mock.Call
+}
+
+// GetAttendeesByConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+func (_e *MockIRegistrationService_Expecter) GetAttendeesByConference(ctx interface{}, conferenceID interface{}) *MockIRegistrationService_GetAttendeesByConference_Call {
+	return &MockIRegistrationService_GetAttendeesByConference_Call{Call: _e.mock.On("GetAttendeesByConference", ctx, conferenceID)}
+}
+
+func (_c *MockIRegistrationService_GetAttendeesByConference_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID)) *MockIRegistrationService_GetAttendeesByConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationService_GetAttendeesByConference_Call) Return(_a0 []entity.User, _a1 error) *MockIRegistrationService_GetAttendeesByConference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRegistrationService_GetAttendeesByConference_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.User, error)) *MockIRegistrationService_GetAttendeesByConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRegisteredConferencesByUser provides a mock function with given fields: ctx, userID, includePast, eventID, lazyReq
+func (_m *MockIRegistrationService) GetRegisteredConferencesByUser(ctx context.Context, userID uuid.UUID, includePast bool, eventID *uuid.UUID, lazyReq dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, userID, includePast, eventID, lazyReq)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRegisteredConferencesByUser")
@@ -36,25 +147,25 @@ func (_m *MockIRegistrationService) GetRegisteredConferencesByUser(ctx context.C
 	var r0 []dto.ConferenceResponse
 	var r1 dto.LazyLoadResponse
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)); ok {
-		return rf(ctx, userID, includePast, lazyReq)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, userID, includePast, eventID, lazyReq)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) []dto.ConferenceResponse); ok {
-		r0 = rf(ctx, userID, includePast, lazyReq)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) []dto.ConferenceResponse); ok {
+		r0 = rf(ctx, userID, includePast, eventID, lazyReq)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dto.ConferenceResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
-		r1 = rf(ctx, userID, includePast, lazyReq)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, userID, includePast, eventID, lazyReq)
 	} else {
 		r1 = ret.Get(1).(dto.LazyLoadResponse)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) error); ok {
-		r2 = rf(ctx, userID, includePast, lazyReq)
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, userID, includePast, eventID, lazyReq)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -71,14 +182,15 @@ type MockIRegistrationService_GetRegisteredConferencesByUser_Call struct {
 //   - ctx context.Context
 //   - userID uuid.UUID
 //   - includePast bool
+//   - eventID *uuid.UUID
 //   - lazyReq dto.LazyLoadQuery
-func (_e *MockIRegistrationService_Expecter) GetRegisteredConferencesByUser(ctx interface{}, userID interface{}, includePast interface{}, lazyReq interface{}) *MockIRegistrationService_GetRegisteredConferencesByUser_Call {
-	return &MockIRegistrationService_GetRegisteredConferencesByUser_Call{Call: _e.mock.On("GetRegisteredConferencesByUser", ctx, userID, includePast, lazyReq)}
+func (_e *MockIRegistrationService_Expecter) GetRegisteredConferencesByUser(ctx interface{}, userID interface{}, includePast interface{}, eventID interface{}, lazyReq interface{}) *MockIRegistrationService_GetRegisteredConferencesByUser_Call {
+	return &MockIRegistrationService_GetRegisteredConferencesByUser_Call{Call: _e.mock.On("GetRegisteredConferencesByUser", ctx, userID, includePast, eventID, lazyReq)}
 }
 
-func (_c *MockIRegistrationService_GetRegisteredConferencesByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, includePast bool, lazyReq dto.LazyLoadQuery)) *MockIRegistrationService_GetRegisteredConferencesByUser_Call {
+func (_c *MockIRegistrationService_GetRegisteredConferencesByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, includePast bool, eventID *uuid.UUID, lazyReq dto.LazyLoadQuery)) *MockIRegistrationService_GetRegisteredConferencesByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dto.LazyLoadQuery))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(*uuid.UUID), args[4].(dto.LazyLoadQuery))
 	})
 	return _c
 }
@@ -88,7 +200,7 @@ func (_c *MockIRegistrationService_GetRegisteredConferencesByUser_Call) Return(_
 	return _c
 }
 
-func (_c *MockIRegistrationService_GetRegisteredConferencesByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)) *MockIRegistrationService_GetRegisteredConferencesByUser_Call {
+func (_c *MockIRegistrationService_GetRegisteredConferencesByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, *uuid.UUID, dto.LazyLoadQuery) ([]dto.ConferenceResponse, dto.LazyLoadResponse, error)) *MockIRegistrationService_GetRegisteredConferencesByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -160,6 +272,120 @@ func (_c *MockIRegistrationService_GetRegisteredUsersByConference_Call) RunAndRe
 	return _c
 }
 
+// GetWaitlistByConference provides a mock function with given fields: ctx, conferenceID, lazyReq
+func (_m *MockIRegistrationService) GetWaitlistByConference(ctx context.Context, conferenceID uuid.UUID, lazyReq dto.LazyLoadQuery) ([]dto.WaitlistEntryResponse, dto.LazyLoadResponse, error) {
+	ret := _m.Called(ctx, conferenceID, lazyReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWaitlistByConference")
+	}
+
+	var r0 []dto.WaitlistEntryResponse
+	var r1 dto.LazyLoadResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) ([]dto.WaitlistEntryResponse, dto.LazyLoadResponse, error)); ok {
+		return rf(ctx, conferenceID, lazyReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) []dto.WaitlistEntryResponse); ok {
+		r0 = rf(ctx, conferenceID, lazyReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.WaitlistEntryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) dto.LazyLoadResponse); ok {
+		r1 = rf(ctx, conferenceID, lazyReq)
+	} else {
+		r1 = ret.Get(1).(dto.LazyLoadResponse)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, dto.LazyLoadQuery) error); ok {
+		r2 = rf(ctx, conferenceID, lazyReq)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIRegistrationService_GetWaitlistByConference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWaitlistByConference'
+type MockIRegistrationService_GetWaitlistByConference_Call struct {
+	*mock.Call
+}
+
+// GetWaitlistByConference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - lazyReq dto.LazyLoadQuery
+func (_e *MockIRegistrationService_Expecter) GetWaitlistByConference(ctx interface{}, conferenceID interface{}, lazyReq interface{}) *MockIRegistrationService_GetWaitlistByConference_Call {
+	return &MockIRegistrationService_GetWaitlistByConference_Call{Call: _e.mock.On("GetWaitlistByConference", ctx, conferenceID, lazyReq)}
+}
+
+func (_c *MockIRegistrationService_GetWaitlistByConference_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, lazyReq dto.LazyLoadQuery)) *MockIRegistrationService_GetWaitlistByConference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.LazyLoadQuery))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationService_GetWaitlistByConference_Call) Return(_a0 []dto.WaitlistEntryResponse, _a1 dto.LazyLoadResponse, _a2 error) *MockIRegistrationService_GetWaitlistByConference_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIRegistrationService_GetWaitlistByConference_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.LazyLoadQuery) ([]dto.WaitlistEntryResponse, dto.LazyLoadResponse, error)) *MockIRegistrationService_GetWaitlistByConference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HandleEvent provides a mock function with given fields: ctx, e
+func (_m *MockIRegistrationService) HandleEvent(ctx context.Context, e event.Event) error {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, event.Event) error); ok {
+		r0 = rf(ctx, e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationService_HandleEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleEvent'
+type MockIRegistrationService_HandleEvent_Call struct {
+	*mock.Call
+}
+
+// HandleEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e event.Event
+func (_e *MockIRegistrationService_Expecter) HandleEvent(ctx interface{}, e interface{}) *MockIRegistrationService_HandleEvent_Call {
+	return &MockIRegistrationService_HandleEvent_Call{Call: _e.mock.On("HandleEvent", ctx, e)}
+}
+
+func (_c *MockIRegistrationService_HandleEvent_Call) Run(run func(ctx context.Context, e event.Event)) *MockIRegistrationService_HandleEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(event.Event))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationService_HandleEvent_Call) Return(_a0 error) *MockIRegistrationService_HandleEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationService_HandleEvent_Call) RunAndReturn(run func(context.Context, event.Event) error) *MockIRegistrationService_HandleEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsUserRegisteredToConference provides a mock function with given fields: ctx, conferenceID, userID
 func (_m *MockIRegistrationService) IsUserRegisteredToConference(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) (bool, error) {
 	ret := _m.Called(ctx, conferenceID, userID)
@@ -218,6 +444,197 @@ func (_c *MockIRegistrationService_IsUserRegisteredToConference_Call) RunAndRetu
 	return _c
 }
 
+// JoinWaitlist provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIRegistrationService) JoinWaitlist(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JoinWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationService_JoinWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinWaitlist'
+type MockIRegistrationService_JoinWaitlist_Call struct {
+	*mock.Call
+}
+
+// JoinWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIRegistrationService_Expecter) JoinWaitlist(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIRegistrationService_JoinWaitlist_Call {
+	return &MockIRegistrationService_JoinWaitlist_Call{Call: _e.mock.On("JoinWaitlist", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIRegistrationService_JoinWaitlist_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIRegistrationService_JoinWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationService_JoinWaitlist_Call) Return(_a0 error) *MockIRegistrationService_JoinWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationService_JoinWaitlist_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIRegistrationService_JoinWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KeepRegistration provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIRegistrationService) KeepRegistration(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeepRegistration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationService_KeepRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeepRegistration'
+type MockIRegistrationService_KeepRegistration_Call struct {
+	*mock.Call
+}
+
+// KeepRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIRegistrationService_Expecter) KeepRegistration(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIRegistrationService_KeepRegistration_Call {
+	return &MockIRegistrationService_KeepRegistration_Call{Call: _e.mock.On("KeepRegistration", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIRegistrationService_KeepRegistration_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIRegistrationService_KeepRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationService_KeepRegistration_Call) Return(_a0 error) *MockIRegistrationService_KeepRegistration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationService_KeepRegistration_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIRegistrationService_KeepRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LeaveWaitlist provides a mock function with given fields: ctx, conferenceID, userID
+func (_m *MockIRegistrationService) LeaveWaitlist(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeaveWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationService_LeaveWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveWaitlist'
+type MockIRegistrationService_LeaveWaitlist_Call struct {
+	*mock.Call
+}
+
+// LeaveWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockIRegistrationService_Expecter) LeaveWaitlist(ctx interface{}, conferenceID interface{}, userID interface{}) *MockIRegistrationService_LeaveWaitlist_Call {
+	return &MockIRegistrationService_LeaveWaitlist_Call{Call: _e.mock.On("LeaveWaitlist", ctx, conferenceID, userID)}
+}
+
+func (_c *MockIRegistrationService_LeaveWaitlist_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID)) *MockIRegistrationService_LeaveWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationService_LeaveWaitlist_Call) Return(_a0 error) *MockIRegistrationService_LeaveWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationService_LeaveWaitlist_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockIRegistrationService_LeaveWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteFromWaitlist provides a mock function with given fields: ctx, conferenceID
+func (_m *MockIRegistrationService) PromoteFromWaitlist(ctx context.Context, conferenceID uuid.UUID) error {
+	ret := _m.Called(ctx, conferenceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteFromWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, conferenceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRegistrationService_PromoteFromWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteFromWaitlist'
+type MockIRegistrationService_PromoteFromWaitlist_Call struct {
+	*mock.Call
+}
+
+// PromoteFromWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+func (_e *MockIRegistrationService_Expecter) PromoteFromWaitlist(ctx interface{}, conferenceID interface{}) *MockIRegistrationService_PromoteFromWaitlist_Call {
+	return &MockIRegistrationService_PromoteFromWaitlist_Call{Call: _e.mock.On("PromoteFromWaitlist", ctx, conferenceID)}
+}
+
+func (_c *MockIRegistrationService_PromoteFromWaitlist_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID)) *MockIRegistrationService_PromoteFromWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRegistrationService_PromoteFromWaitlist_Call) Return(_a0 error) *MockIRegistrationService_PromoteFromWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRegistrationService_PromoteFromWaitlist_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIRegistrationService_PromoteFromWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: ctx, conferenceID, userID
 func (_m *MockIRegistrationService) Register(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID) error {
 	ret := _m.Called(ctx, conferenceID, userID)
@@ -0,0 +1,301 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIReminderRepository is an autogenerated mock type for the IReminderRepository type
+type MockIReminderRepository struct {
+	mock.Mock
+}
+
+type MockIReminderRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIReminderRepository) EXPECT() *MockIReminderRepository_Expecter {
+	return &MockIReminderRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimReminder provides a mock function with given fields: ctx, conferenceID, userID, reminderType
+func (_m *MockIReminderRepository) ClaimReminder(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID, reminderType string) (bool, error) {
+	ret := _m.Called(ctx, conferenceID, userID, reminderType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimReminder")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (bool, error)); ok {
+		return rf(ctx, conferenceID, userID, reminderType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) bool); ok {
+		r0 = rf(ctx, conferenceID, userID, reminderType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, conferenceID, userID, reminderType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReminderRepository_ClaimReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimReminder'
+type MockIReminderRepository_ClaimReminder_Call struct {
+	*mock.Call
+}
+
+// ClaimReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+//   - reminderType string
+func (_e *MockIReminderRepository_Expecter) ClaimReminder(ctx interface{}, conferenceID interface{}, userID interface{}, reminderType interface{}) *MockIReminderRepository_ClaimReminder_Call {
+	return &MockIReminderRepository_ClaimReminder_Call{Call: _e.mock.On("ClaimReminder", ctx, conferenceID, userID, reminderType)}
+}
+
+func (_c *MockIReminderRepository_ClaimReminder_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID, reminderType string)) *MockIReminderRepository_ClaimReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockIReminderRepository_ClaimReminder_Call) Return(_a0 bool, _a1 error) *MockIReminderRepository_ClaimReminder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReminderRepository_ClaimReminder_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) (bool, error)) *MockIReminderRepository_ClaimReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOptOut provides a mock function with given fields: ctx, userID, reminderType
+func (_m *MockIReminderRepository) CreateOptOut(ctx context.Context, userID uuid.UUID, reminderType string) error {
+	ret := _m.Called(ctx, userID, reminderType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOptOut")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, userID, reminderType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReminderRepository_CreateOptOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOptOut'
+type MockIReminderRepository_CreateOptOut_Call struct {
+	*mock.Call
+}
+
+// CreateOptOut is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - reminderType string
+func (_e *MockIReminderRepository_Expecter) CreateOptOut(ctx interface{}, userID interface{}, reminderType interface{}) *MockIReminderRepository_CreateOptOut_Call {
+	return &MockIReminderRepository_CreateOptOut_Call{Call: _e.mock.On("CreateOptOut", ctx, userID, reminderType)}
+}
+
+func (_c *MockIReminderRepository_CreateOptOut_Call) Run(run func(ctx context.Context, userID uuid.UUID, reminderType string)) *MockIReminderRepository_CreateOptOut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIReminderRepository_CreateOptOut_Call) Return(_a0 error) *MockIReminderRepository_CreateOptOut_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReminderRepository_CreateOptOut_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockIReminderRepository_CreateOptOut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOptOut provides a mock function with given fields: ctx, userID, reminderType
+func (_m *MockIReminderRepository) DeleteOptOut(ctx context.Context, userID uuid.UUID, reminderType string) error {
+	ret := _m.Called(ctx, userID, reminderType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOptOut")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, userID, reminderType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReminderRepository_DeleteOptOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOptOut'
+type MockIReminderRepository_DeleteOptOut_Call struct {
+	*mock.Call
+}
+
+// DeleteOptOut is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - reminderType string
+func (_e *MockIReminderRepository_Expecter) DeleteOptOut(ctx interface{}, userID interface{}, reminderType interface{}) *MockIReminderRepository_DeleteOptOut_Call {
+	return &MockIReminderRepository_DeleteOptOut_Call{Call: _e.mock.On("DeleteOptOut", ctx, userID, reminderType)}
+}
+
+func (_c *MockIReminderRepository_DeleteOptOut_Call) Run(run func(ctx context.Context, userID uuid.UUID, reminderType string)) *MockIReminderRepository_DeleteOptOut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIReminderRepository_DeleteOptOut_Call) Return(_a0 error) *MockIReminderRepository_DeleteOptOut_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReminderRepository_DeleteOptOut_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockIReminderRepository_DeleteOptOut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOptOutsByUser provides a mock function with given fields: ctx, userID
+func (_m *MockIReminderRepository) GetOptOutsByUser(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOptOutsByUser")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReminderRepository_GetOptOutsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOptOutsByUser'
+type MockIReminderRepository_GetOptOutsByUser_Call struct {
+	*mock.Call
+}
+
+// GetOptOutsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIReminderRepository_Expecter) GetOptOutsByUser(ctx interface{}, userID interface{}) *MockIReminderRepository_GetOptOutsByUser_Call {
+	return &MockIReminderRepository_GetOptOutsByUser_Call{Call: _e.mock.On("GetOptOutsByUser", ctx, userID)}
+}
+
+func (_c *MockIReminderRepository_GetOptOutsByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIReminderRepository_GetOptOutsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIReminderRepository_GetOptOutsByUser_Call) Return(_a0 []string, _a1 error) *MockIReminderRepository_GetOptOutsByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReminderRepository_GetOptOutsByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]string, error)) *MockIReminderRepository_GetOptOutsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReminder provides a mock function with given fields: ctx, conferenceID, userID, reminderType
+func (_m *MockIReminderRepository) ReleaseReminder(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID, reminderType string) error {
+	ret := _m.Called(ctx, conferenceID, userID, reminderType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, conferenceID, userID, reminderType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReminderRepository_ReleaseReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReminder'
+type MockIReminderRepository_ReleaseReminder_Call struct {
+	*mock.Call
+}
+
+// ReleaseReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conferenceID uuid.UUID
+//   - userID uuid.UUID
+//   - reminderType string
+func (_e *MockIReminderRepository_Expecter) ReleaseReminder(ctx interface{}, conferenceID interface{}, userID interface{}, reminderType interface{}) *MockIReminderRepository_ReleaseReminder_Call {
+	return &MockIReminderRepository_ReleaseReminder_Call{Call: _e.mock.On("ReleaseReminder", ctx, conferenceID, userID, reminderType)}
+}
+
+func (_c *MockIReminderRepository_ReleaseReminder_Call) Run(run func(ctx context.Context, conferenceID uuid.UUID, userID uuid.UUID, reminderType string)) *MockIReminderRepository_ReleaseReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockIReminderRepository_ReleaseReminder_Call) Return(_a0 error) *MockIReminderRepository_ReleaseReminder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReminderRepository_ReleaseReminder_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *MockIReminderRepository_ReleaseReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIReminderRepository creates a new instance of MockIReminderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIReminderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIReminderRepository {
+	mock := &MockIReminderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
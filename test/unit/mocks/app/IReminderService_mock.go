@@ -0,0 +1,204 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIReminderService is an autogenerated mock type for the IReminderService type
+type MockIReminderService struct {
+	mock.Mock
+}
+
+type MockIReminderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIReminderService) EXPECT() *MockIReminderService_Expecter {
+	return &MockIReminderService_Expecter{mock: &_m.Mock}
+}
+
+// GetPreferences provides a mock function with given fields: ctx, userID
+func (_m *MockIReminderService) GetPreferences(ctx context.Context, userID uuid.UUID) ([]dto.ReminderPreference, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferences")
+	}
+
+	var r0 []dto.ReminderPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dto.ReminderPreference, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dto.ReminderPreference); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ReminderPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReminderService_GetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreferences'
+type MockIReminderService_GetPreferences_Call struct {
+	*mock.Call
+}
+
+// GetPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIReminderService_Expecter) GetPreferences(ctx interface{}, userID interface{}) *MockIReminderService_GetPreferences_Call {
+	return &MockIReminderService_GetPreferences_Call{Call: _e.mock.On("GetPreferences", ctx, userID)}
+}
+
+func (_c *MockIReminderService_GetPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIReminderService_GetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIReminderService_GetPreferences_Call) Return(_a0 []dto.ReminderPreference, _a1 error) *MockIReminderService_GetPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReminderService_GetPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dto.ReminderPreference, error)) *MockIReminderService_GetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendDueReminders provides a mock function with given fields: ctx
+func (_m *MockIReminderService) SendDueReminders(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDueReminders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReminderService_SendDueReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDueReminders'
+type MockIReminderService_SendDueReminders_Call struct {
+	*mock.Call
+}
+
+// SendDueReminders is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIReminderService_Expecter) SendDueReminders(ctx interface{}) *MockIReminderService_SendDueReminders_Call {
+	return &MockIReminderService_SendDueReminders_Call{Call: _e.mock.On("SendDueReminders", ctx)}
+}
+
+func (_c *MockIReminderService_SendDueReminders_Call) Run(run func(ctx context.Context)) *MockIReminderService_SendDueReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIReminderService_SendDueReminders_Call) Return(_a0 int, _a1 error) *MockIReminderService_SendDueReminders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReminderService_SendDueReminders_Call) RunAndReturn(run func(context.Context) (int, error)) *MockIReminderService_SendDueReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreference provides a mock function with given fields: ctx, userID, reminderType, enabled
+func (_m *MockIReminderService) UpdatePreference(ctx context.Context, userID uuid.UUID, reminderType string, enabled bool) error {
+	ret := _m.Called(ctx, userID, reminderType, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreference")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, bool) error); ok {
+		r0 = rf(ctx, userID, reminderType, enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReminderService_UpdatePreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreference'
+type MockIReminderService_UpdatePreference_Call struct {
+	*mock.Call
+}
+
+// UpdatePreference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - reminderType string
+//   - enabled bool
+func (_e *MockIReminderService_Expecter) UpdatePreference(ctx interface{}, userID interface{}, reminderType interface{}, enabled interface{}) *MockIReminderService_UpdatePreference_Call {
+	return &MockIReminderService_UpdatePreference_Call{Call: _e.mock.On("UpdatePreference", ctx, userID, reminderType, enabled)}
+}
+
+func (_c *MockIReminderService_UpdatePreference_Call) Run(run func(ctx context.Context, userID uuid.UUID, reminderType string, enabled bool)) *MockIReminderService_UpdatePreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockIReminderService_UpdatePreference_Call) Return(_a0 error) *MockIReminderService_UpdatePreference_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReminderService_UpdatePreference_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, bool) error) *MockIReminderService_UpdatePreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIReminderService creates a new instance of MockIReminderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIReminderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIReminderService {
+	mock := &MockIReminderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
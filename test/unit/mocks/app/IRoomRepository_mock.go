@@ -0,0 +1,415 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	entity "github.com/nathakusuma/conference-backend/domain/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIRoomRepository is an autogenerated mock type for the IRoomRepository type
+type MockIRoomRepository struct {
+	mock.Mock
+}
+
+type MockIRoomRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRoomRepository) EXPECT() *MockIRoomRepository_Expecter {
+	return &MockIRoomRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateRoom provides a mock function with given fields: ctx, room
+func (_m *MockIRoomRepository) CreateRoom(ctx context.Context, room *entity.Room) error {
+	ret := _m.Called(ctx, room)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRoom")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Room) error); ok {
+		r0 = rf(ctx, room)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRoomRepository_CreateRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoom'
+type MockIRoomRepository_CreateRoom_Call struct {
+	*mock.Call
+}
+
+// CreateRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - room *entity.Room
+func (_e *MockIRoomRepository_Expecter) CreateRoom(ctx interface{}, room interface{}) *MockIRoomRepository_CreateRoom_Call {
+	return &MockIRoomRepository_CreateRoom_Call{Call: _e.mock.On("CreateRoom", ctx, room)}
+}
+
+func (_c *MockIRoomRepository_CreateRoom_Call) Run(run func(ctx context.Context, room *entity.Room)) *MockIRoomRepository_CreateRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Room))
+	})
+	return _c
+}
+
+func (_c *MockIRoomRepository_CreateRoom_Call) Return(_a0 error) *MockIRoomRepository_CreateRoom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRoomRepository_CreateRoom_Call) RunAndReturn(run func(context.Context, *entity.Room) error) *MockIRoomRepository_CreateRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoom provides a mock function with given fields: ctx, id
+func (_m *MockIRoomRepository) DeleteRoom(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoom")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRoomRepository_DeleteRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoom'
+type MockIRoomRepository_DeleteRoom_Call struct {
+	*mock.Call
+}
+
+// DeleteRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIRoomRepository_Expecter) DeleteRoom(ctx interface{}, id interface{}) *MockIRoomRepository_DeleteRoom_Call {
+	return &MockIRoomRepository_DeleteRoom_Call{Call: _e.mock.On("DeleteRoom", ctx, id)}
+}
+
+func (_c *MockIRoomRepository_DeleteRoom_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIRoomRepository_DeleteRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRoomRepository_DeleteRoom_Call) Return(_a0 error) *MockIRoomRepository_DeleteRoom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRoomRepository_DeleteRoom_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIRoomRepository_DeleteRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLargestUpcomingSeats provides a mock function with given fields: ctx, roomID
+func (_m *MockIRoomRepository) GetLargestUpcomingSeats(ctx context.Context, roomID uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, roomID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLargestUpcomingSeats")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return rf(ctx, roomID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, roomID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roomID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRoomRepository_GetLargestUpcomingSeats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLargestUpcomingSeats'
+type MockIRoomRepository_GetLargestUpcomingSeats_Call struct {
+	*mock.Call
+}
+
+// GetLargestUpcomingSeats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomID uuid.UUID
+func (_e *MockIRoomRepository_Expecter) GetLargestUpcomingSeats(ctx interface{}, roomID interface{}) *MockIRoomRepository_GetLargestUpcomingSeats_Call {
+	return &MockIRoomRepository_GetLargestUpcomingSeats_Call{Call: _e.mock.On("GetLargestUpcomingSeats", ctx, roomID)}
+}
+
+func (_c *MockIRoomRepository_GetLargestUpcomingSeats_Call) Run(run func(ctx context.Context, roomID uuid.UUID)) *MockIRoomRepository_GetLargestUpcomingSeats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRoomRepository_GetLargestUpcomingSeats_Call) Return(_a0 int, _a1 error) *MockIRoomRepository_GetLargestUpcomingSeats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRoomRepository_GetLargestUpcomingSeats_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, error)) *MockIRoomRepository_GetLargestUpcomingSeats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoomByID provides a mock function with given fields: ctx, id
+func (_m *MockIRoomRepository) GetRoomByID(ctx context.Context, id uuid.UUID) (*entity.Room, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoomByID")
+	}
+
+	var r0 *entity.Room
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Room, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Room); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Room)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRoomRepository_GetRoomByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoomByID'
+type MockIRoomRepository_GetRoomByID_Call struct {
+	*mock.Call
+}
+
+// GetRoomByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIRoomRepository_Expecter) GetRoomByID(ctx interface{}, id interface{}) *MockIRoomRepository_GetRoomByID_Call {
+	return &MockIRoomRepository_GetRoomByID_Call{Call: _e.mock.On("GetRoomByID", ctx, id)}
+}
+
+func (_c *MockIRoomRepository_GetRoomByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIRoomRepository_GetRoomByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRoomRepository_GetRoomByID_Call) Return(_a0 *entity.Room, _a1 error) *MockIRoomRepository_GetRoomByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRoomRepository_GetRoomByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Room, error)) *MockIRoomRepository_GetRoomByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRooms provides a mock function with given fields: ctx, query
+func (_m *MockIRoomRepository) GetRooms(ctx context.Context, query dto.GetRoomsQuery) ([]entity.Room, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRooms")
+	}
+
+	var r0 []entity.Room
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetRoomsQuery) ([]entity.Room, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetRoomsQuery) []entity.Room); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Room)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GetRoomsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRoomRepository_GetRooms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRooms'
+type MockIRoomRepository_GetRooms_Call struct {
+	*mock.Call
+}
+
+// GetRooms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dto.GetRoomsQuery
+func (_e *MockIRoomRepository_Expecter) GetRooms(ctx interface{}, query interface{}) *MockIRoomRepository_GetRooms_Call {
+	return &MockIRoomRepository_GetRooms_Call{Call: _e.mock.On("GetRooms", ctx, query)}
+}
+
+func (_c *MockIRoomRepository_GetRooms_Call) Run(run func(ctx context.Context, query dto.GetRoomsQuery)) *MockIRoomRepository_GetRooms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.GetRoomsQuery))
+	})
+	return _c
+}
+
+func (_c *MockIRoomRepository_GetRooms_Call) Return(_a0 []entity.Room, _a1 error) *MockIRoomRepository_GetRooms_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRoomRepository_GetRooms_Call) RunAndReturn(run func(context.Context, dto.GetRoomsQuery) ([]entity.Room, error)) *MockIRoomRepository_GetRooms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasUpcomingConferences provides a mock function with given fields: ctx, roomID
+func (_m *MockIRoomRepository) HasUpcomingConferences(ctx context.Context, roomID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, roomID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasUpcomingConferences")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, roomID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, roomID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roomID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRoomRepository_HasUpcomingConferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasUpcomingConferences'
+type MockIRoomRepository_HasUpcomingConferences_Call struct {
+	*mock.Call
+}
+
+// HasUpcomingConferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomID uuid.UUID
+func (_e *MockIRoomRepository_Expecter) HasUpcomingConferences(ctx interface{}, roomID interface{}) *MockIRoomRepository_HasUpcomingConferences_Call {
+	return &MockIRoomRepository_HasUpcomingConferences_Call{Call: _e.mock.On("HasUpcomingConferences", ctx, roomID)}
+}
+
+func (_c *MockIRoomRepository_HasUpcomingConferences_Call) Run(run func(ctx context.Context, roomID uuid.UUID)) *MockIRoomRepository_HasUpcomingConferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRoomRepository_HasUpcomingConferences_Call) Return(_a0 bool, _a1 error) *MockIRoomRepository_HasUpcomingConferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRoomRepository_HasUpcomingConferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockIRoomRepository_HasUpcomingConferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRoom provides a mock function with given fields: ctx, room
+func (_m *MockIRoomRepository) UpdateRoom(ctx context.Context, room *entity.Room) error {
+	ret := _m.Called(ctx, room)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRoom")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Room) error); ok {
+		r0 = rf(ctx, room)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRoomRepository_UpdateRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoom'
+type MockIRoomRepository_UpdateRoom_Call struct {
+	*mock.Call
+}
+
+// UpdateRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - room *entity.Room
+func (_e *MockIRoomRepository_Expecter) UpdateRoom(ctx interface{}, room interface{}) *MockIRoomRepository_UpdateRoom_Call {
+	return &MockIRoomRepository_UpdateRoom_Call{Call: _e.mock.On("UpdateRoom", ctx, room)}
+}
+
+func (_c *MockIRoomRepository_UpdateRoom_Call) Run(run func(ctx context.Context, room *entity.Room)) *MockIRoomRepository_UpdateRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Room))
+	})
+	return _c
+}
+
+func (_c *MockIRoomRepository_UpdateRoom_Call) Return(_a0 error) *MockIRoomRepository_UpdateRoom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRoomRepository_UpdateRoom_Call) RunAndReturn(run func(context.Context, *entity.Room) error) *MockIRoomRepository_UpdateRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIRoomRepository creates a new instance of MockIRoomRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRoomRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRoomRepository {
+	mock := &MockIRoomRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
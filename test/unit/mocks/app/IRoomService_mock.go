@@ -0,0 +1,312 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/nathakusuma/conference-backend/domain/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIRoomService is an autogenerated mock type for the IRoomService type
+type MockIRoomService struct {
+	mock.Mock
+}
+
+type MockIRoomService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRoomService) EXPECT() *MockIRoomService_Expecter {
+	return &MockIRoomService_Expecter{mock: &_m.Mock}
+}
+
+// CreateRoom provides a mock function with given fields: ctx, req
+func (_m *MockIRoomService) CreateRoom(ctx context.Context, req dto.CreateRoomRequest) (uuid.UUID, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRoom")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateRoomRequest) (uuid.UUID, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateRoomRequest) uuid.UUID); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateRoomRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRoomService_CreateRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoom'
+type MockIRoomService_CreateRoom_Call struct {
+	*mock.Call
+}
+
+// CreateRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req dto.CreateRoomRequest
+func (_e *MockIRoomService_Expecter) CreateRoom(ctx interface{}, req interface{}) *MockIRoomService_CreateRoom_Call {
+	return &MockIRoomService_CreateRoom_Call{Call: _e.mock.On("CreateRoom", ctx, req)}
+}
+
+func (_c *MockIRoomService_CreateRoom_Call) Run(run func(ctx context.Context, req dto.CreateRoomRequest)) *MockIRoomService_CreateRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.CreateRoomRequest))
+	})
+	return _c
+}
+
+func (_c *MockIRoomService_CreateRoom_Call) Return(_a0 uuid.UUID, _a1 error) *MockIRoomService_CreateRoom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRoomService_CreateRoom_Call) RunAndReturn(run func(context.Context, dto.CreateRoomRequest) (uuid.UUID, error)) *MockIRoomService_CreateRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoom provides a mock function with given fields: ctx, id
+func (_m *MockIRoomService) DeleteRoom(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoom")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRoomService_DeleteRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoom'
+type MockIRoomService_DeleteRoom_Call struct {
+	*mock.Call
+}
+
+// DeleteRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIRoomService_Expecter) DeleteRoom(ctx interface{}, id interface{}) *MockIRoomService_DeleteRoom_Call {
+	return &MockIRoomService_DeleteRoom_Call{Call: _e.mock.On("DeleteRoom", ctx, id)}
+}
+
+func (_c *MockIRoomService_DeleteRoom_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIRoomService_DeleteRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRoomService_DeleteRoom_Call) Return(_a0 error) *MockIRoomService_DeleteRoom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRoomService_DeleteRoom_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIRoomService_DeleteRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoomByID provides a mock function with given fields: ctx, id
+func (_m *MockIRoomService) GetRoomByID(ctx context.Context, id uuid.UUID) (*dto.RoomResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoomByID")
+	}
+
+	var r0 *dto.RoomResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.RoomResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.RoomResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RoomResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRoomService_GetRoomByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoomByID'
+type MockIRoomService_GetRoomByID_Call struct {
+	*mock.Call
+}
+
+// GetRoomByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIRoomService_Expecter) GetRoomByID(ctx interface{}, id interface{}) *MockIRoomService_GetRoomByID_Call {
+	return &MockIRoomService_GetRoomByID_Call{Call: _e.mock.On("GetRoomByID", ctx, id)}
+}
+
+func (_c *MockIRoomService_GetRoomByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIRoomService_GetRoomByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIRoomService_GetRoomByID_Call) Return(_a0 *dto.RoomResponse, _a1 error) *MockIRoomService_GetRoomByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRoomService_GetRoomByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.RoomResponse, error)) *MockIRoomService_GetRoomByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRooms provides a mock function with given fields: ctx, query
+func (_m *MockIRoomService) GetRooms(ctx context.Context, query dto.GetRoomsQuery) ([]dto.RoomResponse, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRooms")
+	}
+
+	var r0 []dto.RoomResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetRoomsQuery) ([]dto.RoomResponse, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetRoomsQuery) []dto.RoomResponse); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.RoomResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GetRoomsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIRoomService_GetRooms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRooms'
+type MockIRoomService_GetRooms_Call struct {
+	*mock.Call
+}
+
+// GetRooms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query dto.GetRoomsQuery
+func (_e *MockIRoomService_Expecter) GetRooms(ctx interface{}, query interface{}) *MockIRoomService_GetRooms_Call {
+	return &MockIRoomService_GetRooms_Call{Call: _e.mock.On("GetRooms", ctx, query)}
+}
+
+func (_c *MockIRoomService_GetRooms_Call) Run(run func(ctx context.Context, query dto.GetRoomsQuery)) *MockIRoomService_GetRooms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.GetRoomsQuery))
+	})
+	return _c
+}
+
+func (_c *MockIRoomService_GetRooms_Call) Return(_a0 []dto.RoomResponse, _a1 error) *MockIRoomService_GetRooms_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIRoomService_GetRooms_Call) RunAndReturn(run func(context.Context, dto.GetRoomsQuery) ([]dto.RoomResponse, error)) *MockIRoomService_GetRooms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRoom provides a mock function with given fields: ctx, id, req
+func (_m *MockIRoomService) UpdateRoom(ctx context.Context, id uuid.UUID, req dto.UpdateRoomRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRoom")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.UpdateRoomRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIRoomService_UpdateRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoom'
+type MockIRoomService_UpdateRoom_Call struct {
+	*mock.Call
+}
+
+// UpdateRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - req dto.UpdateRoomRequest
+func (_e *MockIRoomService_Expecter) UpdateRoom(ctx interface{}, id interface{}, req interface{}) *MockIRoomService_UpdateRoom_Call {
+	return &MockIRoomService_UpdateRoom_Call{Call: _e.mock.On("UpdateRoom", ctx, id, req)}
+}
+
+func (_c *MockIRoomService_UpdateRoom_Call) Run(run func(ctx context.Context, id uuid.UUID, req dto.UpdateRoomRequest)) *MockIRoomService_UpdateRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.UpdateRoomRequest))
+	})
+	return _c
+}
+
+func (_c *MockIRoomService_UpdateRoom_Call) Return(_a0 error) *MockIRoomService_UpdateRoom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIRoomService_UpdateRoom_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.UpdateRoomRequest) error) *MockIRoomService_UpdateRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIRoomService creates a new instance of MockIRoomService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRoomService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRoomService {
+	mock := &MockIRoomService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
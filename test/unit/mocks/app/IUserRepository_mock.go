@@ -72,6 +72,53 @@ func (_c *MockIUserRepository_CreateUser_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// DeleteProposalQuota provides a mock function with given fields: ctx, userID
+func (_m *MockIUserRepository) DeleteProposalQuota(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProposalQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_DeleteProposalQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProposalQuota'
+type MockIUserRepository_DeleteProposalQuota_Call struct {
+	*mock.Call
+}
+
+// DeleteProposalQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIUserRepository_Expecter) DeleteProposalQuota(ctx interface{}, userID interface{}) *MockIUserRepository_DeleteProposalQuota_Call {
+	return &MockIUserRepository_DeleteProposalQuota_Call{Call: _e.mock.On("DeleteProposalQuota", ctx, userID)}
+}
+
+func (_c *MockIUserRepository_DeleteProposalQuota_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIUserRepository_DeleteProposalQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_DeleteProposalQuota_Call) Return(_a0 error) *MockIUserRepository_DeleteProposalQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_DeleteProposalQuota_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIUserRepository_DeleteProposalQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUser provides a mock function with given fields: ctx, id
 func (_m *MockIUserRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	ret := _m.Called(ctx, id)
@@ -119,6 +166,63 @@ func (_c *MockIUserRepository_DeleteUser_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetProposalQuota provides a mock function with given fields: ctx, userID
+func (_m *MockIUserRepository) GetProposalQuota(ctx context.Context, userID uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProposalQuota")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetProposalQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProposalQuota'
+type MockIUserRepository_GetProposalQuota_Call struct {
+	*mock.Call
+}
+
+// GetProposalQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIUserRepository_Expecter) GetProposalQuota(ctx interface{}, userID interface{}) *MockIUserRepository_GetProposalQuota_Call {
+	return &MockIUserRepository_GetProposalQuota_Call{Call: _e.mock.On("GetProposalQuota", ctx, userID)}
+}
+
+func (_c *MockIUserRepository_GetProposalQuota_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIUserRepository_GetProposalQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetProposalQuota_Call) Return(_a0 int, _a1 error) *MockIUserRepository_GetProposalQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetProposalQuota_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, error)) *MockIUserRepository_GetProposalQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByField provides a mock function with given fields: ctx, field, value
 func (_m *MockIUserRepository) GetUserByField(ctx context.Context, field string, value string) (*entity.User, error) {
 	ret := _m.Called(ctx, field, value)
@@ -179,6 +283,54 @@ func (_c *MockIUserRepository_GetUserByField_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// SetProposalQuota provides a mock function with given fields: ctx, userID, quota
+func (_m *MockIUserRepository) SetProposalQuota(ctx context.Context, userID uuid.UUID, quota int) error {
+	ret := _m.Called(ctx, userID, quota)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProposalQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, userID, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_SetProposalQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProposalQuota'
+type MockIUserRepository_SetProposalQuota_Call struct {
+	*mock.Call
+}
+
+// SetProposalQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - quota int
+func (_e *MockIUserRepository_Expecter) SetProposalQuota(ctx interface{}, userID interface{}, quota interface{}) *MockIUserRepository_SetProposalQuota_Call {
+	return &MockIUserRepository_SetProposalQuota_Call{Call: _e.mock.On("SetProposalQuota", ctx, userID, quota)}
+}
+
+func (_c *MockIUserRepository_SetProposalQuota_Call) Run(run func(ctx context.Context, userID uuid.UUID, quota int)) *MockIUserRepository_SetProposalQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_SetProposalQuota_Call) Return(_a0 error) *MockIUserRepository_SetProposalQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_SetProposalQuota_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) error) *MockIUserRepository_SetProposalQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function with given fields: ctx, user
 func (_m *MockIUserRepository) UpdateUser(ctx context.Context, user *entity.User) error {
 	ret := _m.Called(ctx, user)
@@ -133,6 +133,65 @@ func (_c *MockIUserService_DeleteUser_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetProposalQuota provides a mock function with given fields: ctx, userID
+func (_m *MockIUserService) GetProposalQuota(ctx context.Context, userID uuid.UUID) (*dto.ProposalQuotaResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProposalQuota")
+	}
+
+	var r0 *dto.ProposalQuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*dto.ProposalQuotaResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *dto.ProposalQuotaResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ProposalQuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserService_GetProposalQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProposalQuota'
+type MockIUserService_GetProposalQuota_Call struct {
+	*mock.Call
+}
+
+// GetProposalQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIUserService_Expecter) GetProposalQuota(ctx interface{}, userID interface{}) *MockIUserService_GetProposalQuota_Call {
+	return &MockIUserService_GetProposalQuota_Call{Call: _e.mock.On("GetProposalQuota", ctx, userID)}
+}
+
+func (_c *MockIUserService_GetProposalQuota_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIUserService_GetProposalQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetProposalQuota_Call) Return(_a0 *dto.ProposalQuotaResponse, _a1 error) *MockIUserService_GetProposalQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserService_GetProposalQuota_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*dto.ProposalQuotaResponse, error)) *MockIUserService_GetProposalQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByEmail provides a mock function with given fields: ctx, email
 func (_m *MockIUserService) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	ret := _m.Called(ctx, email)
@@ -251,6 +310,101 @@ func (_c *MockIUserService_GetUserByID_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ResetProposalQuota provides a mock function with given fields: ctx, userID
+func (_m *MockIUserService) ResetProposalQuota(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetProposalQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserService_ResetProposalQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetProposalQuota'
+type MockIUserService_ResetProposalQuota_Call struct {
+	*mock.Call
+}
+
+// ResetProposalQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIUserService_Expecter) ResetProposalQuota(ctx interface{}, userID interface{}) *MockIUserService_ResetProposalQuota_Call {
+	return &MockIUserService_ResetProposalQuota_Call{Call: _e.mock.On("ResetProposalQuota", ctx, userID)}
+}
+
+func (_c *MockIUserService_ResetProposalQuota_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIUserService_ResetProposalQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIUserService_ResetProposalQuota_Call) Return(_a0 error) *MockIUserService_ResetProposalQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserService_ResetProposalQuota_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockIUserService_ResetProposalQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProposalQuota provides a mock function with given fields: ctx, userID, req
+func (_m *MockIUserService) SetProposalQuota(ctx context.Context, userID uuid.UUID, req dto.SetProposalQuotaRequest) error {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProposalQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.SetProposalQuotaRequest) error); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserService_SetProposalQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProposalQuota'
+type MockIUserService_SetProposalQuota_Call struct {
+	*mock.Call
+}
+
+// SetProposalQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - req dto.SetProposalQuotaRequest
+func (_e *MockIUserService_Expecter) SetProposalQuota(ctx interface{}, userID interface{}, req interface{}) *MockIUserService_SetProposalQuota_Call {
+	return &MockIUserService_SetProposalQuota_Call{Call: _e.mock.On("SetProposalQuota", ctx, userID, req)}
+}
+
+func (_c *MockIUserService_SetProposalQuota_Call) Run(run func(ctx context.Context, userID uuid.UUID, req dto.SetProposalQuotaRequest)) *MockIUserService_SetProposalQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dto.SetProposalQuotaRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUserService_SetProposalQuota_Call) Return(_a0 error) *MockIUserService_SetProposalQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserService_SetProposalQuota_Call) RunAndReturn(run func(context.Context, uuid.UUID, dto.SetProposalQuotaRequest) error) *MockIUserService_SetProposalQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePassword provides a mock function with given fields: ctx, email, newPassword
 func (_m *MockIUserService) UpdatePassword(ctx context.Context, email string, newPassword string) error {
 	ret := _m.Called(ctx, email, newPassword)
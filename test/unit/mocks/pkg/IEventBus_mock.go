@@ -0,0 +1,107 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	event "github.com/nathakusuma/conference-backend/domain/event"
+	eventbus "github.com/nathakusuma/conference-backend/pkg/eventbus"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIEventBus is an autogenerated mock type for the IEventBus type
+type MockIEventBus struct {
+	mock.Mock
+}
+
+type MockIEventBus_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEventBus) EXPECT() *MockIEventBus_Expecter {
+	return &MockIEventBus_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, e
+func (_m *MockIEventBus) Publish(ctx context.Context, e event.Event) {
+	_m.Called(ctx, e)
+}
+
+// MockIEventBus_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockIEventBus_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e event.Event
+func (_e *MockIEventBus_Expecter) Publish(ctx interface{}, e interface{}) *MockIEventBus_Publish_Call {
+	return &MockIEventBus_Publish_Call{Call: _e.mock.On("Publish", ctx, e)}
+}
+
+func (_c *MockIEventBus_Publish_Call) Run(run func(ctx context.Context, e event.Event)) *MockIEventBus_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(event.Event))
+	})
+	return _c
+}
+
+func (_c *MockIEventBus_Publish_Call) Return() *MockIEventBus_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIEventBus_Publish_Call) RunAndReturn(run func(context.Context, event.Event)) *MockIEventBus_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: eventName, handler
+func (_m *MockIEventBus) Subscribe(eventName string, handler eventbus.Handler) {
+	_m.Called(eventName, handler)
+}
+
+// MockIEventBus_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockIEventBus_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - eventName string
+//   - handler eventbus.Handler
+func (_e *MockIEventBus_Expecter) Subscribe(eventName interface{}, handler interface{}) *MockIEventBus_Subscribe_Call {
+	return &MockIEventBus_Subscribe_Call{Call: _e.mock.On("Subscribe", eventName, handler)}
+}
+
+func (_c *MockIEventBus_Subscribe_Call) Run(run func(eventName string, handler eventbus.Handler)) *MockIEventBus_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(eventbus.Handler))
+	})
+	return _c
+}
+
+func (_c *MockIEventBus_Subscribe_Call) Return() *MockIEventBus_Subscribe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIEventBus_Subscribe_Call) RunAndReturn(run func(string, eventbus.Handler)) *MockIEventBus_Subscribe_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIEventBus creates a new instance of MockIEventBus. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEventBus(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEventBus {
+	mock := &MockIEventBus{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
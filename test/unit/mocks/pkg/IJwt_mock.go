@@ -24,9 +24,9 @@ func (_m *MockIJwt) EXPECT() *MockIJwt_Expecter {
 	return &MockIJwt_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: userID, role
-func (_m *MockIJwt) Create(userID uuid.UUID, role enum.UserRole) (string, error) {
-	ret := _m.Called(userID, role)
+// Create provides a mock function with given fields: userID, role, sessionID
+func (_m *MockIJwt) Create(userID uuid.UUID, role enum.UserRole, sessionID uuid.UUID) (string, error) {
+	ret := _m.Called(userID, role, sessionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -34,17 +34,17 @@ func (_m *MockIJwt) Create(userID uuid.UUID, role enum.UserRole) (string, error)
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, enum.UserRole) (string, error)); ok {
-		return rf(userID, role)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, enum.UserRole, uuid.UUID) (string, error)); ok {
+		return rf(userID, role, sessionID)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID, enum.UserRole) string); ok {
-		r0 = rf(userID, role)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, enum.UserRole, uuid.UUID) string); ok {
+		r0 = rf(userID, role, sessionID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(uuid.UUID, enum.UserRole) error); ok {
-		r1 = rf(userID, role)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, enum.UserRole, uuid.UUID) error); ok {
+		r1 = rf(userID, role, sessionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,13 +60,14 @@ type MockIJwt_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - userID uuid.UUID
 //   - role enum.UserRole
-func (_e *MockIJwt_Expecter) Create(userID interface{}, role interface{}) *MockIJwt_Create_Call {
-	return &MockIJwt_Create_Call{Call: _e.mock.On("Create", userID, role)}
+//   - sessionID uuid.UUID
+func (_e *MockIJwt_Expecter) Create(userID interface{}, role interface{}, sessionID interface{}) *MockIJwt_Create_Call {
+	return &MockIJwt_Create_Call{Call: _e.mock.On("Create", userID, role, sessionID)}
 }
 
-func (_c *MockIJwt_Create_Call) Run(run func(userID uuid.UUID, role enum.UserRole)) *MockIJwt_Create_Call {
+func (_c *MockIJwt_Create_Call) Run(run func(userID uuid.UUID, role enum.UserRole, sessionID uuid.UUID)) *MockIJwt_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uuid.UUID), args[1].(enum.UserRole))
+		run(args[0].(uuid.UUID), args[1].(enum.UserRole), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -76,7 +77,7 @@ func (_c *MockIJwt_Create_Call) Return(_a0 string, _a1 error) *MockIJwt_Create_C
 	return _c
 }
 
-func (_c *MockIJwt_Create_Call) RunAndReturn(run func(uuid.UUID, enum.UserRole) (string, error)) *MockIJwt_Create_Call {
+func (_c *MockIJwt_Create_Call) RunAndReturn(run func(uuid.UUID, enum.UserRole, uuid.UUID) (string, error)) *MockIJwt_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
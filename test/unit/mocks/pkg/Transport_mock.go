@@ -0,0 +1,81 @@
+// Code generated by mockery v2.51.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	mail "github.com/nathakusuma/conference-backend/pkg/mail"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTransport is an autogenerated mock type for the Transport type
+type MockTransport struct {
+	mock.Mock
+}
+
+type MockTransport_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransport) EXPECT() *MockTransport_Expecter {
+	return &MockTransport_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: msg
+func (_m *MockTransport) Send(msg mail.Message) error {
+	ret := _m.Called(msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(mail.Message) error); ok {
+		r0 = rf(msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransport_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockTransport_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - msg mail.Message
+func (_e *MockTransport_Expecter) Send(msg interface{}) *MockTransport_Send_Call {
+	return &MockTransport_Send_Call{Call: _e.mock.On("Send", msg)}
+}
+
+func (_c *MockTransport_Send_Call) Run(run func(msg mail.Message)) *MockTransport_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(mail.Message))
+	})
+	return _c
+}
+
+func (_c *MockTransport_Send_Call) Return(_a0 error) *MockTransport_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransport_Send_Call) RunAndReturn(run func(mail.Message) error) *MockTransport_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTransport creates a new instance of MockTransport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransport(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransport {
+	mock := &MockTransport{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,51 @@
+package pkg
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nathakusuma/conference-backend/pkg/background"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Background_Wait(t *testing.T) {
+	t.Run("waits for running jobs", func(t *testing.T) {
+		var done atomic.Int32
+		for i := 0; i < 5; i++ {
+			background.Go("test", func() {
+				time.Sleep(20 * time.Millisecond)
+				done.Add(1)
+			})
+		}
+
+		err := background.Wait(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, int32(5), done.Load())
+	})
+
+	t.Run("gives up when the context is done", func(t *testing.T) {
+		release := make(chan struct{})
+		background.Go("test", func() {
+			<-release
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := background.Wait(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		close(release)
+		assert.NoError(t, background.Wait(context.Background()))
+	})
+
+	t.Run("recovers a panicking job", func(t *testing.T) {
+		background.Go("test", func() {
+			panic("boom")
+		})
+
+		assert.NoError(t, background.Wait(context.Background()))
+	})
+}
@@ -0,0 +1,75 @@
+package pkg
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nathakusuma/conference-backend/domain/event"
+	"github.com/nathakusuma/conference-backend/pkg/background"
+	"github.com/nathakusuma/conference-backend/pkg/eventbus"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EventBus_Publish(t *testing.T) {
+	t.Run("delivers to every subscriber of the event", func(t *testing.T) {
+		bus := eventbus.NewEventBus()
+
+		var approved, rejected atomic.Int32
+		for i := 0; i < 2; i++ {
+			bus.Subscribe(event.NameConferenceApproved, func(ctx context.Context, e event.Event) error {
+				approved.Add(1)
+				return nil
+			})
+		}
+		bus.Subscribe(event.NameConferenceRejected, func(ctx context.Context, e event.Event) error {
+			rejected.Add(1)
+			return nil
+		})
+
+		bus.Publish(context.Background(), event.ConferenceApproved{})
+
+		require.NoError(t, background.Wait(context.Background()))
+		assert.Equal(t, int32(2), approved.Load())
+		assert.Equal(t, int32(0), rejected.Load())
+	})
+
+	t.Run("handler gets a live context after the publisher's is done", func(t *testing.T) {
+		bus := eventbus.NewEventBus()
+
+		var ctxDone atomic.Bool
+		bus.Subscribe(event.NameConferenceCancelled, func(ctx context.Context, e event.Event) error {
+			ctxDone.Store(ctx.Err() != nil)
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		bus.Publish(ctx, event.ConferenceCancelled{})
+
+		require.NoError(t, background.Wait(context.Background()))
+		assert.False(t, ctxDone.Load())
+	})
+
+	t.Run("failing handler does not stop the others", func(t *testing.T) {
+		bus := eventbus.NewEventBus()
+
+		var called atomic.Int32
+		bus.Subscribe(event.NameRegistrationConfirmed, func(ctx context.Context, e event.Event) error {
+			called.Add(1)
+			return errors.New("handler error")
+		})
+		bus.Subscribe(event.NameRegistrationConfirmed, func(ctx context.Context, e event.Event) error {
+			called.Add(1)
+			return nil
+		})
+
+		bus.Publish(context.Background(), event.RegistrationConfirmed{})
+
+		require.NoError(t, background.Wait(context.Background()))
+		assert.Equal(t, int32(2), called.Load())
+	})
+}
@@ -0,0 +1,97 @@
+package pkg
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nathakusuma/conference-backend/internal/mailtmpl"
+	"github.com/nathakusuma/conference-backend/pkg/mail"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Mail_Templates(t *testing.T) {
+	htmlTemplates, err := fs.Glob(mailtmpl.Templates, "*.html")
+	require.NoError(t, err)
+	require.NotEmpty(t, htmlTemplates)
+
+	for _, name := range htmlTemplates {
+		t.Run(name+" has a plain-text part", func(t *testing.T) {
+			_, err := fs.Stat(mailtmpl.Templates, mail.TextTemplateName(name))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_Mail_Send(t *testing.T) {
+	t.Run("renders html and plain-text parts", func(t *testing.T) {
+		transport := mail.NewMemoryTransport()
+		mailer := mail.NewMailer(transport)
+
+		err := mailer.Send("user@example.com", "[Conference App] Verify Your Account", "otp_register_user.html",
+			map[string]any{"otp": "123456"})
+		require.NoError(t, err)
+
+		messages := transport.Messages()
+		require.Len(t, messages, 1)
+		assert.Equal(t, "user@example.com", messages[0].To)
+		assert.Equal(t, "[Conference App] Verify Your Account", messages[0].Subject)
+		assert.Contains(t, messages[0].HTMLBody, "123456")
+		assert.Contains(t, messages[0].TextBody, "123456")
+		assert.NotContains(t, messages[0].TextBody, "<")
+	})
+
+	t.Run("unknown template is a permanent failure", func(t *testing.T) {
+		transport := mail.NewMemoryTransport()
+		mailer := mail.NewMailer(transport)
+
+		err := mailer.Send("user@example.com", "subject", "missing.html", nil)
+		assert.ErrorIs(t, err, mail.ErrPermanent)
+		assert.Empty(t, transport.Messages())
+	})
+
+	t.Run("file transport writes an eml file", func(t *testing.T) {
+		dir := t.TempDir()
+		mailer := mail.NewMailer(mail.NewFileTransport(dir))
+
+		err := mailer.Send("user@example.com", "[Conference App] Reset Password", "otp_reset_password.html",
+			map[string]any{"otp": "654321"})
+		require.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Contains(t, filepath.Base(files[0]), "user_at_example.com")
+
+		content, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "Content-Type: multipart/alternative")
+		assert.Contains(t, string(content), "Content-Type: text/plain")
+		assert.Contains(t, string(content), "Content-Type: text/html")
+		assert.Contains(t, string(content), "654321")
+	})
+}
+
+func Test_Mail_ValidateTransport(t *testing.T) {
+	t.Run("log and memory transports are rejected in production", func(t *testing.T) {
+		assert.ErrorIs(t, mail.ValidateTransport("production", mail.TransportLog), mail.ErrTransportNotForProduction)
+		assert.ErrorIs(t, mail.ValidateTransport("production", mail.TransportMemory), mail.ErrTransportNotForProduction)
+	})
+
+	t.Run("delivering transports are allowed in production", func(t *testing.T) {
+		assert.NoError(t, mail.ValidateTransport("production", ""))
+		assert.NoError(t, mail.ValidateTransport("production", mail.TransportSMTP))
+		assert.NoError(t, mail.ValidateTransport("production", mail.TransportFile))
+	})
+
+	t.Run("every transport is allowed outside production", func(t *testing.T) {
+		for _, transport := range []string{mail.TransportSMTP, mail.TransportFile, mail.TransportLog,
+			mail.TransportMemory} {
+			assert.NoError(t, mail.ValidateTransport("development", transport))
+			assert.NoError(t, mail.ValidateTransport("staging", transport))
+		}
+	})
+}
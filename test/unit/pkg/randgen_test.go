@@ -0,0 +1,127 @@
+package pkg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nathakusuma/conference-backend/pkg/randgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const alphaNum = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890"
+
+// chiSquare measures how far the observed counts are from a uniform distribution.
+func chiSquare(counts map[rune]int, categories, total int) float64 {
+	expected := float64(total) / float64(categories)
+
+	var sum float64
+	for _, count := range counts {
+		diff := float64(count) - expected
+		sum += diff * diff / expected
+	}
+
+	// categories that never showed up still contribute to the statistic
+	sum += float64(categories-len(counts)) * expected
+
+	return sum
+}
+
+func Test_RandGen_RandomString(t *testing.T) {
+	randGen := randgen.GetRandGen()
+
+	t.Run("returns requested length", func(t *testing.T) {
+		for _, length := range []int{0, 1, 32, 255} {
+			s, err := randGen.RandomString(length)
+			require.NoError(t, err)
+			assert.Len(t, s, length)
+		}
+	})
+
+	t.Run("uniform over the whole alphabet", func(t *testing.T) {
+		const length = 32
+		const iterations = 4000
+
+		counts := make(map[rune]int)
+		for i := 0; i < iterations; i++ {
+			s, err := randGen.RandomString(length)
+			require.NoError(t, err)
+
+			for _, r := range s {
+				counts[r]++
+			}
+		}
+
+		for r := range counts {
+			assert.True(t, strings.ContainsRune(alphaNum, r), "unexpected character %q", r)
+		}
+
+		// every character must be reachable, including the last one of the alphabet
+		for _, r := range alphaNum {
+			assert.Positive(t, counts[r], "character %q never generated", r)
+		}
+
+		// 61 degrees of freedom, critical value for p = 0.00001 is about 117
+		assert.Less(t, chiSquare(counts, len(alphaNum), length*iterations), 117.0)
+	})
+
+	t.Run("no collisions", func(t *testing.T) {
+		seen := make(map[string]struct{})
+		for i := 0; i < 10000; i++ {
+			s, err := randGen.RandomString(32)
+			require.NoError(t, err)
+
+			_, exists := seen[s]
+			require.False(t, exists, "duplicate string generated")
+			seen[s] = struct{}{}
+		}
+	})
+}
+
+func Test_RandGen_RandomNumber(t *testing.T) {
+	randGen := randgen.GetRandGen()
+
+	t.Run("stays within digit range", func(t *testing.T) {
+		for i := 0; i < 10000; i++ {
+			n, err := randGen.RandomNumber(6)
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, n, 100000)
+			assert.LessOrEqual(t, n, 999999)
+		}
+	})
+
+	t.Run("uniform over single digits", func(t *testing.T) {
+		const iterations = 90000
+
+		counts := make(map[rune]int)
+		for i := 0; i < iterations; i++ {
+			n, err := randGen.RandomNumber(1)
+			require.NoError(t, err)
+			require.True(t, n >= 1 && n <= 9, "unexpected number %d", n)
+
+			counts[rune('0'+n)]++
+		}
+
+		// 8 degrees of freedom, critical value for p = 0.00001 is about 37.3
+		assert.Less(t, chiSquare(counts, 9, iterations), 37.3)
+	})
+
+	t.Run("uniform over leading and trailing digits", func(t *testing.T) {
+		const iterations = 90000
+
+		leading := make(map[rune]int)
+		trailing := make(map[rune]int)
+		for i := 0; i < iterations; i++ {
+			n, err := randGen.RandomNumber(6)
+			require.NoError(t, err)
+
+			leading[rune('0'+n/100000)]++
+			trailing[rune('0'+n%10)]++
+		}
+
+		// 8 degrees of freedom, critical value for p = 0.00001 is about 37.3
+		assert.Less(t, chiSquare(leading, 9, iterations), 37.3)
+		// 9 degrees of freedom, critical value for p = 0.00001 is about 39.3
+		assert.Less(t, chiSquare(trailing, 10, iterations), 39.3)
+	})
+}
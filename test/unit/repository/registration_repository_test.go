@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/internal/app/registration/repository"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRegistrationRepositoryTest(t *testing.T) (contract.IRegistrationRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+		_ = db.Close()
+	})
+
+	return repository.NewRegistrationRepository(sqlx.NewDb(db, "pgx")), mock
+}
+
+func Test_RegistrationRepository_GetRegisteredUsersByConference(t *testing.T) {
+	ctx := context.Background()
+	conferenceID := uuid.New()
+	firstID, secondID := uuid.New(), uuid.New()
+
+	t.Run("scans id, name and email of every registrant", func(t *testing.T) {
+		repo, mock := setupRegistrationRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT id, name, email FROM users`).
+			WithArgs(conferenceID, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
+				AddRow(firstID, "First User", "first@example.com").
+				AddRow(secondID, "Second User", "second@example.com"))
+
+		users, lazy, err := repo.GetRegisteredUsersByConference(ctx, conferenceID, dto.LazyLoadQuery{Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []entity.User{
+			{ID: firstID, Name: "First User", Email: "first@example.com"},
+		}, users)
+		assert.True(t, lazy.HasMore)
+		assert.Equal(t, firstID, lazy.FirstID)
+		assert.Equal(t, firstID, lazy.LastID)
+	})
+
+	t.Run("no registrants", func(t *testing.T) {
+		repo, mock := setupRegistrationRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT id, name, email FROM users`).
+			WithArgs(conferenceID, secondID, 11).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}))
+
+		users, lazy, err := repo.GetRegisteredUsersByConference(ctx, conferenceID, dto.LazyLoadQuery{
+			AfterID: secondID,
+			Limit:   10,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, users)
+		assert.False(t, lazy.HasMore)
+	})
+}
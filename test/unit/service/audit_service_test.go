@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/app/audit/service"
+	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type auditServiceMocks struct {
+	auditRepo *appmocks.MockIAuditRepository
+	uuid      *pkgmocks.MockIUUID
+}
+
+func setupAuditServiceTest(t *testing.T) (contract.IAuditService, *auditServiceMocks) {
+	mocks := &auditServiceMocks{
+		auditRepo: appmocks.NewMockIAuditRepository(t),
+		uuid:      pkgmocks.NewMockIUUID(t),
+	}
+
+	svc := service.NewAuditService(mocks.auditRepo, mocks.uuid)
+
+	return svc, mocks
+}
+
+func Test_AuditService_Record(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	actorID := uuid.New()
+	role := enum.RoleAdmin
+	targetID := uuid.NewString()
+
+	req := dto.RecordAuditEventRequest{
+		ActorID:    &actorID,
+		ActorRole:  &role,
+		IPAddress:  "10.0.0.1",
+		Action:     enum.AuditActionUserDeleted,
+		TargetType: "user",
+		TargetID:   &targetID,
+		Outcome:    enum.AuditOutcomeSuccess,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupAuditServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(eventID, nil)
+
+		mocks.auditRepo.EXPECT().
+			CreateEvent(ctx, mock.MatchedBy(func(event *entity.AuditEvent) bool {
+				return event.ID == eventID &&
+					*event.ActorID == actorID &&
+					*event.ActorRole == enum.RoleAdmin &&
+					event.IPAddress == "10.0.0.1" &&
+					event.Action == enum.AuditActionUserDeleted &&
+					event.TargetType == "user" &&
+					*event.TargetID == targetID &&
+					event.Outcome == enum.AuditOutcomeSuccess
+			})).
+			Return(nil)
+
+		err := svc.Record(ctx, req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - UUID generation fails", func(t *testing.T) {
+		svc, mocks := setupAuditServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.Nil, errors.New("uuid error"))
+
+		err := svc.Record(ctx, req)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - repository fails", func(t *testing.T) {
+		svc, mocks := setupAuditServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(eventID, nil)
+
+		mocks.auditRepo.EXPECT().
+			CreateEvent(ctx, mock.AnythingOfType("*entity.AuditEvent")).
+			Return(errors.New("db error"))
+
+		err := svc.Record(ctx, req)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_AuditService_GetEvents(t *testing.T) {
+	ctx := context.Background()
+	lazyReq := dto.LazyLoadQuery{Limit: 10}
+	query := dto.GetAuditEventsQuery{Action: enum.AuditActionLogin, Outcome: enum.AuditOutcomeFailure}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupAuditServiceTest(t)
+
+		targetID := "user@example.com"
+		event := entity.AuditEvent{
+			ID:         uuid.New(),
+			IPAddress:  "10.0.0.1",
+			Action:     enum.AuditActionLogin,
+			TargetType: "user",
+			TargetID:   &targetID,
+			Outcome:    enum.AuditOutcomeFailure,
+		}
+		lazyResp := dto.LazyLoadResponse{FirstID: event.ID, LastID: event.ID}
+
+		mocks.auditRepo.EXPECT().
+			GetEvents(ctx, query, lazyReq).
+			Return([]entity.AuditEvent{event}, lazyResp, nil)
+
+		events, gotLazyResp, err := svc.GetEvents(ctx, query, lazyReq)
+		assert.NoError(t, err)
+		assert.Equal(t, lazyResp, gotLazyResp)
+		assert.Equal(t, []dto.AuditEventResponse{{
+			ID:         event.ID,
+			IPAddress:  "10.0.0.1",
+			Action:     enum.AuditActionLogin,
+			TargetType: "user",
+			TargetID:   &targetID,
+			Outcome:    enum.AuditOutcomeFailure,
+		}}, events)
+	})
+
+	t.Run("error - pagination includes beforeID and afterID", func(t *testing.T) {
+		svc, _ := setupAuditServiceTest(t)
+
+		_, _, err := svc.GetEvents(ctx, query, dto.LazyLoadQuery{
+			AfterID:  uuid.New(),
+			BeforeID: uuid.New(),
+			Limit:    10,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidPagination)
+	})
+
+	t.Run("error - repository fails", func(t *testing.T) {
+		svc, mocks := setupAuditServiceTest(t)
+
+		mocks.auditRepo.EXPECT().
+			GetEvents(ctx, query, lazyReq).
+			Return(nil, dto.LazyLoadResponse{}, errors.New("db error"))
+
+		_, _, err := svc.GetEvents(ctx, query, lazyReq)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
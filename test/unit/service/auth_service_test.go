@@ -11,6 +11,7 @@ import (
 	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
 	"github.com/nathakusuma/conference-backend/internal/app/auth/service"
+	"github.com/nathakusuma/conference-backend/internal/infra/env"
 	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
 	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
 	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
@@ -26,8 +27,9 @@ type authServiceMocks struct {
 	userSvc  *appmocks.MockIUserService
 	bcrypt   *pkgmocks.MockIBcrypt
 	jwt      *pkgmocks.MockIJwt
-	mailer   *pkgmocks.MockIMailer
+	emailSvc *appmocks.MockIEmailService
 	uuid     *pkgmocks.MockIUUID
+	randGen  *pkgmocks.MockIRandGen
 }
 
 func setupAuthServiceMocks(t *testing.T) (contract.IAuthService, *authServiceMocks) {
@@ -36,11 +38,13 @@ func setupAuthServiceMocks(t *testing.T) (contract.IAuthService, *authServiceMoc
 		userSvc:  appmocks.NewMockIUserService(t),
 		bcrypt:   pkgmocks.NewMockIBcrypt(t),
 		jwt:      pkgmocks.NewMockIJwt(t),
-		mailer:   pkgmocks.NewMockIMailer(t),
+		emailSvc: appmocks.NewMockIEmailService(t),
 		uuid:     pkgmocks.NewMockIUUID(t),
+		randGen:  pkgmocks.NewMockIRandGen(t),
 	}
 
-	svc := service.NewAuthService(mocks.authRepo, mocks.userSvc, mocks.bcrypt, mocks.jwt, mocks.mailer, mocks.uuid)
+	svc := service.NewAuthService(mocks.authRepo, mocks.userSvc, mocks.bcrypt, mocks.jwt, mocks.emailSvc,
+		mocks.uuid, mocks.randGen)
 
 	return svc, mocks
 }
@@ -51,70 +55,59 @@ func Test_AuthService_RequestOTPRegisterUser(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
-		emailSent := make(chan struct{}, 1)
 
 		// Expect user not found (which is good for registration)
 		mocks.userSvc.EXPECT().
 			GetUserByEmail(ctx, email).
 			Return(nil, errorpkg.ErrNotFound)
 
+		// Expect OTP to be generated
+		mocks.randGen.EXPECT().
+			RandomNumber(6).
+			Return(123456, nil)
+
 		// Expect OTP to be set
 		mocks.authRepo.EXPECT().
-			SetOTPRegisterUser(ctx, email, mock.AnythingOfType("string")).
+			SetOTPRegisterUser(ctx, email, "123456").
 			Return(nil)
 
-		// Mock email sending with channel notification
-		mocks.mailer.EXPECT().
-			Send(
-				email,
-				"[Conference App] Verify Your Account",
-				"otp_register_user.html",
-				mock.AnythingOfType("map[string]interface {}"),
-			).RunAndReturn(func(_, _, _ string, _ map[string]interface{}) error {
-			emailSent <- struct{}{}
-			return nil
-		})
+		// Expect OTP email to be queued
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, email, "[Conference App] Verify Your Account", "otp_register_user.html",
+				map[string]interface{}{"otp": "123456"}).
+			Return(nil)
 
 		err := svc.RequestOTPRegisterUser(ctx, email)
 		assert.NoError(t, err)
 
-		// Wait for email sending goroutine to complete
-		<-emailSent
 	})
 
-	t.Run("error - email sending fails", func(t *testing.T) {
+	t.Run("error - queue email fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
-		emailSent := make(chan struct{}, 1)
 
 		// Expect user not found (which is good for registration)
 		mocks.userSvc.EXPECT().
 			GetUserByEmail(ctx, email).
 			Return(nil, errorpkg.ErrNotFound)
 
+		// Expect OTP to be generated
+		mocks.randGen.EXPECT().
+			RandomNumber(6).
+			Return(123456, nil)
+
 		// Expect OTP to be set
 		mocks.authRepo.EXPECT().
-			SetOTPRegisterUser(ctx, email, mock.AnythingOfType("string")).
+			SetOTPRegisterUser(ctx, email, "123456").
 			Return(nil)
 
-		// Mock email sending to fail
-		mocks.mailer.EXPECT().
-			Send(
-				email,
-				"[Conference App] Verify Your Account",
-				"otp_register_user.html",
-				mock.AnythingOfType("map[string]interface {}"),
-			).RunAndReturn(func(_, _, _ string, _ map[string]interface{}) error {
-			emailSent <- struct{}{}
-			return errors.New("email sending error")
-		})
+		// Mock queueing the email to fail
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, email, "[Conference App] Verify Your Account", "otp_register_user.html",
+				map[string]interface{}{"otp": "123456"}).
+			Return(errorpkg.ErrInternalServer)
 
 		err := svc.RequestOTPRegisterUser(ctx, email)
-		assert.NoError(t, err)
-
-		// Wait for email sending goroutine to complete
-		<-emailSent
-
-		// It should not return an error because the email sending is done in a goroutine
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
 	t.Run("error - email already registered", func(t *testing.T) {
@@ -148,51 +141,80 @@ func Test_AuthService_RequestOTPRegisterUser(t *testing.T) {
 			GetUserByEmail(ctx, email).
 			Return(nil, errorpkg.ErrNotFound)
 
+		mocks.randGen.EXPECT().
+			RandomNumber(6).
+			Return(123456, nil)
+
 		mocks.authRepo.EXPECT().
-			SetOTPRegisterUser(ctx, email, mock.AnythingOfType("string")).
+			SetOTPRegisterUser(ctx, email, "123456").
 			Return(errors.New("redis error"))
 
 		err := svc.RequestOTPRegisterUser(ctx, email)
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
+
+	t.Run("error - generate OTP fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.userSvc.EXPECT().
+			GetUserByEmail(ctx, email).
+			Return(nil, errorpkg.ErrNotFound)
+
+		mocks.randGen.EXPECT().
+			RandomNumber(6).
+			Return(0, errors.New("entropy error"))
+
+		err := svc.RequestOTPRegisterUser(ctx, email)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
 }
 
 func Test_AuthService_CheckOTPRegisterUser(t *testing.T) {
 	ctx := context.Background()
 	email := "test@example.com"
 	otp := "123456"
+	req := dto.CheckOTPRegisterUserRequest{
+		Email: email,
+		OTP:   otp,
+	}
 
 	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "register_otp", email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPRegisterUser(ctx, email).
 			Return(otp, nil)
 
-		err := svc.CheckOTPRegisterUser(ctx, email, otp)
+		err := svc.CheckOTPRegisterUser(ctx, req)
 		assert.NoError(t, err)
 	})
 
 	t.Run("error - OTP not found", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "register_otp", email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPRegisterUser(ctx, email).
 			Return("", redis.Nil)
 
-		err := svc.CheckOTPRegisterUser(ctx, email, otp)
+		err := svc.CheckOTPRegisterUser(ctx, req)
 		assert.ErrorIs(t, err, errorpkg.ErrInvalidOTP)
 	})
 
 	t.Run("error - get OTP fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "register_otp", email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPRegisterUser(ctx, email).
 			Return("", errors.New("redis error"))
 
-		err := svc.CheckOTPRegisterUser(ctx, email, otp)
+		err := svc.CheckOTPRegisterUser(ctx, req)
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
@@ -200,13 +222,135 @@ func Test_AuthService_CheckOTPRegisterUser(t *testing.T) {
 	t.Run("error - invalid OTP", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "register_otp", email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPRegisterUser(ctx, email).
 			Return("654321", nil)
 
-		err := svc.CheckOTPRegisterUser(ctx, email, otp)
+		mockFailedAttempt(mocks, ctx, "register_otp", email, 1)
+
+		err := svc.CheckOTPRegisterUser(ctx, req)
 		assert.ErrorIs(t, err, errorpkg.ErrInvalidOTP)
 	})
+
+	t.Run("error - locked out", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			GetAttempts(ctx, "register_otp", "email:"+email).
+			Return(5, 3*time.Minute, nil)
+
+		err := svc.CheckOTPRegisterUser(ctx, req)
+		assert.ErrorIs(t, err, errorpkg.ErrTooManyAttempts)
+		assert.Equal(t, map[string]interface{}{"retry_after": 180}, errorpkg.ErrTooManyAttempts.Detail)
+	})
+
+	t.Run("error - check attempts fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			GetAttempts(ctx, "register_otp", "email:"+email).
+			Return(0, 0, errors.New("redis error"))
+
+		err := svc.CheckOTPRegisterUser(ctx, req)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - too many invalid OTP invalidates OTP", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, email).
+			Return("654321", nil)
+
+		mocks.authRepo.EXPECT().
+			IncrementAttempts(ctx, "register_otp", "email:"+email, 10*time.Minute).
+			Return(5, 8*time.Minute, nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteOTPRegisterUser(ctx, email).
+			Return(nil)
+
+		err := svc.CheckOTPRegisterUser(ctx, req)
+		assert.ErrorIs(t, err, errorpkg.ErrTooManyAttempts)
+		assert.Equal(t, map[string]interface{}{"retry_after": 480}, errorpkg.ErrTooManyAttempts.Detail)
+	})
+
+	t.Run("error - too many invalid OTP from same IP", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		reqWithIP := req
+		reqWithIP.IPAddress = "10.0.0.1"
+
+		mocks.authRepo.EXPECT().
+			GetAttempts(ctx, "register_otp", "email:"+email).
+			Return(0, 0, nil)
+
+		mocks.authRepo.EXPECT().
+			GetAttempts(ctx, "register_otp", "ip:10.0.0.1").
+			Return(19, 2*time.Minute, nil)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, email).
+			Return("654321", nil)
+
+		mocks.authRepo.EXPECT().
+			IncrementAttempts(ctx, "register_otp", "email:"+email, 10*time.Minute).
+			Return(1, 10*time.Minute, nil)
+
+		mocks.authRepo.EXPECT().
+			IncrementAttempts(ctx, "register_otp", "ip:10.0.0.1", 10*time.Minute).
+			Return(20, 2*time.Minute, nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteOTPRegisterUser(ctx, email).
+			Return(nil)
+
+		err := svc.CheckOTPRegisterUser(ctx, reqWithIP)
+		assert.ErrorIs(t, err, errorpkg.ErrTooManyAttempts)
+		assert.Equal(t, map[string]interface{}{"retry_after": 120}, errorpkg.ErrTooManyAttempts.Detail)
+	})
+
+	t.Run("error - record failed attempt fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, email).
+			Return("654321", nil)
+
+		mocks.authRepo.EXPECT().
+			IncrementAttempts(ctx, "register_otp", "email:"+email, 10*time.Minute).
+			Return(0, 0, errors.New("redis error"))
+
+		err := svc.CheckOTPRegisterUser(ctx, req)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - invalidate OTP fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, email).
+			Return("654321", nil)
+
+		mocks.authRepo.EXPECT().
+			IncrementAttempts(ctx, "register_otp", "email:"+email, 10*time.Minute).
+			Return(5, 8*time.Minute, nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteOTPRegisterUser(ctx, email).
+			Return(errors.New("redis error"))
+
+		err := svc.CheckOTPRegisterUser(ctx, req)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
 }
 
 func Test_AuthService_LoginUser(t *testing.T) {
@@ -239,10 +383,14 @@ func Test_AuthService_LoginUser(t *testing.T) {
 	t.Run("error - user not found", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
 		mocks.userSvc.EXPECT().
 			GetUserByEmail(ctx, req.Email).
 			Return(nil, errorpkg.ErrNotFound)
 
+		mockFailedAttempt(mocks, ctx, "login", req.Email, 1)
+
 		resp, err := svc.Login(ctx, req)
 		assert.Empty(t, resp)
 		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
@@ -251,6 +399,8 @@ func Test_AuthService_LoginUser(t *testing.T) {
 	t.Run("error - get user unexpected error", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
 		mocks.userSvc.EXPECT().
 			GetUserByEmail(ctx, req.Email).
 			Return(nil, errors.New("db error"))
@@ -264,6 +414,8 @@ func Test_AuthService_LoginUser(t *testing.T) {
 	t.Run("error - invalid credentials", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
 		passwordHash := "hashed_password"
 		user := &entity.User{
 			Email:        req.Email,
@@ -278,14 +430,52 @@ func Test_AuthService_LoginUser(t *testing.T) {
 			Compare(req.Password, passwordHash).
 			Return(false)
 
+		mockFailedAttempt(mocks, ctx, "login", req.Email, 1)
+
 		resp, err := svc.Login(ctx, req)
 		assert.Empty(t, resp)
 		assert.ErrorIs(t, err, errorpkg.ErrCredentialsNotMatch)
 	})
 
+	t.Run("error - session ID generation fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
+		passwordHash := "hashed_password"
+		user := &entity.User{
+			ID:           uuid.New(),
+			Email:        req.Email,
+			PasswordHash: passwordHash,
+			Role:         enum.RoleUser,
+		}
+
+		mocks.userSvc.EXPECT().
+			GetUserByEmail(ctx, req.Email).
+			Return(user, nil)
+
+		mocks.bcrypt.EXPECT().
+			Compare(req.Password, passwordHash).
+			Return(true)
+
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "login", "email:"+req.Email).
+			Return(nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.Nil, errors.New("uuid error"))
+
+		resp, err := svc.Login(ctx, req)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
 	t.Run("error - jwt creation fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
 		passwordHash := "hashed_password"
 		user := &entity.User{
 			ID:           uuid.New(),
@@ -303,16 +493,27 @@ func Test_AuthService_LoginUser(t *testing.T) {
 			Compare(req.Password, passwordHash).
 			Return(true)
 
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "login", "email:"+req.Email).
+			Return(nil)
+
 		// JWT creation will fail
+		sessionID := uuid.New()
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(sessionID, nil)
+
 		mocks.jwt.EXPECT().
-			Create(user.ID, user.Role).
+			Create(user.ID, user.Role, sessionID).
 			Return("", errors.New("jwt error"))
 
 		// Expect CreateAuthSession to be called but we don't care about the result
 		// since the JWT error should be returned first
 		mocks.authRepo.EXPECT().
 			CreateAuthSession(ctx, mock.MatchedBy(func(authSession *entity.AuthSession) bool {
-				return authSession.UserID == user.ID &&
+				return authSession.ID == sessionID &&
+					authSession.FamilyID == sessionID &&
+					authSession.UserID == user.ID &&
 					len(authSession.Token) == 32 &&
 					!authSession.ExpiresAt.IsZero()
 			})).
@@ -328,6 +529,8 @@ func Test_AuthService_LoginUser(t *testing.T) {
 	t.Run("error - create auth session fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
 		passwordHash := "hashed_password"
 		user := &entity.User{
 			ID:           uuid.New(),
@@ -345,15 +548,30 @@ func Test_AuthService_LoginUser(t *testing.T) {
 			Compare(req.Password, passwordHash).
 			Return(true)
 
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "login", "email:"+req.Email).
+			Return(nil)
+
 		// JWT creation succeeds
+		sessionID := uuid.New()
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(sessionID, nil)
+
 		mocks.jwt.EXPECT().
-			Create(user.ID, user.Role).
+			Create(user.ID, user.Role, sessionID).
 			Return("access_token", nil)
 
 		// AuthSession creation fails
+		mocks.randGen.EXPECT().
+			RandomString(32).
+			Return("zvXQxgxN2pQD4kci41lhnkwfXKAXtt2l", nil)
+
 		mocks.authRepo.EXPECT().
 			CreateAuthSession(ctx, mock.MatchedBy(func(authSession *entity.AuthSession) bool {
-				return authSession.UserID == user.ID &&
+				return authSession.ID == sessionID &&
+					authSession.FamilyID == sessionID &&
+					authSession.UserID == user.ID &&
 					len(authSession.Token) == 32 &&
 					!authSession.ExpiresAt.IsZero()
 			})).
@@ -368,6 +586,8 @@ func Test_AuthService_LoginUser(t *testing.T) {
 	t.Run("both operations fail", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
 		passwordHash := "hashed_password"
 		user := &entity.User{
 			ID:           uuid.New(),
@@ -385,14 +605,25 @@ func Test_AuthService_LoginUser(t *testing.T) {
 			Compare(req.Password, passwordHash).
 			Return(true)
 
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "login", "email:"+req.Email).
+			Return(nil)
+
 		// Both operations fail
+		sessionID := uuid.New()
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(sessionID, nil)
+
 		mocks.jwt.EXPECT().
-			Create(user.ID, user.Role).
+			Create(user.ID, user.Role, sessionID).
 			Return("", errors.New("jwt error"))
 
 		mocks.authRepo.EXPECT().
 			CreateAuthSession(ctx, mock.MatchedBy(func(authSession *entity.AuthSession) bool {
-				return authSession.UserID == user.ID &&
+				return authSession.ID == sessionID &&
+					authSession.FamilyID == sessionID &&
+					authSession.UserID == user.ID &&
 					len(authSession.Token) == 32 &&
 					!authSession.ExpiresAt.IsZero()
 			})).
@@ -404,120 +635,287 @@ func Test_AuthService_LoginUser(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
-}
-
-func Test_AuthService_RegisterUser(t *testing.T) {
-	ctx := context.Background()
-	req := dto.RegisterUserRequest{
-		Email:    "test@example.com",
-		OTP:      "123456",
-		Name:     "Test User",
-		Password: "password123",
-	}
 
-	t.Run("success", func(t *testing.T) {
+	t.Run("error - account locked out", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		// Setup expectations
-		mocks.authRepo.EXPECT().
-			GetOTPRegisterUser(ctx, req.Email).
-			Return(req.OTP, nil)
-
 		mocks.authRepo.EXPECT().
-			DeleteOTPRegisterUser(ctx, req.Email).
-			Return(nil)
-
-		userID := uuid.New()
-		mocks.userSvc.EXPECT().
-			CreateUser(ctx, &dto.CreateUserRequest{
-				Name:     req.Name,
-				Email:    req.Email,
-				Password: req.Password,
-				Role:     enum.RoleUser,
-			}).Return(userID, nil)
-
-		// Mock login expectations
-		mockLoginExpectations(mocks, ctx, req.Email, req.Password, userID)
+			GetAttempts(ctx, "login", "email:"+req.Email).
+			Return(5, 90*time.Second, nil)
 
-		resp, err := svc.RegisterUser(ctx, req)
-		assert.NoError(t, err)
-		assert.NotEmpty(t, resp.AccessToken)
-		assert.NotEmpty(t, resp.RefreshToken)
-		assert.NotNil(t, resp.User)
+		resp, err := svc.Login(ctx, req)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrTooManyAttempts)
+		assert.Equal(t, map[string]interface{}{"retry_after": 90}, errorpkg.ErrTooManyAttempts.Detail)
 	})
 
-	t.Run("error - no OTP found", func(t *testing.T) {
+	t.Run("error - IP locked out", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		mocks.authRepo.EXPECT().
-			GetOTPRegisterUser(ctx, req.Email).
-			Return("", redis.Nil)
-
-		resp, err := svc.RegisterUser(ctx, req)
-		assert.Empty(t, resp)
-		assert.ErrorIs(t, err, errorpkg.ErrInvalidOTP)
-	})
+		reqWithIP := req
+		reqWithIP.IPAddress = "10.0.0.1"
 
-	t.Run("error - invalid OTP", func(t *testing.T) {
-		svc, mocks := setupAuthServiceMocks(t)
+		mocks.authRepo.EXPECT().
+			GetAttempts(ctx, "login", "email:"+req.Email).
+			Return(0, 0, nil)
 
 		mocks.authRepo.EXPECT().
-			GetOTPRegisterUser(ctx, req.Email).
-			Return("different-otp", nil)
+			GetAttempts(ctx, "login", "ip:10.0.0.1").
+			Return(20, 5*time.Minute, nil)
 
-		resp, err := svc.RegisterUser(ctx, req)
+		resp, err := svc.Login(ctx, reqWithIP)
 		assert.Empty(t, resp)
-		assert.ErrorIs(t, err, errorpkg.ErrInvalidOTP)
+		assert.ErrorIs(t, err, errorpkg.ErrTooManyAttempts)
+		assert.Equal(t, map[string]interface{}{"retry_after": 300}, errorpkg.ErrTooManyAttempts.Detail)
 	})
 
-	t.Run("error - get OTP fails", func(t *testing.T) {
+	t.Run("error - check attempts fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
 		mocks.authRepo.EXPECT().
-			GetOTPRegisterUser(ctx, req.Email).
-			Return("", errors.New("redis error"))
+			GetAttempts(ctx, "login", "email:"+req.Email).
+			Return(0, 0, errors.New("redis error"))
 
-		resp, err := svc.RegisterUser(ctx, req)
+		resp, err := svc.Login(ctx, req)
 		assert.Empty(t, resp)
-		assert.Error(t, err)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - delete OTP fails", func(t *testing.T) {
+	t.Run("error - repeated invalid credentials locks account", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		mocks.authRepo.EXPECT().
-			GetOTPRegisterUser(ctx, req.Email).
-			Return(req.OTP, nil)
+		passwordHash := "hashed_password"
+		user := &entity.User{
+			Email:        req.Email,
+			PasswordHash: passwordHash,
+		}
+
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
+		mocks.userSvc.EXPECT().
+			GetUserByEmail(ctx, req.Email).
+			Return(user, nil)
+
+		mocks.bcrypt.EXPECT().
+			Compare(req.Password, passwordHash).
+			Return(false)
 
 		mocks.authRepo.EXPECT().
-			DeleteOTPRegisterUser(ctx, req.Email).
-			Return(errors.New("redis error"))
+			IncrementAttempts(ctx, "login", "email:"+req.Email, 15*time.Minute).
+			Return(5, 15*time.Minute, nil)
 
-		resp, err := svc.RegisterUser(ctx, req)
+		resp, err := svc.Login(ctx, req)
 		assert.Empty(t, resp)
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+		assert.ErrorIs(t, err, errorpkg.ErrTooManyAttempts)
+		assert.Equal(t, map[string]interface{}{"retry_after": 900}, errorpkg.ErrTooManyAttempts.Detail)
 	})
 
-	t.Run("error - create user fails", func(t *testing.T) {
+	t.Run("error - record failed attempt fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		mocks.authRepo.EXPECT().
-			GetOTPRegisterUser(ctx, req.Email).
-			Return(req.OTP, nil)
+		passwordHash := "hashed_password"
+		user := &entity.User{
+			Email:        req.Email,
+			PasswordHash: passwordHash,
+		}
 
-		mocks.authRepo.EXPECT().
-			DeleteOTPRegisterUser(ctx, req.Email).
-			Return(nil)
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
 
 		mocks.userSvc.EXPECT().
-			CreateUser(ctx, mock.Anything).
-			Return(uuid.UUID{}, errors.New("db error"))
+			GetUserByEmail(ctx, req.Email).
+			Return(user, nil)
 
-		resp, err := svc.RegisterUser(ctx, req)
+		mocks.bcrypt.EXPECT().
+			Compare(req.Password, passwordHash).
+			Return(false)
+
+		mocks.authRepo.EXPECT().
+			IncrementAttempts(ctx, "login", "email:"+req.Email, 15*time.Minute).
+			Return(0, 0, errors.New("redis error"))
+
+		resp, err := svc.Login(ctx, req)
 		assert.Empty(t, resp)
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - refresh token generation fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
+		passwordHash := "hashed_password"
+		user := &entity.User{
+			ID:           uuid.New(),
+			Email:        req.Email,
+			PasswordHash: passwordHash,
+			Role:         enum.RoleUser,
+		}
+
+		mocks.userSvc.EXPECT().
+			GetUserByEmail(ctx, req.Email).
+			Return(user, nil)
+
+		mocks.bcrypt.EXPECT().
+			Compare(req.Password, passwordHash).
+			Return(true)
+
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "login", "email:"+req.Email).
+			Return(nil)
+
+		sessionID := uuid.New()
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(sessionID, nil)
+
+		mocks.jwt.EXPECT().
+			Create(user.ID, user.Role, sessionID).
+			Return("access_token", nil)
+
+		mocks.randGen.EXPECT().
+			RandomString(32).
+			Return("", errors.New("entropy error"))
+
+		resp, err := svc.Login(ctx, req)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_AuthService_RegisterUser(t *testing.T) {
+	ctx := context.Background()
+	req := dto.RegisterUserRequest{
+		Email:    "test@example.com",
+		OTP:      "123456",
+		Name:     "Test User",
+		Password: "password123",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", req.Email)
+
+		// Setup expectations
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, req.Email).
+			Return(req.OTP, nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteOTPRegisterUser(ctx, req.Email).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "register_otp", "email:"+req.Email).
+			Return(nil)
+
+		userID := uuid.New()
+		mocks.userSvc.EXPECT().
+			CreateUser(ctx, &dto.CreateUserRequest{
+				Name:     req.Name,
+				Email:    req.Email,
+				Password: req.Password,
+				Role:     enum.RoleUser,
+			}).Return(userID, nil)
+
+		// Mock login expectations
+		mockLoginExpectations(mocks, ctx, req.Email, req.Password, userID)
+
+		resp, err := svc.RegisterUser(ctx, req)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
+		assert.NotNil(t, resp.User)
+	})
+
+	t.Run("error - no OTP found", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", req.Email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, req.Email).
+			Return("", redis.Nil)
+
+		resp, err := svc.RegisterUser(ctx, req)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidOTP)
+	})
+
+	t.Run("error - invalid OTP", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", req.Email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, req.Email).
+			Return("different-otp", nil)
+
+		mockFailedAttempt(mocks, ctx, "register_otp", req.Email, 1)
+
+		resp, err := svc.RegisterUser(ctx, req)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidOTP)
+	})
+
+	t.Run("error - get OTP fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", req.Email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, req.Email).
+			Return("", errors.New("redis error"))
+
+		resp, err := svc.RegisterUser(ctx, req)
+		assert.Empty(t, resp)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - delete OTP fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", req.Email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, req.Email).
+			Return(req.OTP, nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteOTPRegisterUser(ctx, req.Email).
+			Return(errors.New("redis error"))
+
+		resp, err := svc.RegisterUser(ctx, req)
+		assert.Empty(t, resp)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - create user fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "register_otp", req.Email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPRegisterUser(ctx, req.Email).
+			Return(req.OTP, nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteOTPRegisterUser(ctx, req.Email).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "register_otp", "email:"+req.Email).
+			Return(nil)
+
+		mocks.userSvc.EXPECT().
+			CreateUser(ctx, mock.Anything).
+			Return(uuid.UUID{}, errors.New("db error"))
+
+		resp, err := svc.RegisterUser(ctx, req)
+		assert.Empty(t, resp)
+		assert.Error(t, err)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 }
@@ -535,6 +933,8 @@ func mockLoginExpectations(mocks *authServiceMocks, ctx context.Context, email,
 		UpdatedAt:    time.Now(),
 	}
 
+	mockAttemptsAllowed(mocks, ctx, "login", email)
+
 	mocks.userSvc.EXPECT().
 		GetUserByEmail(ctx, email).
 		Return(user, nil)
@@ -543,19 +943,48 @@ func mockLoginExpectations(mocks *authServiceMocks, ctx context.Context, email,
 		Compare(password, passwordHash).
 		Return(true)
 
+	mocks.authRepo.EXPECT().
+		DeleteAttempts(ctx, "login", "email:"+email).
+		Return(nil)
+
+	sessionID := uuid.New()
+	mocks.uuid.EXPECT().
+		NewV7().
+		Return(sessionID, nil)
+
 	mocks.jwt.EXPECT().
-		Create(user.ID, user.Role).
+		Create(user.ID, user.Role, sessionID).
 		Return("access_token", nil)
 
+	mocks.randGen.EXPECT().
+		RandomString(32).
+		Return("zvXQxgxN2pQD4kci41lhnkwfXKAXtt2l", nil)
+
 	mocks.authRepo.EXPECT().
 		CreateAuthSession(ctx, mock.MatchedBy(func(authSession *entity.AuthSession) bool {
-			return authSession.UserID == user.ID &&
+			return authSession.ID == sessionID &&
+				authSession.FamilyID == sessionID &&
+				authSession.UserID == user.ID &&
 				len(authSession.Token) == 32 && // Check refresh token length
 				!authSession.ExpiresAt.IsZero() // Check expiration is set
 		})).
 		Return(nil)
 }
 
+// Helper function to let the attempt check pass for requests without IP address
+func mockAttemptsAllowed(mocks *authServiceMocks, ctx context.Context, scope, email string) {
+	mocks.authRepo.EXPECT().
+		GetAttempts(ctx, scope, "email:"+email).
+		Return(0, 0, nil)
+}
+
+// Helper function to record a failed attempt that doesn't reach the limit yet
+func mockFailedAttempt(mocks *authServiceMocks, ctx context.Context, scope, email string, count int64) {
+	mocks.authRepo.EXPECT().
+		IncrementAttempts(ctx, scope, "email:"+email, mock.AnythingOfType("time.Duration")).
+		Return(count, 10*time.Minute, nil)
+}
+
 func Test_AuthService_RefreshToken(t *testing.T) {
 	ctx := context.Background()
 	refreshToken := "valid_refresh_token"
@@ -564,208 +993,780 @@ func Test_AuthService_RefreshToken(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		// Setup auth session
-		authSession := &entity.AuthSession{
-			Token:     refreshToken,
-			UserID:    userID,
-			ExpiresAt: time.Now().Add(time.Hour), // Valid future expiration
-		}
-
-		// Setup user
-		user := &entity.User{
-			ID:        userID,
-			Email:     "test@example.com",
-			Name:      "Test User",
-			Role:      enum.RoleUser,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		}
-
-		// Set up expectations
+		// Setup auth session
+		authSession := &entity.AuthSession{
+			ID:        uuid.New(),
+			FamilyID:  uuid.New(),
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour), // Valid future expiration
+		}
+
+		// Setup user
+		user := &entity.User{
+			ID:        userID,
+			Email:     "test@example.com",
+			Name:      "Test User",
+			Role:      enum.RoleUser,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+
+		// Set up expectations
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(user, nil)
+
+		mocks.jwt.EXPECT().
+			Create(user.ID, user.Role, authSession.FamilyID).
+			Return("new_access_token", nil)
+
+		newSessionID := uuid.New()
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(newSessionID, nil)
+
+		mocks.randGen.EXPECT().
+			RandomString(32).
+			Return("zvXQxgxN2pQD4kci41lhnkwfXKAXtt2l", nil)
+
+		mocks.authRepo.EXPECT().
+			RotateAuthSession(ctx, authSession, mock.MatchedBy(func(session *entity.AuthSession) bool {
+				return session.ID == newSessionID &&
+					session.FamilyID == authSession.FamilyID &&
+					session.UserID == userID &&
+					len(session.Token) == 32 &&
+					session.Token != refreshToken
+			})).
+			Return(nil)
+
+		// Execute and verify
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.Equal(t, "zvXQxgxN2pQD4kci41lhnkwfXKAXtt2l", resp.RefreshToken)
+		assert.NotNil(t, resp.User)
+		assert.Equal(t, user.ID, resp.User.ID)
+		assert.Equal(t, user.Email, resp.User.Email)
+		assert.Equal(t, user.Role, resp.User.Role)
+	})
+
+	t.Run("error - auth session not found", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(nil, sql.ErrNoRows)
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidRefreshToken)
+	})
+
+	t.Run("error - get auth session unexpected error", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(nil, errors.New("db error"))
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - expired auth session", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		// Setup expired auth session
+		authSession := &entity.AuthSession{
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(-time.Hour), // Expired
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidRefreshToken)
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		// Setup valid auth session
+		authSession := &entity.AuthSession{
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - get user unexpected error", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		// Setup valid auth session
+		authSession := &entity.AuthSession{
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(nil, errors.New("db error"))
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - jwt creation fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		// Setup valid auth session
+		authSession := &entity.AuthSession{
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		// Setup user
+		user := &entity.User{
+			ID:   userID,
+			Role: enum.RoleUser,
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(user, nil)
+
+		mocks.jwt.EXPECT().
+			Create(user.ID, user.Role, authSession.FamilyID).
+			Return("", errors.New("jwt error"))
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - session ID generation fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		authSession := &entity.AuthSession{
+			ID:        uuid.New(),
+			FamilyID:  uuid.New(),
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		user := &entity.User{
+			ID:   userID,
+			Role: enum.RoleUser,
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(user, nil)
+
+		mocks.jwt.EXPECT().
+			Create(user.ID, user.Role, authSession.FamilyID).
+			Return("new_access_token", nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.Nil, errors.New("uuid error"))
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - rotate auth session fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		authSession := &entity.AuthSession{
+			ID:        uuid.New(),
+			FamilyID:  uuid.New(),
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		user := &entity.User{
+			ID:   userID,
+			Role: enum.RoleUser,
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(user, nil)
+
+		mocks.jwt.EXPECT().
+			Create(user.ID, user.Role, authSession.FamilyID).
+			Return("new_access_token", nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.New(), nil)
+
+		mocks.randGen.EXPECT().
+			RandomString(32).
+			Return("zvXQxgxN2pQD4kci41lhnkwfXKAXtt2l", nil)
+
+		mocks.authRepo.EXPECT().
+			RotateAuthSession(ctx, authSession, mock.AnythingOfType("*entity.AuthSession")).
+			Return(errors.New("db error"))
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - rotated token reused", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		rotatedAt := time.Now().Add(-time.Minute)
+		authSession := &entity.AuthSession{
+			ID:        uuid.New(),
+			FamilyID:  uuid.New(),
+			Token:     refreshToken,
+			UserID:    userID,
+			RotatedAt: &rotatedAt,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		// Whole token family is revoked
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{authSession.FamilyID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, authSession.FamilyID, userID).
+			Return(nil)
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidRefreshToken)
+	})
+
+	t.Run("error - rotated token reused and revoke fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		rotatedAt := time.Now().Add(-time.Minute)
+		authSession := &entity.AuthSession{
+			ID:        uuid.New(),
+			FamilyID:  uuid.New(),
+			Token:     refreshToken,
+			UserID:    userID,
+			RotatedAt: &rotatedAt,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{authSession.FamilyID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, authSession.FamilyID, userID).
+			Return(errors.New("db error"))
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - token rotated concurrently", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		authSession := &entity.AuthSession{
+			ID:        uuid.New(),
+			FamilyID:  uuid.New(),
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		user := &entity.User{
+			ID:   userID,
+			Role: enum.RoleUser,
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(user, nil)
+
+		mocks.jwt.EXPECT().
+			Create(user.ID, user.Role, authSession.FamilyID).
+			Return("new_access_token", nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.New(), nil)
+
+		mocks.randGen.EXPECT().
+			RandomString(32).
+			Return("zvXQxgxN2pQD4kci41lhnkwfXKAXtt2l", nil)
+
+		mocks.authRepo.EXPECT().
+			RotateAuthSession(ctx, authSession, mock.AnythingOfType("*entity.AuthSession")).
+			Return(sql.ErrNoRows)
+
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{authSession.FamilyID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, authSession.FamilyID, userID).
+			Return(nil)
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidRefreshToken)
+	})
+
+	t.Run("error - refresh token generation fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		authSession := &entity.AuthSession{
+			ID:        uuid.New(),
+			FamilyID:  uuid.New(),
+			Token:     refreshToken,
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		user := &entity.User{
+			ID:   userID,
+			Role: enum.RoleUser,
+		}
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionByToken(ctx, refreshToken).
+			Return(authSession, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(user, nil)
+
+		mocks.jwt.EXPECT().
+			Create(user.ID, user.Role, authSession.FamilyID).
+			Return("new_access_token", nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.New(), nil)
+
+		mocks.randGen.EXPECT().
+			RandomString(32).
+			Return("", errors.New("entropy error"))
+
+		resp, err := svc.RefreshToken(ctx, refreshToken)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_AuthService_Logout(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	ctx := context.WithValue(context.Background(), "user.id", userID)
+	ctx = context.WithValue(ctx, "session.id", sessionID)
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, sessionID, userID).
+			Return(nil)
+
+		err := svc.Logout(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - auth session not found", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, sessionID, userID).
+			Return(sql.ErrNoRows)
+
+		err := svc.Logout(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidBearerToken)
+	})
+
+	t.Run("error - delete auth session fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, sessionID, userID).
+			Return(errors.New("db error"))
+
+		err := svc.Logout(ctx)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - revoke access tokens fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(errors.New("redis error"))
+
+		err := svc.Logout(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_AuthService_LogoutAll(t *testing.T) {
+	userID := uuid.New()
+	firstSessionID := uuid.New()
+	secondSessionID := uuid.New()
+	ctx := context.WithValue(context.Background(), "user.id", userID)
+	sessions := []entity.AuthSession{
+		{FamilyID: firstSessionID, UserID: userID},
+		{FamilyID: secondSessionID, UserID: userID},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
 		mocks.authRepo.EXPECT().
-			GetAuthSessionByToken(ctx, refreshToken).
-			Return(authSession, nil)
+			GetAuthSessionsByUserID(ctx, userID).
+			Return(sessions, nil)
 
-		mocks.userSvc.EXPECT().
-			GetUserByID(ctx, userID).
-			Return(user, nil)
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{firstSessionID, secondSessionID},
+				env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
 
-		mocks.jwt.EXPECT().
-			Create(user.ID, user.Role).
-			Return("new_access_token", nil)
+		mocks.authRepo.EXPECT().
+			DeleteAuthSessionsByUserID(ctx, userID).
+			Return(nil)
 
-		// Execute and verify
-		resp, err := svc.RefreshToken(ctx, refreshToken)
+		err := svc.LogoutAll(ctx)
 		assert.NoError(t, err)
-		assert.NotEmpty(t, resp.AccessToken)
-		assert.Equal(t, refreshToken, resp.RefreshToken)
-		assert.NotNil(t, resp.User)
-		assert.Equal(t, user.ID, resp.User.ID)
-		assert.Equal(t, user.Email, resp.User.Email)
-		assert.Equal(t, user.Role, resp.User.Role)
 	})
 
-	t.Run("error - auth session not found", func(t *testing.T) {
+	t.Run("error - no auth session", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
 		mocks.authRepo.EXPECT().
-			GetAuthSessionByToken(ctx, refreshToken).
-			Return(nil, sql.ErrNoRows)
+			GetAuthSessionsByUserID(ctx, userID).
+			Return([]entity.AuthSession{}, nil)
 
-		resp, err := svc.RefreshToken(ctx, refreshToken)
-		assert.Empty(t, resp)
-		assert.ErrorIs(t, err, errorpkg.ErrInvalidRefreshToken)
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAuthSessionsByUserID(ctx, userID).
+			Return(sql.ErrNoRows)
+
+		err := svc.LogoutAll(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidBearerToken)
 	})
 
-	t.Run("error - get auth session unexpected error", func(t *testing.T) {
+	t.Run("error - get auth sessions fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
 		mocks.authRepo.EXPECT().
-			GetAuthSessionByToken(ctx, refreshToken).
+			GetAuthSessionsByUserID(ctx, userID).
 			Return(nil, errors.New("db error"))
 
-		resp, err := svc.RefreshToken(ctx, refreshToken)
-		assert.Empty(t, resp)
-		assert.Error(t, err)
+		err := svc.LogoutAll(ctx)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - expired auth session", func(t *testing.T) {
+	t.Run("error - revoke access tokens fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		// Setup expired auth session
-		authSession := &entity.AuthSession{
-			Token:     refreshToken,
-			UserID:    userID,
-			ExpiresAt: time.Now().Add(-time.Hour), // Expired
-		}
+		mocks.authRepo.EXPECT().
+			GetAuthSessionsByUserID(ctx, userID).
+			Return(sessions, nil)
 
 		mocks.authRepo.EXPECT().
-			GetAuthSessionByToken(ctx, refreshToken).
-			Return(authSession, nil)
+			RevokeAccessTokens(ctx, []uuid.UUID{firstSessionID, secondSessionID},
+				env.GetEnv().JwtAccessExpireDuration).
+			Return(errors.New("redis error"))
 
-		resp, err := svc.RefreshToken(ctx, refreshToken)
-		assert.Empty(t, resp)
-		assert.ErrorIs(t, err, errorpkg.ErrInvalidRefreshToken)
+		err := svc.LogoutAll(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - user not found", func(t *testing.T) {
+	t.Run("error - delete auth sessions fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		// Setup valid auth session
-		authSession := &entity.AuthSession{
-			Token:     refreshToken,
-			UserID:    userID,
-			ExpiresAt: time.Now().Add(time.Hour),
-		}
+		mocks.authRepo.EXPECT().
+			GetAuthSessionsByUserID(ctx, userID).
+			Return(sessions, nil)
 
 		mocks.authRepo.EXPECT().
-			GetAuthSessionByToken(ctx, refreshToken).
-			Return(authSession, nil)
+			RevokeAccessTokens(ctx, []uuid.UUID{firstSessionID, secondSessionID},
+				env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
 
-		mocks.userSvc.EXPECT().
-			GetUserByID(ctx, userID).
-			Return(nil, errorpkg.ErrNotFound)
+		mocks.authRepo.EXPECT().
+			DeleteAuthSessionsByUserID(ctx, userID).
+			Return(errors.New("db error"))
 
-		resp, err := svc.RefreshToken(ctx, refreshToken)
-		assert.Empty(t, resp)
-		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+		err := svc.LogoutAll(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
+}
 
-	t.Run("error - get user unexpected error", func(t *testing.T) {
+func Test_AuthService_GetSessions(t *testing.T) {
+	userID := uuid.New()
+	currentSessionID := uuid.New()
+	otherSessionID := uuid.New()
+	ctx := context.WithValue(context.Background(), "user.id", userID)
+	ctx = context.WithValue(ctx, "session.id", currentSessionID)
+
+	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		// Setup valid auth session
-		authSession := &entity.AuthSession{
-			Token:     refreshToken,
-			UserID:    userID,
-			ExpiresAt: time.Now().Add(time.Hour),
+		now := time.Now()
+		sessions := []entity.AuthSession{
+			{
+				ID:         uuid.New(),
+				FamilyID:   currentSessionID,
+				UserID:     userID,
+				UserAgent:  "Mozilla/5.0 (X11; Linux x86_64)",
+				IPAddress:  "10.0.0.1",
+				LastUsedAt: now,
+				CreatedAt:  now.Add(-time.Hour),
+				ExpiresAt:  now.Add(time.Hour),
+			},
+			{
+				ID:         uuid.New(),
+				FamilyID:   otherSessionID,
+				UserID:     userID,
+				UserAgent:  "Mozilla/5.0 (iPhone)",
+				IPAddress:  "10.0.0.2",
+				LastUsedAt: now.Add(-time.Minute),
+				CreatedAt:  now.Add(-2 * time.Hour),
+				ExpiresAt:  now.Add(time.Hour),
+			},
 		}
 
 		mocks.authRepo.EXPECT().
-			GetAuthSessionByToken(ctx, refreshToken).
-			Return(authSession, nil)
+			GetAuthSessionsByUserID(ctx, userID).
+			Return(sessions, nil)
 
-		mocks.userSvc.EXPECT().
-			GetUserByID(ctx, userID).
+		resp, err := svc.GetSessions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.AuthSessionResponse{
+			{
+				ID:         currentSessionID,
+				UserAgent:  "Mozilla/5.0 (X11; Linux x86_64)",
+				IPAddress:  "10.0.0.1",
+				IsCurrent:  true,
+				LastUsedAt: sessions[0].LastUsedAt,
+				CreatedAt:  sessions[0].CreatedAt,
+				ExpiresAt:  sessions[0].ExpiresAt,
+			},
+			{
+				ID:         otherSessionID,
+				UserAgent:  "Mozilla/5.0 (iPhone)",
+				IPAddress:  "10.0.0.2",
+				IsCurrent:  false,
+				LastUsedAt: sessions[1].LastUsedAt,
+				CreatedAt:  sessions[1].CreatedAt,
+				ExpiresAt:  sessions[1].ExpiresAt,
+			},
+		}, resp)
+	})
+
+	t.Run("error - get auth sessions fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			GetAuthSessionsByUserID(ctx, userID).
 			Return(nil, errors.New("db error"))
 
-		resp, err := svc.RefreshToken(ctx, refreshToken)
-		assert.Empty(t, resp)
-		assert.Error(t, err)
+		resp, err := svc.GetSessions(ctx)
+		assert.Nil(t, resp)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
+}
 
-	t.Run("error - jwt creation fails", func(t *testing.T) {
+func Test_AuthService_RevokeSession(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	ctx := context.WithValue(context.Background(), "user.id", userID)
+
+	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
-		// Setup valid auth session
-		authSession := &entity.AuthSession{
-			Token:     refreshToken,
-			UserID:    userID,
-			ExpiresAt: time.Now().Add(time.Hour),
-		}
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
 
-		// Setup user
-		user := &entity.User{
-			ID:   userID,
-			Role: enum.RoleUser,
-		}
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, sessionID, userID).
+			Return(nil)
+
+		err := svc.RevokeSession(ctx, sessionID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - session not found or owned by other user", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
 
 		mocks.authRepo.EXPECT().
-			GetAuthSessionByToken(ctx, refreshToken).
-			Return(authSession, nil)
+			RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
 
-		mocks.userSvc.EXPECT().
-			GetUserByID(ctx, userID).
-			Return(user, nil)
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, sessionID, userID).
+			Return(sql.ErrNoRows)
 
-		mocks.jwt.EXPECT().
-			Create(user.ID, user.Role).
-			Return("", errors.New("jwt error"))
+		err := svc.RevokeSession(ctx, sessionID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
 
-		resp, err := svc.RefreshToken(ctx, refreshToken)
-		assert.Empty(t, resp)
-		assert.Error(t, err)
+	t.Run("error - delete auth session fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAuthSession(ctx, sessionID, userID).
+			Return(errors.New("db error"))
+
+		err := svc.RevokeSession(ctx, sessionID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - revoke access tokens fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			RevokeAccessTokens(ctx, []uuid.UUID{sessionID}, env.GetEnv().JwtAccessExpireDuration).
+			Return(errors.New("redis error"))
+
+		err := svc.RevokeSession(ctx, sessionID)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 }
 
-func Test_AuthService_Logout(t *testing.T) {
-	userID := uuid.New()
-	ctx := context.WithValue(context.Background(), "user.id", userID)
+func Test_AuthService_DeleteExpiredSessions(t *testing.T) {
+	ctx := context.Background()
 
 	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
 		mocks.authRepo.EXPECT().
-			DeleteAuthSession(ctx, userID).
-			Return(nil)
+			DeleteExpiredAuthSessions(ctx).
+			Return(int64(3), nil)
 
-		err := svc.Logout(ctx)
+		err := svc.DeleteExpiredSessions(ctx)
 		assert.NoError(t, err)
 	})
 
-	t.Run("error - auth session not found", func(t *testing.T) {
+	t.Run("error - delete expired auth sessions fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
 		mocks.authRepo.EXPECT().
-			DeleteAuthSession(ctx, userID).
-			Return(sql.ErrNoRows)
+			DeleteExpiredAuthSessions(ctx).
+			Return(int64(0), errors.New("db error"))
 
-		err := svc.Logout(ctx)
-		assert.ErrorIs(t, err, errorpkg.ErrInvalidBearerToken)
+		err := svc.DeleteExpiredSessions(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
+}
 
-	t.Run("error - delete auth session fails", func(t *testing.T) {
+func Test_AuthService_IsSessionRevoked(t *testing.T) {
+	ctx := context.Background()
+	sessionID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
 		mocks.authRepo.EXPECT().
-			DeleteAuthSession(ctx, userID).
-			Return(errors.New("db error"))
+			IsAccessTokenRevoked(ctx, sessionID).
+			Return(true, nil)
 
-		err := svc.Logout(ctx)
-		assert.Error(t, err)
+		revoked, err := svc.IsSessionRevoked(ctx, sessionID)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("error - check fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			IsAccessTokenRevoked(ctx, sessionID).
+			Return(false, errors.New("redis error"))
+
+		revoked, err := svc.IsSessionRevoked(ctx, sessionID)
+		assert.False(t, revoked)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 }
@@ -776,35 +1777,31 @@ func Test_AuthService_RequestOTPResetPassword(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
-		emailSent := make(chan struct{}, 1)
 
 		// Expect user to be found
 		mocks.userSvc.EXPECT().
 			GetUserByEmail(ctx, email).
 			Return(&entity.User{ID: uuid.New()}, nil)
 
+		// Expect OTP to be generated
+		mocks.randGen.EXPECT().
+			RandomNumber(6).
+			Return(123456, nil)
+
 		// Expect OTP to be set
 		mocks.authRepo.EXPECT().
-			SetOTPResetPassword(ctx, email, mock.AnythingOfType("string")).
+			SetOTPResetPassword(ctx, email, "123456").
 			Return(nil)
 
-		// Mock email sending with channel notification
-		mocks.mailer.EXPECT().
-			Send(
-				email,
-				"[Conference App] Reset Password",
-				"otp_reset_password.html",
-				mock.AnythingOfType("map[string]interface {}"),
-			).RunAndReturn(func(_, _, _ string, _ map[string]interface{}) error {
-			emailSent <- struct{}{}
-			return nil
-		})
+		// Expect OTP email to be queued
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, email, "[Conference App] Reset Password", "otp_reset_password.html",
+				map[string]interface{}{"otp": "123456"}).
+			Return(nil)
 
 		err := svc.RequestOTPResetPassword(ctx, email)
 		assert.NoError(t, err)
 
-		// Wait for email sending goroutine to complete
-		<-emailSent
 	})
 
 	t.Run("error - user not found", func(t *testing.T) {
@@ -838,8 +1835,12 @@ func Test_AuthService_RequestOTPResetPassword(t *testing.T) {
 			GetUserByEmail(ctx, email).
 			Return(&entity.User{ID: uuid.New()}, nil)
 
+		mocks.randGen.EXPECT().
+			RandomNumber(6).
+			Return(123456, nil)
+
 		mocks.authRepo.EXPECT().
-			SetOTPResetPassword(ctx, email, mock.AnythingOfType("string")).
+			SetOTPResetPassword(ctx, email, "123456").
 			Return(errors.New("redis error"))
 
 		err := svc.RequestOTPResetPassword(ctx, email)
@@ -847,34 +1848,44 @@ func Test_AuthService_RequestOTPResetPassword(t *testing.T) {
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - email sending fails", func(t *testing.T) {
+	t.Run("error - queue email fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
-		emailSent := make(chan struct{}, 1)
 
 		mocks.userSvc.EXPECT().
 			GetUserByEmail(ctx, email).
 			Return(&entity.User{ID: uuid.New()}, nil)
 
+		mocks.randGen.EXPECT().
+			RandomNumber(6).
+			Return(123456, nil)
+
 		mocks.authRepo.EXPECT().
-			SetOTPResetPassword(ctx, email, mock.AnythingOfType("string")).
+			SetOTPResetPassword(ctx, email, "123456").
 			Return(nil)
 
-		mocks.mailer.EXPECT().
-			Send(
-				email,
-				"[Conference App] Reset Password",
-				"otp_reset_password.html",
-				mock.AnythingOfType("map[string]interface {}"),
-			).RunAndReturn(func(_, _, _ string, _ map[string]interface{}) error {
-			emailSent <- struct{}{}
-			return errors.New("email sending error")
-		})
+		// Mock queueing the email to fail
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, email, "[Conference App] Reset Password", "otp_reset_password.html",
+				map[string]interface{}{"otp": "123456"}).
+			Return(errorpkg.ErrInternalServer)
 
 		err := svc.RequestOTPResetPassword(ctx, email)
-		assert.NoError(t, err) // Should not return error as email is sent in goroutine
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - generate OTP fails", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.userSvc.EXPECT().
+			GetUserByEmail(ctx, email).
+			Return(&entity.User{Email: email}, nil)
 
-		// Wait for email sending goroutine to complete
-		<-emailSent
+		mocks.randGen.EXPECT().
+			RandomNumber(6).
+			Return(0, errors.New("entropy error"))
+
+		err := svc.RequestOTPResetPassword(ctx, email)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 }
 
@@ -889,19 +1900,26 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
 		// Setup expectations for password reset
 		mocks.authRepo.EXPECT().
 			GetOTPResetPassword(ctx, req.Email).
 			Return(req.OTP, nil)
 
 		mocks.authRepo.EXPECT().
-			SetOTPResetPassword(ctx, req.Email, "").
+			DeleteOTPResetPassword(ctx, req.Email).
 			Return(nil)
 
 		mocks.userSvc.EXPECT().
 			UpdatePassword(ctx, req.Email, req.NewPassword).
 			Return(nil)
 
+		// Lockout is lifted, login attempts are reset by mockLoginExpectations
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "reset_password_otp", "email:"+req.Email).
+			Return(nil)
+
 		// Setup expectations for subsequent login
 		mockLoginExpectations(mocks, ctx, req.Email, req.NewPassword, uuid.New())
 
@@ -915,6 +1933,8 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 	t.Run("error - OTP not found", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPResetPassword(ctx, req.Email).
 			Return("", redis.Nil)
@@ -927,6 +1947,8 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 	t.Run("error - get OTP unexpected error", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPResetPassword(ctx, req.Email).
 			Return("", errors.New("redis error"))
@@ -940,10 +1962,14 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 	t.Run("error - invalid OTP", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPResetPassword(ctx, req.Email).
 			Return("different-otp", nil)
 
+		mockFailedAttempt(mocks, ctx, "reset_password_otp", req.Email, 1)
+
 		resp, err := svc.ResetPassword(ctx, req)
 		assert.Empty(t, resp)
 		assert.ErrorIs(t, err, errorpkg.ErrInvalidOTP)
@@ -952,12 +1978,14 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 	t.Run("error - delete OTP fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPResetPassword(ctx, req.Email).
 			Return(req.OTP, nil)
 
 		mocks.authRepo.EXPECT().
-			SetOTPResetPassword(ctx, req.Email, "").
+			DeleteOTPResetPassword(ctx, req.Email).
 			Return(errors.New("redis error"))
 
 		resp, err := svc.ResetPassword(ctx, req)
@@ -969,12 +1997,14 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 	t.Run("error - update password fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPResetPassword(ctx, req.Email).
 			Return(req.OTP, nil)
 
 		mocks.authRepo.EXPECT().
-			SetOTPResetPassword(ctx, req.Email, "").
+			DeleteOTPResetPassword(ctx, req.Email).
 			Return(nil)
 
 		mocks.userSvc.EXPECT().
@@ -990,12 +2020,14 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 	t.Run("error - user not found during update", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
 		mocks.authRepo.EXPECT().
 			GetOTPResetPassword(ctx, req.Email).
 			Return(req.OTP, nil)
 
 		mocks.authRepo.EXPECT().
-			SetOTPResetPassword(ctx, req.Email, "").
+			DeleteOTPResetPassword(ctx, req.Email).
 			Return(nil)
 
 		mocks.userSvc.EXPECT().
@@ -1010,20 +2042,32 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 	t.Run("error - login after reset fails", func(t *testing.T) {
 		svc, mocks := setupAuthServiceMocks(t)
 
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
 		// Success up to password update
 		mocks.authRepo.EXPECT().
 			GetOTPResetPassword(ctx, req.Email).
 			Return(req.OTP, nil)
 
 		mocks.authRepo.EXPECT().
-			SetOTPResetPassword(ctx, req.Email, "").
+			DeleteOTPResetPassword(ctx, req.Email).
 			Return(nil)
 
 		mocks.userSvc.EXPECT().
 			UpdatePassword(ctx, req.Email, req.NewPassword).
 			Return(nil)
 
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "reset_password_otp", "email:"+req.Email).
+			Return(nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteAttempts(ctx, "login", "email:"+req.Email).
+			Return(nil)
+
 		// Fail during login
+		mockAttemptsAllowed(mocks, ctx, "login", req.Email)
+
 		mocks.userSvc.EXPECT().
 			GetUserByEmail(ctx, req.Email).
 			Return(nil, errors.New("db error"))
@@ -1033,4 +2077,38 @@ func Test_AuthService_ResetPassword(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
+
+	t.Run("error - too many invalid OTP invalidates OTP", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mockAttemptsAllowed(mocks, ctx, "reset_password_otp", req.Email)
+
+		mocks.authRepo.EXPECT().
+			GetOTPResetPassword(ctx, req.Email).
+			Return("different-otp", nil)
+
+		mocks.authRepo.EXPECT().
+			IncrementAttempts(ctx, "reset_password_otp", "email:"+req.Email, 10*time.Minute).
+			Return(5, 4*time.Minute, nil)
+
+		mocks.authRepo.EXPECT().
+			DeleteOTPResetPassword(ctx, req.Email).
+			Return(nil)
+
+		resp, err := svc.ResetPassword(ctx, req)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrTooManyAttempts)
+	})
+
+	t.Run("error - locked out", func(t *testing.T) {
+		svc, mocks := setupAuthServiceMocks(t)
+
+		mocks.authRepo.EXPECT().
+			GetAttempts(ctx, "reset_password_otp", "email:"+req.Email).
+			Return(5, 4*time.Minute, nil)
+
+		resp, err := svc.ResetPassword(ctx, req)
+		assert.Empty(t, resp)
+		assert.ErrorIs(t, err, errorpkg.ErrTooManyAttempts)
+	})
 }
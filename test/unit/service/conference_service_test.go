@@ -4,34 +4,47 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/nathakusuma/conference-backend/domain/contract"
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
 	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/domain/event"
 	"github.com/nathakusuma/conference-backend/internal/app/conference/service"
 	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
 	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
 	_ "github.com/nathakusuma/conference-backend/test/unit/setup" // Initialize test environment
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type conferenceServiceMocks struct {
 	conferenceRepo *appmocks.MockIConferenceRepository
+	roomSvc        *appmocks.MockIRoomService
+	eventSvc       *appmocks.MockIEventService
+	userSvc        *appmocks.MockIUserService
 	uuid           *pkgmocks.MockIUUID
+	eventBus       *pkgmocks.MockIEventBus
 }
 
 func setupConferenceServiceTest(t *testing.T) (contract.IConferenceService, *conferenceServiceMocks) {
 	mocks := &conferenceServiceMocks{
 		conferenceRepo: appmocks.NewMockIConferenceRepository(t),
+		roomSvc:        appmocks.NewMockIRoomService(t),
+		eventSvc:       appmocks.NewMockIEventService(t),
+		userSvc:        appmocks.NewMockIUserService(t),
 		uuid:           pkgmocks.NewMockIUUID(t),
+		eventBus:       pkgmocks.NewMockIEventBus(t),
 	}
 
-	svc := service.NewConferenceService(mocks.conferenceRepo, mocks.uuid)
+	svc := service.NewConferenceService(mocks.conferenceRepo, mocks.roomSvc, mocks.eventSvc, mocks.userSvc,
+		mocks.uuid, mocks.eventBus)
 
 	return svc, mocks
 }
@@ -42,6 +55,22 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 	now := time.Now()
 	futureTime := now.Add(24 * time.Hour)
 	laterTime := now.Add(48 * time.Hour)
+	roomID := uuid.New()
+	room := &dto.RoomResponse{ID: roomID, Name: "Main Hall", Capacity: 500}
+	eventID := uuid.New()
+	eventStartsAt := now.Add(-30 * 24 * time.Hour)
+	eventEndsAt := now.Add(30 * 24 * time.Hour)
+	cfpOpensAt := now.Add(-24 * time.Hour)
+	cfpClosesAt := now.Add(24 * time.Hour)
+	evt := &dto.EventResponse{
+		ID:          eventID,
+		Name:        "Backend Week",
+		StartsAt:    &eventStartsAt,
+		EndsAt:      &eventEndsAt,
+		CFPOpensAt:  &cfpOpensAt,
+		CFPClosesAt: &cfpClosesAt,
+	}
+	quota := &dto.ProposalQuotaResponse{UserID: userID, Quota: 1}
 
 	ctx := context.WithValue(context.Background(), "user.id", userID)
 
@@ -49,6 +78,8 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:         roomID,
+			EventID:        eventID,
 			Title:          "Test Conference",
 			Description:    "Test Description",
 			SpeakerName:    "Test Speaker",
@@ -61,20 +92,25 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		}
 
 		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return([]entity.Conference{}, dto.LazyLoadResponse{}, nil)
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
 
 		// Mock checking for time conflicts
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, req.StartsAt, req.EndsAt, uuid.Nil).
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
 			Return([]entity.Conference{}, nil)
 
 		// Mock UUID generation
@@ -96,7 +132,12 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 				StartsAt:       req.StartsAt,
 				EndsAt:         req.EndsAt,
 				HostID:         userID,
+				RoomID:         roomID,
+				EventID:        eventID,
 				Status:         enum.ConferencePending,
+				Speakers: []entity.ConferenceSpeaker{
+					{ConferenceID: conferenceID, Position: 0, Name: &req.SpeakerName, Title: &req.SpeakerTitle},
+				},
 			}).
 			Return(nil)
 
@@ -105,6 +146,69 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		assert.Equal(t, conferenceID, resultID)
 	})
 
+	t.Run("success - with linked and guest speakers", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		coSpeakerID := uuid.New()
+		guestName := "Guest Speaker"
+		guestTitle := "CTO"
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+			Speakers: []dto.ConferenceSpeakerRequest{
+				{UserID: &coSpeakerID},
+				{Name: &guestName, Title: &guestTitle},
+			},
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(conferenceID, nil)
+
+		coSpeaker := &entity.User{ID: coSpeakerID, Name: "Linked Speaker"}
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, coSpeakerID).
+			Return(coSpeaker, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			CreateConference(ctx, mock.MatchedBy(func(conference *entity.Conference) bool {
+				// The first speaker becomes the headline one
+				return assert.Equal(t, []entity.ConferenceSpeaker{
+					{ConferenceID: conferenceID, Position: 0, UserID: &coSpeakerID, User: coSpeaker},
+					{ConferenceID: conferenceID, Position: 1, Name: &guestName, Title: &guestTitle},
+				}, conference.Speakers) &&
+					conference.SpeakerName == "Linked Speaker" && conference.SpeakerTitle == ""
+			})).
+			Return(nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, conferenceID, resultID)
+	})
+
 	t.Run("error - missing user ID in context", func(t *testing.T) {
 		svc, _ := setupConferenceServiceTest(t)
 
@@ -114,10 +218,207 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - user has active proposal", func(t *testing.T) {
+	t.Run("error - speaker listed twice", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		coSpeakerID := uuid.New()
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+			Speakers: []dto.ConferenceSpeakerRequest{
+				{UserID: &coSpeakerID},
+				{UserID: &coSpeakerID},
+			},
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(conferenceID, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, coSpeakerID).
+			Return(&entity.User{ID: coSpeakerID}, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrSpeakerListedTwice)
+	})
+
+	t.Run("error - speaker user not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		coSpeakerID := uuid.New()
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+			Speakers: []dto.ConferenceSpeakerRequest{{UserID: &coSpeakerID}},
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(conferenceID, nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, coSpeakerID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrSpeakerUserNotFound)
+	})
+
+	t.Run("error - speaker with both user and name", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		coSpeakerID := uuid.New()
+		name := "Someone Else"
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+			Speakers: []dto.ConferenceSpeakerRequest{{UserID: &coSpeakerID, Name: &name}},
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(conferenceID, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidSpeaker)
+	})
+
+	t.Run("error - active proposal quota reached", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+		}
+
+		existingConferences := []entity.Conference{
+			{
+				ID:        uuid.New(),
+				Title:     "Existing Conference",
+				Status:    enum.ConferencePending,
+				CreatedAt: now.Add(-2 * time.Hour),
+			},
+			{
+				ID:        uuid.New(),
+				Title:     "Another Conference",
+				Status:    enum.ConferencePending,
+				CreatedAt: now.Add(-time.Hour),
+			},
+		}
+
+		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(&dto.ProposalQuotaResponse{UserID: userID, Quota: 2}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return(existingConferences, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrUserHasActiveProposal)
+		assert.Equal(t, map[string]interface{}{
+			"quota": 2,
+			"conferences": []dto.ConferenceResponse{
+				{
+					ID:        existingConferences[0].ID,
+					Title:     existingConferences[0].Title,
+					Status:    enum.ConferencePending,
+					CreatedAt: &existingConferences[0].CreatedAt,
+				},
+				{
+					ID:        existingConferences[1].ID,
+					Title:     existingConferences[1].Title,
+					Status:    enum.ConferencePending,
+					CreatedAt: &existingConferences[1].CreatedAt,
+				},
+			},
+		}, errorpkg.ErrUserHasActiveProposal.Detail)
+	})
+
+	t.Run("success - active proposals below quota", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
 			StartsAt: futureTime,
 			EndsAt:   laterTime,
 		}
@@ -126,45 +427,80 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 			ID:        uuid.New(),
 			Title:     "Existing Conference",
 			Status:    enum.ConferencePending,
-			CreatedAt: time.Now(),
+			CreatedAt: now.Add(-time.Hour),
 		}
 
 		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(&dto.ProposalQuotaResponse{UserID: userID, Quota: 2}, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return([]entity.Conference{existingConference}, dto.LazyLoadResponse{}, nil)
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{existingConference}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(conferenceID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			CreateConference(ctx, mock.AnythingOfType("*entity.Conference")).
+			Return(nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, conferenceID, resultID)
+	})
+
+	t.Run("error - get proposal quota fails", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(nil, errorpkg.ErrInternalServer)
 
 		resultID, err := svc.CreateConferenceProposal(ctx, req)
 		assert.Equal(t, uuid.Nil, resultID)
-		assert.ErrorIs(t, err, errorpkg.ErrUserHasActiveProposal)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
 	t.Run("error - time window conflict", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
 			StartsAt: futureTime,
 			EndsAt:   laterTime,
 		}
 
 		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return([]entity.Conference{}, dto.LazyLoadResponse{}, nil)
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
 
 		conflictingConference := entity.Conference{
 			ID:          uuid.New(),
@@ -173,9 +509,17 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 			EndsAt:      laterTime,
 		}
 
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
+
 		// Mock checking for time conflicts
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, req.StartsAt, req.EndsAt, uuid.Nil).
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
 			Return([]entity.Conference{conflictingConference}, nil)
 
 		resultID, err := svc.CreateConferenceProposal(ctx, req)
@@ -187,25 +531,32 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
 			StartsAt: futureTime,
 			EndsAt:   laterTime,
 		}
 
 		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return([]entity.Conference{}, dto.LazyLoadResponse{}, nil)
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
 
 		// Mock checking for time conflicts
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, req.StartsAt, req.EndsAt, uuid.Nil).
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
 			Return([]entity.Conference{}, nil)
 
 		// Mock UUID generation failure
@@ -222,25 +573,32 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
 			StartsAt: now.Add(-24 * time.Hour), // Past time
 			EndsAt:   laterTime,
 		}
 
 		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return([]entity.Conference{}, dto.LazyLoadResponse{}, nil)
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
 
 		// Mock checking for time conflicts
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, req.StartsAt, req.EndsAt, uuid.Nil).
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
 			Return([]entity.Conference{}, nil)
 
 		// Mock UUID generation
@@ -257,25 +615,32 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
 			StartsAt: laterTime,
 			EndsAt:   futureTime, // Before start time
 		}
 
 		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return([]entity.Conference{}, dto.LazyLoadResponse{}, nil)
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
 
 		// Mock checking for time conflicts
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, req.StartsAt, req.EndsAt, uuid.Nil).
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
 			Return([]entity.Conference{}, nil)
 
 		// Mock UUID generation
@@ -288,10 +653,55 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		assert.ErrorIs(t, err, errorpkg.ErrEndTimeBeforeStart)
 	})
 
+	t.Run("error - cancellation cutoff after start time", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:             roomID,
+			EventID:            eventID,
+			StartsAt:           futureTime,
+			EndsAt:             laterTime,
+			CancellationCutoff: &laterTime, // After start time
+		}
+
+		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
+
+		// Mock checking for time conflicts
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
+			Return([]entity.Conference{}, nil)
+
+		// Mock UUID generation
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(conferenceID, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrCancellationCutoffAfterStart)
+	})
+
 	t.Run("error - conference creation fails", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:         roomID,
+			EventID:        eventID,
 			Title:          "Test Conference",
 			Description:    "Test Description",
 			SpeakerName:    "Test Speaker",
@@ -304,20 +714,25 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		}
 
 		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return([]entity.Conference{}, dto.LazyLoadResponse{}, nil)
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
 
 		// Mock checking for time conflicts
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, req.StartsAt, req.EndsAt, uuid.Nil).
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
 			Return([]entity.Conference{}, nil)
 
 		// Mock UUID generation
@@ -339,7 +754,12 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 				StartsAt:       req.StartsAt,
 				EndsAt:         req.EndsAt,
 				HostID:         userID,
+				RoomID:         roomID,
+				EventID:        eventID,
 				Status:         enum.ConferencePending,
+				Speakers: []entity.ConferenceSpeaker{
+					{ConferenceID: conferenceID, Position: 0, Name: &req.SpeakerName, Title: &req.SpeakerTitle},
+				},
 			}).
 			Return(errors.New("database error"))
 
@@ -348,98 +768,355 @@ func Test_ConferenceService_CreateConferenceProposal(t *testing.T) {
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - get conferences fails", func(t *testing.T) {
+	t.Run("error - get active proposals fails", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
 			StartsAt: futureTime,
 			EndsAt:   laterTime,
 		}
 
 		// Mock checking for active proposals fails
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return(nil, dto.LazyLoadResponse{}, errors.New("database error"))
+			GetActiveProposalsByHost(ctx, userID).
+			Return(nil, errors.New("database error"))
 
 		resultID, err := svc.CreateConferenceProposal(ctx, req)
 		assert.Equal(t, uuid.Nil, resultID)
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
 	t.Run("error - get conflicting conferences fails", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
 			StartsAt: futureTime,
 			EndsAt:   laterTime,
 		}
 
 		// Mock checking for active proposals
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferences(ctx, &dto.GetConferenceQuery{
-				Limit:       1,
-				HostID:      &userID,
-				Status:      enum.ConferencePending,
-				IncludePast: false,
-				OrderBy:     "created_at",
-				Order:       "desc",
-			}).
-			Return([]entity.Conference{}, dto.LazyLoadResponse{}, nil)
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
 
 		// Mock checking for time conflicts fails
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, req.StartsAt, req.EndsAt, uuid.Nil).
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, uuid.Nil).
 			Return(nil, errors.New("database error"))
 
 		resultID, err := svc.CreateConferenceProposal(ctx, req)
 		assert.Equal(t, uuid.Nil, resultID)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
-}
 
-func Test_ConferenceService_GetConferenceByID(t *testing.T) {
-	ctx := context.Background()
-	conferenceID := uuid.New()
-	userID := uuid.New()
-	now := time.Now()
+	t.Run("error - room not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
 
-	conference := &entity.Conference{
-		ID:             conferenceID,
-		Title:          "Test Conference",
-		Description:    "Test Description",
-		SpeakerName:    "Test Speaker",
-		SpeakerTitle:   "Test Title",
-		TargetAudience: "Test Audience",
-		Prerequisites:  nil,
-		Seats:          100,
-		StartsAt:       now.Add(24 * time.Hour),
-		EndsAt:         now.Add(48 * time.Hour),
-		HostID:         userID,
-		Status:         enum.ConferenceApproved,
-		CreatedAt:      now,
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - seats exceed room capacity", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    room.Capacity + 1,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(room, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrSeatsExceedRoomCapacity)
+	})
+
+	t.Run("error - event not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - CFP closed", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+		}
+
+		closedAt := now.Add(-time.Hour)
+		closed := *evt
+		closed.CFPClosesAt = &closedAt
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&closed, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrCFPClosed)
+	})
+
+	t.Run("error - outside event dates", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: eventEndsAt.Add(-time.Hour),
+			EndsAt:   eventEndsAt.Add(time.Hour),
+		}
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrOutsideEventDates)
+	})
+
+	t.Run("error - CFP not open yet", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+		}
+
+		opensAt := now.Add(time.Hour)
+		upcoming := *evt
+		upcoming.CFPOpensAt = &opensAt
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&upcoming, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrCFPNotOpenYet)
+	})
+
+	t.Run("error - lead time too short", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		req := &dto.CreateConferenceProposalRequest{
+			RoomID:   roomID,
+			EventID:  eventID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   laterTime,
+		}
+
+		// Proposals must come in two days ahead, the conference starts tomorrow
+		leadHours := 48
+		strict := *evt
+		strict.CFPMinLeadHours = &leadHours
+
+		mocks.userSvc.EXPECT().
+			GetProposalQuota(ctx, userID).
+			Return(quota, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetActiveProposalsByHost(ctx, userID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&strict, nil)
+
+		resultID, err := svc.CreateConferenceProposal(ctx, req)
+		assert.Equal(t, uuid.Nil, resultID)
+		assert.ErrorIs(t, err, errorpkg.ErrCFPLeadTimeTooShort)
+	})
+}
+
+func Test_ConferenceService_GetConferenceByID(t *testing.T) {
+	ctx := context.Background()
+	conferenceID := uuid.New()
+	userID := uuid.New()
+	now := time.Now()
+
+	conference := &entity.Conference{
+		ID:             conferenceID,
+		Title:          "Test Conference",
+		Description:    "Test Description",
+		SpeakerName:    "Test Speaker",
+		SpeakerTitle:   "Test Title",
+		TargetAudience: "Test Audience",
+		Prerequisites:  nil,
+		Seats:          100,
+		StartsAt:       now.Add(24 * time.Hour),
+		EndsAt:         now.Add(48 * time.Hour),
+		HostID:         userID,
+		Status:         enum.ConferenceApproved,
+		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
 
 	t.Run("success - admin role", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
-		ctx := context.WithValue(context.WithValue(ctx, "user.id", userID), "user.role", enum.RoleAdmin)
+		ctx := context.WithValue(context.WithValue(ctx, "user.id", uuid.New()), "user.role", enum.RoleAdmin)
 
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
+		speakerID := uuid.New()
+		speakerBio := "Backend engineer"
+		guestName := "Guest Speaker"
+		guestTitle := "CTO"
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return([]entity.ConferenceSpeaker{
+				{
+					ConferenceID: conferenceID,
+					Position:     0,
+					UserID:       &speakerID,
+					User:         &entity.User{ID: speakerID, Name: "Linked Speaker", Bio: &speakerBio},
+				},
+				{
+					ConferenceID: conferenceID,
+					Position:     1,
+					Name:         &guestName,
+					Title:        &guestTitle,
+				},
+			}, nil)
+
 		result, err := svc.GetConferenceByID(ctx, conferenceID)
 		assert.NoError(t, err)
 		assert.Equal(t, conference.ID, result.ID)
 		assert.Equal(t, conference.Title, result.Title)
 		assert.Equal(t, conference.Status, result.Status)
+		assert.Equal(t, []dto.ConferenceSpeakerResponse{
+			{
+				User:  &dto.UserResponse{ID: speakerID, Name: "Linked Speaker"},
+				Name:  "Linked Speaker",
+				Bio:   &speakerBio,
+				Order: 0,
+			},
+			{
+				Name:  guestName,
+				Title: &guestTitle,
+				Order: 1,
+			},
+		}, result.Speakers)
 	})
 
 	t.Run("success - host user", func(t *testing.T) {
@@ -450,9 +1127,90 @@ func Test_ConferenceService_GetConferenceByID(t *testing.T) {
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetLatestReview(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
 		result, err := svc.GetConferenceByID(ctx, conferenceID)
 		assert.NoError(t, err)
 		assert.Equal(t, conference.ID, result.ID)
+		assert.Nil(t, result.LatestReview)
+	})
+
+	t.Run("success - host user sees latest review", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(ctx, "user.id", userID), "user.role", enum.RoleUser)
+
+		reason := enum.RejectionDuplicate
+		review := &entity.ConferenceReview{
+			ID:           uuid.New(),
+			ConferenceID: conferenceID,
+			ReviewerID:   uuid.New(),
+			Status:       enum.ConferenceRejected,
+			Reason:       &reason,
+			CreatedAt:    now,
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetLatestReview(ctx, conferenceID).
+			Return(review, nil)
+
+		result, err := svc.GetConferenceByID(ctx, conferenceID)
+		assert.NoError(t, err)
+		assert.NotNil(t, result.LatestReview)
+		assert.Equal(t, review.ID, result.LatestReview.ID)
+		assert.Equal(t, &reason, result.LatestReview.Reason)
+		assert.Nil(t, result.LatestReview.Reviewer)
+	})
+
+	t.Run("error - get speakers fails", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(ctx, "user.id", userID), "user.role", enum.RoleUser)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return(nil, errors.New("repository error"))
+
+		result, err := svc.GetConferenceByID(ctx, conferenceID)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - get latest review fails", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(ctx, "user.id", userID), "user.role", enum.RoleUser)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetLatestReview(ctx, conferenceID).
+			Return(nil, errors.New("repository error"))
+
+		result, err := svc.GetConferenceByID(ctx, conferenceID)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
 	t.Run("success - non-host user accessing approved conference", func(t *testing.T) {
@@ -464,11 +1222,98 @@ func Test_ConferenceService_GetConferenceByID(t *testing.T) {
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
 		result, err := svc.GetConferenceByID(ctx, conferenceID)
 		assert.NoError(t, err)
 		assert.Equal(t, conference.ID, result.ID)
 	})
 
+	t.Run("success - registrant sees why a conference was cancelled", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		otherUserID := uuid.New()
+		ctx := context.WithValue(context.WithValue(ctx, "user.id", otherUserID), "user.role", enum.RoleUser)
+
+		cancelledConference := *conference
+		cancelledConference.Status = enum.ConferenceCancelled
+
+		notes := "The speaker is ill."
+		review := &entity.ConferenceReview{
+			ID:           uuid.New(),
+			ConferenceID: conferenceID,
+			ReviewerID:   userID,
+			Status:       enum.ConferenceCancelled,
+			Notes:        &notes,
+			CreatedAt:    now,
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&cancelledConference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, otherUserID).
+			Return(true, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetLatestReview(ctx, conferenceID).
+			Return(review, nil)
+
+		result, err := svc.GetConferenceByID(ctx, conferenceID)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.ConferenceCancelled, result.Status)
+		assert.Equal(t, &notes, result.CancellationReason)
+		assert.Nil(t, result.LatestReview)
+	})
+
+	t.Run("error - non-registrant accessing cancelled conference", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		otherUserID := uuid.New()
+		ctx := context.WithValue(context.WithValue(ctx, "user.id", otherUserID), "user.role", enum.RoleUser)
+
+		cancelledConference := *conference
+		cancelledConference.Status = enum.ConferenceCancelled
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&cancelledConference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, otherUserID).
+			Return(false, nil)
+
+		result, err := svc.GetConferenceByID(ctx, conferenceID)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
+	})
+
+	t.Run("error - check registration fails", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		otherUserID := uuid.New()
+		ctx := context.WithValue(context.WithValue(ctx, "user.id", otherUserID), "user.role", enum.RoleUser)
+
+		cancelledConference := *conference
+		cancelledConference.Status = enum.ConferenceCancelled
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&cancelledConference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, otherUserID).
+			Return(false, errors.New("repository error"))
+
+		result, err := svc.GetConferenceByID(ctx, conferenceID)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
 	t.Run("error - non-host user accessing pending conference", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 		otherUserID := uuid.New()
@@ -565,6 +1410,10 @@ func Test_ConferenceService_GetConferences(t *testing.T) {
 			GetConferences(ctx, query).
 			Return(conferences, dto.LazyLoadResponse{HasMore: false}, nil)
 
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferences[0].ID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
 		result, lazy, err := svc.GetConferences(ctx, query)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedResponse, result)
@@ -597,20 +1446,62 @@ func Test_ConferenceService_GetConferences(t *testing.T) {
 			GetConferences(ctx, query).
 			Return(conferences, dto.LazyLoadResponse{HasMore: false}, nil)
 
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferences[0].ID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
 		result, _, err := svc.GetConferences(ctx, query)
 		assert.NoError(t, err)
 		assert.Len(t, result, 1)
 	})
 
-	t.Run("error - pagination includes beforeID and afterID", func(t *testing.T) {
+	t.Run("success - user role viewing cancelled conferences only sees their own", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		ctx = context.WithValue(ctx, "user.id", userID)
 		ctx = context.WithValue(ctx, "user.role", enum.RoleUser)
 
 		query := &dto.GetConferenceQuery{
-			Limit:    10,
-			Status:   enum.ConferenceApproved,
+			Limit:  10,
+			Status: enum.ConferenceCancelled,
+			HostID: &otherUserID,
+		}
+
+		conferences := []entity.Conference{
+			{
+				ID:     uuid.New(),
+				Title:  "Test Conference 1",
+				Status: enum.ConferenceCancelled,
+				HostID: otherUserID,
+			},
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferences(ctx, mock.MatchedBy(func(q *dto.GetConferenceQuery) bool {
+				return q.HostOrRegistrantID != nil && *q.HostOrRegistrantID == userID &&
+					q.HostID != nil && *q.HostID == otherUserID
+			})).
+			Return(conferences, dto.LazyLoadResponse{HasMore: false}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferences[0].ID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
+		result, _, err := svc.GetConferences(ctx, query)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Nil(t, result[0].WaitlistLength)
+	})
+
+	t.Run("error - pagination includes beforeID and afterID", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		ctx = context.WithValue(ctx, "user.id", userID)
+		ctx = context.WithValue(ctx, "user.role", enum.RoleUser)
+
+		query := &dto.GetConferenceQuery{
+			Limit:    10,
+			Status:   enum.ConferenceApproved,
 			BeforeID: &uuid.Nil,
 			AfterID:  &uuid.Nil,
 		}
@@ -666,6 +1557,33 @@ func Test_ConferenceService_GetConferences(t *testing.T) {
 		assert.Equal(t, dto.LazyLoadResponse{}, lazy)
 	})
 
+	t.Run("error - get speakers fails", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		ctx = context.WithValue(ctx, "user.id", userID)
+		ctx = context.WithValue(ctx, "user.role", enum.RoleUser)
+
+		query := &dto.GetConferenceQuery{
+			Limit:  10,
+			Status: enum.ConferenceApproved,
+		}
+
+		conferences := []entity.Conference{{ID: uuid.New(), Status: enum.ConferenceApproved}}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferences(ctx, query).
+			Return(conferences, dto.LazyLoadResponse{}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferences[0].ID}).
+			Return(nil, errors.New("repository error"))
+
+		result, lazy, err := svc.GetConferences(ctx, query)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+		assert.Empty(t, result)
+		assert.Equal(t, dto.LazyLoadResponse{}, lazy)
+	})
+
 	t.Run("success - system role (no role in context)", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
@@ -689,6 +1607,10 @@ func Test_ConferenceService_GetConferences(t *testing.T) {
 			GetConferences(ctx, query).
 			Return(conferences, dto.LazyLoadResponse{HasMore: false}, nil)
 
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferences[0].ID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
 		result, _, err := svc.GetConferences(ctx, query)
 		assert.NoError(t, err)
 		assert.Len(t, result, 1)
@@ -721,6 +1643,10 @@ func Test_ConferenceService_GetConferences(t *testing.T) {
 			GetConferences(ctx, query).
 			Return(conferences, dto.LazyLoadResponse{HasMore: false}, nil)
 
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferences[0].ID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
 		result, _, err := svc.GetConferences(ctx, query)
 		assert.NoError(t, err)
 		assert.Len(t, result, 1)
@@ -759,6 +1685,10 @@ func Test_ConferenceService_GetConferences(t *testing.T) {
 			GetConferences(ctx, expectedQuery).
 			Return(conferences, dto.LazyLoadResponse{HasMore: false}, nil)
 
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferences[0].ID}).
+			Return([]entity.ConferenceSpeaker{}, nil)
+
 		result, _, err := svc.GetConferences(ctx, query)
 		assert.NoError(t, err)
 		assert.Len(t, result, 1)
@@ -770,6 +1700,12 @@ func Test_ConferenceService_UpdateConference(t *testing.T) {
 	userID := uuid.New()
 	now := time.Now()
 	futureTime := now.Add(24 * time.Hour)
+	auditID := uuid.New()
+	roomID := uuid.New()
+	eventID := uuid.New()
+	eventStartsAt := now.Add(-30 * 24 * time.Hour)
+	eventEndsAt := now.Add(30 * 24 * time.Hour)
+	evt := &dto.EventResponse{ID: eventID, StartsAt: &eventStartsAt, EndsAt: &eventEndsAt}
 	ctx := context.WithValue(context.Background(), "user.id", userID)
 	ctx = context.WithValue(ctx, "user.role", enum.RoleUser)
 
@@ -796,14 +1732,154 @@ func Test_ConferenceService_UpdateConference(t *testing.T) {
 		updatedConference := *originalConference
 		updatedConference.Title = newTitle
 
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		// Only the changed field is recorded
+		mocks.conferenceRepo.EXPECT().
+			UpdateConference(ctx, &updatedConference, mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return audit.ID == auditID &&
+					audit.ConferenceID == conferenceID &&
+					*audit.ActorID == userID &&
+					audit.Action == enum.AuditConferenceUpdated &&
+					string(audit.PreviousValues) == `{"title":""}` &&
+					string(audit.NewValues) == `{"title":"Updated Title"}`
+			})).
+			Return(nil)
+
+		err := svc.UpdateConference(ctx, conferenceID, req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - update cancellation cutoff", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		originalConference := &entity.Conference{
+			ID:       conferenceID,
+			HostID:   userID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		cutoff := futureTime.Add(-time.Hour)
+		req := dto.UpdateConferenceRequest{
+			CancellationCutoff: &cutoff,
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(originalConference, nil)
+
+		updatedConference := *originalConference
+		updatedConference.CancellationCutoff = &cutoff
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			UpdateConference(ctx, &updatedConference, mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return string(audit.PreviousValues) == `{"cancellation_cutoff":null}`
+			})).
+			Return(nil)
+
+		err := svc.UpdateConference(ctx, conferenceID, req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - clear cancellation cutoff", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		cutoff := futureTime.Add(-time.Hour)
+		originalConference := &entity.Conference{
+			ID:                 conferenceID,
+			HostID:             userID,
+			StartsAt:           futureTime,
+			EndsAt:             futureTime.Add(time.Hour),
+			CancellationCutoff: &cutoff,
+			Status:             enum.ConferencePending,
+		}
+
+		req := dto.UpdateConferenceRequest{
+			ClearCancellationCutoff: true,
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(originalConference, nil)
+
+		updatedConference := *originalConference
+		updatedConference.CancellationCutoff = nil
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			UpdateConference(ctx, &updatedConference, mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return string(audit.NewValues) == `{"cancellation_cutoff":null}`
+			})).
+			Return(nil)
+
+		err := svc.UpdateConference(ctx, conferenceID, req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - nothing changed is not audited", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		originalConference := &entity.Conference{
+			ID:       conferenceID,
+			Title:    "Same Title",
+			HostID:   userID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		sameTitle := "Same Title"
+		req := dto.UpdateConferenceRequest{
+			Title: &sameTitle,
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(originalConference, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			UpdateConference(ctx, &updatedConference).
+			UpdateConference(ctx, originalConference, (*entity.ConferenceAudit)(nil)).
 			Return(nil)
 
 		err := svc.UpdateConference(ctx, conferenceID, req)
 		assert.NoError(t, err)
 	})
 
+	t.Run("error - cancellation cutoff after start time", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		originalConference := &entity.Conference{
+			ID:       conferenceID,
+			HostID:   userID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		cutoff := futureTime.Add(time.Minute)
+		req := dto.UpdateConferenceRequest{
+			CancellationCutoff: &cutoff,
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(originalConference, nil)
+
+		err := svc.UpdateConference(ctx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrCancellationCutoffAfterStart)
+	})
+
 	t.Run("error - conference not found", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
@@ -927,6 +2003,8 @@ func Test_ConferenceService_UpdateConference(t *testing.T) {
 		conference := &entity.Conference{
 			ID:       conferenceID,
 			HostID:   userID,
+			RoomID:   roomID,
+			EventID:  eventID,
 			StartsAt: futureTime,
 			EndsAt:   futureTime.Add(time.Hour),
 			Status:   enum.ConferencePending,
@@ -951,247 +2029,287 @@ func Test_ConferenceService_UpdateConference(t *testing.T) {
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, newStart, newEnd, conferenceID).
+			GetConferencesConflictingWithTime(ctx, roomID, newStart, newEnd, conferenceID).
 			Return([]entity.Conference{conflictingConference}, nil)
 
 		err := svc.UpdateConference(ctx, conferenceID, req)
 		assert.ErrorIs(t, err, errorpkg.ErrTimeWindowConflict)
 	})
 
-	t.Run("error - internal server error on get conference", func(t *testing.T) {
-		svc, mocks := setupConferenceServiceTest(t)
-
-		mocks.conferenceRepo.EXPECT().
-			GetConferenceByID(ctx, conferenceID).
-			Return(nil, errors.New("database error"))
-
-		err := svc.UpdateConference(ctx, conferenceID, dto.UpdateConferenceRequest{})
-		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
-	})
-
-	t.Run("error - internal server error on update", func(t *testing.T) {
+	t.Run("error - outside event dates", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		conference := &entity.Conference{
 			ID:       conferenceID,
 			HostID:   userID,
+			RoomID:   roomID,
+			EventID:  eventID,
 			StartsAt: futureTime,
 			EndsAt:   futureTime.Add(time.Hour),
 			Status:   enum.ConferencePending,
 		}
 
-		newTitle := "Updated Title"
+		newStart := eventEndsAt.Add(time.Hour)
+		newEnd := newStart.Add(time.Hour)
 		req := dto.UpdateConferenceRequest{
-			Title: &newTitle,
+			StartsAt: &newStart,
+			EndsAt:   &newEnd,
 		}
 
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		updatedConference := *conference
-		updatedConference.Title = newTitle
-
-		mocks.conferenceRepo.EXPECT().
-			UpdateConference(ctx, &updatedConference).
-			Return(errors.New("database error"))
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
 
 		err := svc.UpdateConference(ctx, conferenceID, req)
-		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+		assert.ErrorIs(t, err, errorpkg.ErrOutsideEventDates)
 	})
 
-	t.Run("error - internal server error on get conflicting conferences", func(t *testing.T) {
+	t.Run("error - moved inside the lead time", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
 		conference := &entity.Conference{
 			ID:       conferenceID,
 			HostID:   userID,
-			StartsAt: futureTime,
-			EndsAt:   futureTime.Add(time.Hour),
+			RoomID:   roomID,
+			EventID:  eventID,
+			StartsAt: futureTime.Add(72 * time.Hour),
+			EndsAt:   futureTime.Add(73 * time.Hour),
 			Status:   enum.ConferencePending,
 		}
 
-		newStart := futureTime.Add(2 * time.Hour)
+		newStart := futureTime
 		newEnd := newStart.Add(time.Hour)
 		req := dto.UpdateConferenceRequest{
 			StartsAt: &newStart,
 			EndsAt:   &newEnd,
 		}
 
+		leadHours := 48
+		strict := *evt
+		strict.CFPMinLeadHours = &leadHours
+
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, newStart, newEnd, conferenceID).
-			Return(nil, errors.New("database error"))
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&strict, nil)
 
 		err := svc.UpdateConference(ctx, conferenceID, req)
-		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+		assert.ErrorIs(t, err, errorpkg.ErrCFPLeadTimeTooShort)
 	})
-}
 
-func Test_ConferenceService_DeleteConference(t *testing.T) {
-	conferenceID := uuid.New()
-	userID := uuid.New()
-
-	t.Run("success - admin user", func(t *testing.T) {
+	t.Run("success - move to another room", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
-
-		// Set admin context
-		ctx := context.WithValue(context.WithValue(context.Background(),
-			"user.id", userID),
-			"user.role", enum.RoleAdmin)
+		newRoomID := uuid.New()
 
 		conference := &entity.Conference{
-			ID:     conferenceID,
-			HostID: uuid.New(), // Different from requester
-			Status: enum.ConferenceApproved,
+			ID:       conferenceID,
+			HostID:   userID,
+			RoomID:   roomID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		req := dto.UpdateConferenceRequest{
+			RoomID: &newRoomID,
 		}
 
-		// Expect conference retrieval
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		// Expect conference deletion
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, newRoomID).
+			Return(&dto.RoomResponse{ID: newRoomID, Capacity: 100}, nil)
+
+		// The conflict check follows the conference into its new room
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, newRoomID, conference.StartsAt, conference.EndsAt, conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			DeleteConference(ctx, conferenceID).
+			UpdateConference(ctx, mock.MatchedBy(func(c *entity.Conference) bool {
+				return c.RoomID == newRoomID
+			}), mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return strings.Contains(string(audit.NewValues), newRoomID.String())
+			})).
 			Return(nil)
 
-		err := svc.DeleteConference(ctx, conferenceID)
+		err := svc.UpdateConference(ctx, conferenceID, req)
 		assert.NoError(t, err)
 	})
 
-	t.Run("success - conference host", func(t *testing.T) {
+	t.Run("error - new room too small", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
-
-		// Set user context
-		ctx := context.WithValue(context.WithValue(context.Background(),
-			"user.id", userID),
-			"user.role", enum.RoleUser)
+		newRoomID := uuid.New()
 
 		conference := &entity.Conference{
-			ID:     conferenceID,
-			HostID: userID, // Same as requester
-			Status: enum.ConferenceApproved,
+			ID:       conferenceID,
+			HostID:   userID,
+			RoomID:   roomID,
+			Seats:    100,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
 		}
 
-		// Expect conference retrieval
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		// Expect conference deletion
-		mocks.conferenceRepo.EXPECT().
-			DeleteConference(ctx, conferenceID).
-			Return(nil)
+		mocks.roomSvc.EXPECT().
+			GetRoomByID(ctx, newRoomID).
+			Return(&dto.RoomResponse{ID: newRoomID, Capacity: 50}, nil)
 
-		err := svc.DeleteConference(ctx, conferenceID)
-		assert.NoError(t, err)
+		err := svc.UpdateConference(ctx, conferenceID, dto.UpdateConferenceRequest{RoomID: &newRoomID})
+		assert.ErrorIs(t, err, errorpkg.ErrSeatsExceedRoomCapacity)
 	})
 
-	t.Run("error - conference not found", func(t *testing.T) {
+	t.Run("error - internal server error on get conference", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
-		// Set user context
-		ctx := context.WithValue(context.WithValue(context.Background(),
-			"user.id", userID),
-			"user.role", enum.RoleUser)
-
-		// Expect conference retrieval to return not found
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
-			Return(nil, sql.ErrNoRows)
+			Return(nil, errors.New("database error"))
 
-		err := svc.DeleteConference(ctx, conferenceID)
-		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+		err := svc.UpdateConference(ctx, conferenceID, dto.UpdateConferenceRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - forbidden user", func(t *testing.T) {
+	t.Run("error - generate audit ID fails", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
-		// Set user context
-		ctx := context.WithValue(context.WithValue(context.Background(),
-			"user.id", userID),
-			"user.role", enum.RoleUser)
-
 		conference := &entity.Conference{
-			ID:     conferenceID,
-			HostID: uuid.New(), // Different from requester
-			Status: enum.ConferenceApproved,
+			ID:       conferenceID,
+			HostID:   userID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		newTitle := "Updated Title"
+		req := dto.UpdateConferenceRequest{
+			Title: &newTitle,
 		}
 
-		// Expect conference retrieval
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		err := svc.DeleteConference(ctx, conferenceID)
-		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.Nil, errors.New("uuid error"))
+
+		err := svc.UpdateConference(ctx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - get conference db error", func(t *testing.T) {
+	t.Run("error - internal server error on update", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
-		// Set user context
-		ctx := context.WithValue(context.WithValue(context.Background(),
-			"user.id", userID),
-			"user.role", enum.RoleUser)
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			HostID:   userID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		newTitle := "Updated Title"
+		req := dto.UpdateConferenceRequest{
+			Title: &newTitle,
+		}
 
-		// Expect conference retrieval to return error
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
-			Return(nil, errors.New("db error"))
+			Return(conference, nil)
 
-		err := svc.DeleteConference(ctx, conferenceID)
+		updatedConference := *conference
+		updatedConference.Title = newTitle
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			UpdateConference(ctx, &updatedConference, mock.AnythingOfType("*entity.ConferenceAudit")).
+			Return(errors.New("database error"))
+
+		err := svc.UpdateConference(ctx, conferenceID, req)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - delete conference db error", func(t *testing.T) {
+	t.Run("error - internal server error on get conflicting conferences", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
-		// Set user context
-		ctx := context.WithValue(context.WithValue(context.Background(),
-			"user.id", userID),
-			"user.role", enum.RoleUser)
-
 		conference := &entity.Conference{
-			ID:     conferenceID,
-			HostID: userID, // Same as requester
-			Status: enum.ConferenceApproved,
+			ID:       conferenceID,
+			HostID:   userID,
+			RoomID:   roomID,
+			EventID:  eventID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		newStart := futureTime.Add(2 * time.Hour)
+		newEnd := newStart.Add(time.Hour)
+		req := dto.UpdateConferenceRequest{
+			StartsAt: &newStart,
+			EndsAt:   &newEnd,
 		}
 
-		// Expect conference retrieval
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		// Expect conference deletion to fail
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
 		mocks.conferenceRepo.EXPECT().
-			DeleteConference(ctx, conferenceID).
-			Return(errors.New("db error"))
+			GetConferencesConflictingWithTime(ctx, roomID, newStart, newEnd, conferenceID).
+			Return(nil, errors.New("database error"))
 
-		err := svc.DeleteConference(ctx, conferenceID)
+		err := svc.UpdateConference(ctx, conferenceID, req)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 }
 
-func Test_ConferenceService_UpdateConferenceStatus(t *testing.T) {
-	ctx := context.Background()
+func Test_ConferenceService_DeleteConference(t *testing.T) {
 	conferenceID := uuid.New()
-	now := time.Now()
-	futureTime := now.Add(24 * time.Hour)
+	userID := uuid.New()
+	auditID := uuid.New()
 
-	t.Run("success - approve conference", func(t *testing.T) {
+	t.Run("success - admin user", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
+		// Set admin context
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", userID),
+			"user.role", enum.RoleAdmin)
+
 		conference := &entity.Conference{
-			ID:       conferenceID,
-			StartsAt: futureTime,
-			EndsAt:   futureTime.Add(time.Hour),
-			Status:   enum.ConferencePending,
+			ID:     conferenceID,
+			HostID: uuid.New(), // Different from requester
+			Status: enum.ConferencePending,
 		}
 
 		// Expect conference retrieval
@@ -1199,29 +2317,35 @@ func Test_ConferenceService_UpdateConferenceStatus(t *testing.T) {
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		// Expect conflict check
-		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, conference.StartsAt, conference.EndsAt, conferenceID).
-			Return([]entity.Conference{}, nil)
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
 
-		// Expect conference update
+		// Expect conference deletion, recorded as deleted_at being set
 		mocks.conferenceRepo.EXPECT().
-			UpdateConference(ctx, conference).
+			DeleteConference(ctx, conferenceID, mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return audit.Action == enum.AuditConferenceDeleted &&
+					string(audit.PreviousValues) == `{"deleted_at":null}` &&
+					strings.HasPrefix(string(audit.NewValues), `{"deleted_at":"`)
+			})).
 			Return(nil)
 
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceApproved)
+		err := svc.DeleteConference(ctx, conferenceID)
 		assert.NoError(t, err)
-		assert.Equal(t, enum.ConferenceApproved, conference.Status)
 	})
 
-	t.Run("success - reject conference", func(t *testing.T) {
+	t.Run("success - conference host", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
+		// Set user context
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", userID),
+			"user.role", enum.RoleUser)
+
 		conference := &entity.Conference{
-			ID:       conferenceID,
-			StartsAt: now.Add(-time.Hour), // Past conference can be rejected
-			EndsAt:   now,
-			Status:   enum.ConferencePending,
+			ID:     conferenceID,
+			HostID: userID, // Same as requester
+			Status: enum.ConferencePending,
 		}
 
 		// Expect conference retrieval
@@ -1229,48 +2353,52 @@ func Test_ConferenceService_UpdateConferenceStatus(t *testing.T) {
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		// Expect conference update
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		// Expect conference deletion, recorded as deleted_at being set
 		mocks.conferenceRepo.EXPECT().
-			UpdateConference(ctx, conference).
+			DeleteConference(ctx, conferenceID, mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return audit.Action == enum.AuditConferenceDeleted &&
+					string(audit.PreviousValues) == `{"deleted_at":null}` &&
+					strings.HasPrefix(string(audit.NewValues), `{"deleted_at":"`)
+			})).
 			Return(nil)
 
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceRejected)
+		err := svc.DeleteConference(ctx, conferenceID)
 		assert.NoError(t, err)
-		assert.Equal(t, enum.ConferenceRejected, conference.Status)
 	})
 
 	t.Run("error - conference not found", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
-		// Expect conference retrieval to fail
+		// Set user context
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", userID),
+			"user.role", enum.RoleUser)
+
+		// Expect conference retrieval to return not found
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
 			Return(nil, sql.ErrNoRows)
 
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceApproved)
+		err := svc.DeleteConference(ctx, conferenceID)
 		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
 	})
 
-	t.Run("error - database error on get conference", func(t *testing.T) {
+	t.Run("error - forbidden user", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
-		// Expect conference retrieval to fail
-		mocks.conferenceRepo.EXPECT().
-			GetConferenceByID(ctx, conferenceID).
-			Return(nil, errors.New("database error"))
-
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceApproved)
-		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
-	})
-
-	t.Run("error - conference status not pending", func(t *testing.T) {
-		svc, mocks := setupConferenceServiceTest(t)
+		// Set user context
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", userID),
+			"user.role", enum.RoleUser)
 
 		conference := &entity.Conference{
-			ID:       conferenceID,
-			StartsAt: futureTime,
-			EndsAt:   futureTime.Add(time.Hour),
-			Status:   enum.ConferenceApproved,
+			ID:     conferenceID,
+			HostID: uuid.New(), // Different from requester
+			Status: enum.ConferencePending,
 		}
 
 		// Expect conference retrieval
@@ -1278,18 +2406,22 @@ func Test_ConferenceService_UpdateConferenceStatus(t *testing.T) {
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceRejected)
-		assert.ErrorIs(t, err, errorpkg.ErrUpdateNotPendingConference)
+		err := svc.DeleteConference(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
 	})
 
-	t.Run("error - past conference approval", func(t *testing.T) {
+	t.Run("error - approved conference", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
+		// Set user context
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", userID),
+			"user.role", enum.RoleUser)
+
 		conference := &entity.Conference{
-			ID:       conferenceID,
-			StartsAt: now.Add(-time.Hour), // Past conference
-			EndsAt:   now,
-			Status:   enum.ConferencePending,
+			ID:     conferenceID,
+			HostID: userID, // Same as requester
+			Status: enum.ConferenceApproved,
 		}
 
 		// Expect conference retrieval
@@ -1297,49 +2429,39 @@ func Test_ConferenceService_UpdateConferenceStatus(t *testing.T) {
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceApproved)
-		assert.ErrorIs(t, err, errorpkg.ErrUpdatePastConferenceStatus)
+		err := svc.DeleteConference(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrDeleteApprovedConference)
 	})
 
-	t.Run("error - time window conflict", func(t *testing.T) {
+	t.Run("error - get conference db error", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
-		conference := &entity.Conference{
-			ID:       conferenceID,
-			StartsAt: futureTime,
-			EndsAt:   futureTime.Add(time.Hour),
-			Status:   enum.ConferencePending,
-		}
-
-		conflictingConference := entity.Conference{
-			ID:          uuid.New(),
-			Description: "Conflicting Conference",
-			StartsAt:    futureTime,
-			EndsAt:      futureTime.Add(time.Hour),
-		}
+		// Set user context
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", userID),
+			"user.role", enum.RoleUser)
 
-		// Expect conference retrieval
+		// Expect conference retrieval to return error
 		mocks.conferenceRepo.EXPECT().
 			GetConferenceByID(ctx, conferenceID).
-			Return(conference, nil)
-
-		// Expect conflict check to find conflicts
-		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, conference.StartsAt, conference.EndsAt, conferenceID).
-			Return([]entity.Conference{conflictingConference}, nil)
+			Return(nil, errors.New("db error"))
 
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceApproved)
-		assert.ErrorIs(t, err, errorpkg.ErrTimeWindowConflict)
+		err := svc.DeleteConference(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 
-	t.Run("error - database error on conflict check", func(t *testing.T) {
+	t.Run("error - delete conference db error", func(t *testing.T) {
 		svc, mocks := setupConferenceServiceTest(t)
 
+		// Set user context
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", userID),
+			"user.role", enum.RoleUser)
+
 		conference := &entity.Conference{
-			ID:       conferenceID,
-			StartsAt: futureTime,
-			EndsAt:   futureTime.Add(time.Hour),
-			Status:   enum.ConferencePending,
+			ID:     conferenceID,
+			HostID: userID, // Same as requester
+			Status: enum.ConferencePending,
 		}
 
 		// Expect conference retrieval
@@ -1347,41 +2469,1644 @@ func Test_ConferenceService_UpdateConferenceStatus(t *testing.T) {
 			GetConferenceByID(ctx, conferenceID).
 			Return(conference, nil)
 
-		// Expect conflict check to fail
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		// Expect conference deletion to fail
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, conference.StartsAt, conference.EndsAt, conferenceID).
-			Return(nil, errors.New("database error"))
+			DeleteConference(ctx, conferenceID, mock.AnythingOfType("*entity.ConferenceAudit")).
+			Return(errors.New("db error"))
 
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceApproved)
+		err := svc.DeleteConference(ctx, conferenceID)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
+}
 
-	t.Run("error - database error on update", func(t *testing.T) {
-		svc, mocks := setupConferenceServiceTest(t)
+func Test_ConferenceService_CancelConference(t *testing.T) {
+	hostID := uuid.New()
+	conferenceID := uuid.New()
+	reviewID := uuid.New()
+	auditID := uuid.New()
+	now := time.Now()
+	req := dto.CancelConferenceRequest{Reason: "The speaker is ill."}
 
-		conference := &entity.Conference{
+	hostCtx := context.WithValue(context.WithValue(context.Background(),
+		"user.id", hostID),
+		"user.role", enum.RoleUser)
+
+	newConference := func() *entity.Conference {
+		return &entity.Conference{
 			ID:       conferenceID,
-			StartsAt: futureTime,
-			EndsAt:   futureTime.Add(time.Hour),
-			Status:   enum.ConferencePending,
+			HostID:   hostID,
+			StartsAt: now.Add(24 * time.Hour),
+			EndsAt:   now.Add(26 * time.Hour),
+			Status:   enum.ConferenceApproved,
 		}
+	}
 
-		// Expect conference retrieval
-		mocks.conferenceRepo.EXPECT().
-			GetConferenceByID(ctx, conferenceID).
-			Return(conference, nil)
+	t.Run("success - conference host", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
 
-		// Expect conflict check
 		mocks.conferenceRepo.EXPECT().
-			GetConferencesConflictingWithTime(ctx, conference.StartsAt, conference.EndsAt, conferenceID).
-			Return([]entity.Conference{}, nil)
+			GetConferenceByID(hostCtx, conferenceID).
+			Return(conference, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(reviewID, nil).Once()
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil).Once()
+
+		// Expect the cancellation to be kept with its reason
+		mocks.conferenceRepo.EXPECT().
+			ReviewConference(hostCtx, conference, mock.MatchedBy(func(review *entity.ConferenceReview) bool {
+				return review.ID == reviewID &&
+					review.ReviewerID == hostID &&
+					review.Status == enum.ConferenceCancelled &&
+					review.Reason == nil &&
+					*review.Notes == req.Reason
+			}), mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return audit.Action == enum.AuditConferenceStatusChanged &&
+					string(audit.PreviousValues) == `{"status":"approved"}` &&
+					string(audit.NewValues) == `{"status":"cancelled"}`
+			})).
+			Return(nil)
+
+		// Expect registrants to be told, with the reason
+		mocks.eventBus.EXPECT().
+			Publish(hostCtx, mock.MatchedBy(func(e event.ConferenceCancelled) bool {
+				return e.Conference.ID == conferenceID && e.Reason == req.Reason
+			})).
+			Return()
+
+		err := svc.CancelConference(hostCtx, conferenceID, req)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.ConferenceCancelled, conference.Status)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(hostCtx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.CancelConference(hostCtx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - forbidden user", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", uuid.New()),
+			"user.role", enum.RoleUser)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		err := svc.CancelConference(ctx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
+	})
+
+	t.Run("error - conference not approved", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+		conference.Status = enum.ConferencePending
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(hostCtx, conferenceID).
+			Return(conference, nil)
+
+		err := svc.CancelConference(hostCtx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrCancelNotApprovedConference)
+	})
+
+	t.Run("error - conference started", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+		conference.StartsAt = now.Add(-time.Hour)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(hostCtx, conferenceID).
+			Return(conference, nil)
+
+		err := svc.CancelConference(hostCtx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrCancelStartedConference)
+	})
+
+	t.Run("error - cancel conference db error", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(hostCtx, conferenceID).
+			Return(conference, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(reviewID, nil).Once()
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil).Once()
+
+		mocks.conferenceRepo.EXPECT().
+			ReviewConference(hostCtx, conference, mock.AnythingOfType("*entity.ConferenceReview"),
+				mock.AnythingOfType("*entity.ConferenceAudit")).
+			Return(errors.New("db error"))
+
+		err := svc.CancelConference(hostCtx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_ConferenceService_UpdateConferenceStatus(t *testing.T) {
+	reviewerID := uuid.New()
+	ctx := context.WithValue(context.Background(), "user.id", reviewerID)
+	conferenceID := uuid.New()
+	reviewID := uuid.New()
+	auditID := uuid.New()
+	now := time.Now()
+	futureTime := now.Add(24 * time.Hour)
+
+	t.Run("success - approve conference", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		reason := enum.RejectionOther
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		// Expect conference retrieval
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		// Expect conflict check
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, conference.RoomID, conference.StartsAt, conference.EndsAt,
+				conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(reviewID, nil).Once()
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil).Once()
+
+		// Expect conference update with its review
+		mocks.conferenceRepo.EXPECT().
+			ReviewConference(ctx, conference, mock.MatchedBy(func(review *entity.ConferenceReview) bool {
+				return review.ID == reviewID &&
+					review.ConferenceID == conferenceID &&
+					review.ReviewerID == reviewerID &&
+					review.Status == enum.ConferenceApproved &&
+					review.Reason == nil
+			}), mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return audit.Action == enum.AuditConferenceStatusChanged &&
+					string(audit.PreviousValues) == `{"status":"pending"}` &&
+					string(audit.NewValues) == `{"status":"approved"}`
+			})).
+			Return(nil)
+
+		// Expect host to be told
+		mocks.eventBus.EXPECT().
+			Publish(ctx, mock.AnythingOfType("event.ConferenceApproved")).
+			Return()
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{
+			Status: enum.ConferenceApproved,
+			// A reason is dropped when approving
+			Reason: &reason,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, enum.ConferenceApproved, conference.Status)
+	})
+
+	t.Run("success - reject conference", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		reason := enum.RejectionInsufficientDetail
+		notes := "Please describe the hands-on part."
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			StartsAt: now.Add(-time.Hour), // Past conference can be rejected
+			EndsAt:   now,
+			Status:   enum.ConferencePending,
+		}
+
+		// Expect conference retrieval
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(reviewID, nil).Once()
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil).Once()
+
+		// Expect conference update with its review
+		mocks.conferenceRepo.EXPECT().
+			ReviewConference(ctx, conference, mock.MatchedBy(func(review *entity.ConferenceReview) bool {
+				return review.Status == enum.ConferenceRejected &&
+					*review.Reason == enum.RejectionInsufficientDetail &&
+					*review.Notes == notes
+			}), mock.AnythingOfType("*entity.ConferenceAudit")).
+			Return(nil)
+
+		// Expect host to be told, with the reason
+		mocks.eventBus.EXPECT().
+			Publish(ctx, mock.MatchedBy(func(e event.ConferenceRejected) bool {
+				return *e.Review.Reason == enum.RejectionInsufficientDetail
+			})).
+			Return()
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{
+			Status: enum.ConferenceRejected,
+			Reason: &reason,
+			Notes:  &notes,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, enum.ConferenceRejected, conference.Status)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		// Expect conference retrieval to fail
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{Status: enum.ConferenceApproved})
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - database error on get conference", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		// Expect conference retrieval to fail
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, errors.New("database error"))
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{Status: enum.ConferenceApproved})
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - coordinator of another event", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		eventID := uuid.New()
+		coordinatorCtx := context.WithValue(ctx, "user.role", enum.RoleEventCoordinator)
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			EventID:  eventID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(coordinatorCtx, conferenceID).
+			Return(conference, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(coordinatorCtx, eventID, reviewerID).
+			Return(false, nil)
+
+		err := svc.UpdateConferenceStatus(coordinatorCtx, conferenceID,
+			dto.UpdateConferenceStatusRequest{Status: enum.ConferenceApproved})
+		assert.ErrorIs(t, err, errorpkg.ErrNotEventCoordinator)
+	})
+
+	t.Run("error - conference status not pending", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferenceApproved,
+		}
+
+		// Expect conference retrieval
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{Status: enum.ConferenceRejected})
+		assert.ErrorIs(t, err, errorpkg.ErrUpdateNotPendingConference)
+	})
+
+	t.Run("error - past conference approval", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			StartsAt: now.Add(-time.Hour), // Past conference
+			EndsAt:   now,
+			Status:   enum.ConferencePending,
+		}
+
+		// Expect conference retrieval
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{Status: enum.ConferenceApproved})
+		assert.ErrorIs(t, err, errorpkg.ErrUpdatePastConferenceStatus)
+	})
+
+	t.Run("error - time window conflict", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		conflictingConference := entity.Conference{
+			ID:          uuid.New(),
+			Description: "Conflicting Conference",
+			StartsAt:    futureTime,
+			EndsAt:      futureTime.Add(time.Hour),
+		}
+
+		// Expect conference retrieval
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		// Expect conflict check to find conflicts
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, conference.RoomID, conference.StartsAt, conference.EndsAt,
+				conferenceID).
+			Return([]entity.Conference{conflictingConference}, nil)
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{Status: enum.ConferenceApproved})
+		assert.ErrorIs(t, err, errorpkg.ErrTimeWindowConflict)
+	})
+
+	t.Run("error - database error on conflict check", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		// Expect conference retrieval
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		// Expect conflict check to fail
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, conference.RoomID, conference.StartsAt, conference.EndsAt,
+				conferenceID).
+			Return(nil, errors.New("database error"))
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{Status: enum.ConferenceApproved})
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - generate review ID fails", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			StartsAt: now.Add(-time.Hour),
+			EndsAt:   now,
+			Status:   enum.ConferencePending,
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.Nil, errors.New("uuid error"))
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{
+			Status: enum.ConferenceRejected,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - database error on update", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		conference := &entity.Conference{
+			ID:       conferenceID,
+			StartsAt: futureTime,
+			EndsAt:   futureTime.Add(time.Hour),
+			Status:   enum.ConferencePending,
+		}
+
+		// Expect conference retrieval
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		// Expect conflict check
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, conference.RoomID, conference.StartsAt, conference.EndsAt,
+				conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(reviewID, nil).Once()
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil).Once()
 
 		// Expect update to fail
 		mocks.conferenceRepo.EXPECT().
-			UpdateConference(ctx, conference).
-			Return(errors.New("database error"))
+			ReviewConference(ctx, conference, mock.AnythingOfType("*entity.ConferenceReview"),
+				mock.AnythingOfType("*entity.ConferenceAudit")).
+			Return(errors.New("database error"))
+
+		err := svc.UpdateConferenceStatus(ctx, conferenceID, dto.UpdateConferenceStatusRequest{Status: enum.ConferenceApproved})
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_ConferenceService_GetConferenceReviews(t *testing.T) {
+	ctx := context.Background()
+	conferenceID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		reason := enum.RejectionScheduleConflict
+		reviewer := &entity.User{ID: uuid.New(), Name: "Coordinator"}
+		reviews := []entity.ConferenceReview{
+			{
+				ID:         uuid.New(),
+				ReviewerID: reviewer.ID,
+				Status:     enum.ConferenceRejected,
+				Reason:     &reason,
+				Reviewer:   reviewer,
+			},
+			{
+				ID:         uuid.New(),
+				ReviewerID: reviewer.ID,
+				Status:     enum.ConferenceApproved,
+				Reviewer:   reviewer,
+			},
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&entity.Conference{ID: conferenceID}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetReviewsByConference(ctx, conferenceID).
+			Return(reviews, nil)
+
+		result, err := svc.GetConferenceReviews(ctx, conferenceID)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, reviews[0].ID, result[0].ID)
+		assert.Equal(t, &reason, result[0].Reason)
+		assert.Equal(t, "Coordinator", result[1].Reviewer.Name)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		_, err := svc.GetConferenceReviews(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("forbidden - coordinator of another event", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		coordinatorID := uuid.New()
+		eventID := uuid.New()
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", coordinatorID), "user.role", enum.RoleEventCoordinator)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&entity.Conference{ID: conferenceID, EventID: eventID}, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, coordinatorID).
+			Return(false, nil)
+
+		_, err := svc.GetConferenceReviews(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotEventCoordinator)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&entity.Conference{ID: conferenceID}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetReviewsByConference(ctx, conferenceID).
+			Return(nil, errors.New("database error"))
+
+		_, err := svc.GetConferenceReviews(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_ConferenceService_GetConferenceHistory(t *testing.T) {
+	conferenceID := uuid.New()
+	hostID := uuid.New()
+	eventID := uuid.New()
+	conference := &entity.Conference{ID: conferenceID, HostID: hostID, EventID: eventID}
+
+	t.Run("success - host", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", hostID), "user.role", enum.RoleUser)
+
+		actor := &entity.User{ID: hostID, Name: "Host"}
+		audits := []entity.ConferenceAudit{
+			{
+				ID:             uuid.New(),
+				ConferenceID:   conferenceID,
+				ActorID:        &hostID,
+				Action:         enum.AuditConferenceUpdated,
+				PreviousValues: []byte(`{"title":"Old"}`),
+				NewValues:      []byte(`{"title":"New"}`),
+				Actor:          actor,
+			},
+			{
+				ID:             uuid.New(),
+				ConferenceID:   conferenceID,
+				Action:         enum.AuditConferenceStatusChanged,
+				PreviousValues: []byte(`{"status":"pending"}`),
+				NewValues:      []byte(`{"status":"approved"}`),
+			},
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetAuditsByConference(ctx, conferenceID).
+			Return(audits, nil)
+
+		result, err := svc.GetConferenceHistory(ctx, conferenceID)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "Host", result[0].Actor.Name)
+		assert.JSONEq(t, `{"title":"New"}`, string(result[0].NewValues))
+		assert.Nil(t, result[1].Actor)
+	})
+
+	t.Run("success - event coordinator", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		coordinatorID := uuid.New()
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", coordinatorID), "user.role", enum.RoleEventCoordinator)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, coordinatorID).
+			Return(true, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetAuditsByConference(ctx, conferenceID).
+			Return([]entity.ConferenceAudit{}, nil)
+
+		result, err := svc.GetConferenceHistory(ctx, conferenceID)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("error - user is not the host", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", uuid.New()), "user.role", enum.RoleUser)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		_, err := svc.GetConferenceHistory(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
+	})
+
+	t.Run("forbidden - coordinator of another event", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		coordinatorID := uuid.New()
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", coordinatorID), "user.role", enum.RoleEventCoordinator)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, coordinatorID).
+			Return(false, nil)
+
+		_, err := svc.GetConferenceHistory(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotEventCoordinator)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.Background()
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		_, err := svc.GetConferenceHistory(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.Background()
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetAuditsByConference(ctx, conferenceID).
+			Return(nil, errors.New("database error"))
+
+		_, err := svc.GetConferenceHistory(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_ConferenceService_RequestReschedule(t *testing.T) {
+	hostID := uuid.New()
+	conferenceID := uuid.New()
+	rescheduleID := uuid.New()
+	roomID := uuid.New()
+	now := time.Now()
+	eventID := uuid.New()
+	eventStartsAt := now.Add(-30 * 24 * time.Hour)
+	eventEndsAt := now.Add(30 * 24 * time.Hour)
+	evt := &dto.EventResponse{ID: eventID, StartsAt: &eventStartsAt, EndsAt: &eventEndsAt}
+	ctx := context.WithValue(context.WithValue(context.Background(),
+		"user.id", hostID),
+		"user.role", enum.RoleUser)
+
+	req := dto.RequestRescheduleRequest{
+		StartsAt: now.Add(48 * time.Hour),
+		EndsAt:   now.Add(50 * time.Hour),
+	}
+
+	newConference := func() *entity.Conference {
+		return &entity.Conference{
+			ID:       conferenceID,
+			HostID:   hostID,
+			RoomID:   roomID,
+			EventID:  eventID,
+			StartsAt: now.Add(24 * time.Hour),
+			EndsAt:   now.Add(26 * time.Hour),
+			Status:   enum.ConferenceApproved,
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(rescheduleID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			CreateReschedule(ctx, mock.MatchedBy(func(reschedule *entity.ConferenceReschedule) bool {
+				return reschedule.ID == rescheduleID &&
+					reschedule.RequesterID == hostID &&
+					reschedule.StartsAt.Equal(req.StartsAt) &&
+					reschedule.PreviousStartsAt.Equal(conference.StartsAt) &&
+					reschedule.Status == enum.ReschedulePending
+			})).
+			Return(nil)
+
+		id, err := svc.RequestReschedule(ctx, conferenceID, req)
+		assert.NoError(t, err)
+		assert.Equal(t, rescheduleID, id)
+	})
+
+	t.Run("error - not the host", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+		conference.HostID = uuid.New()
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		_, err := svc.RequestReschedule(ctx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
+	})
+
+	t.Run("error - conference not approved", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+		conference.Status = enum.ConferencePending
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		_, err := svc.RequestReschedule(ctx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrRescheduleNotApprovedConference)
+	})
+
+	t.Run("error - conference started", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+		conference.StartsAt = now.Add(-time.Hour)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		_, err := svc.RequestReschedule(ctx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrRescheduleStartedConference)
+	})
+
+	t.Run("error - end time before start", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		_, err := svc.RequestReschedule(ctx, conferenceID, dto.RequestRescheduleRequest{
+			StartsAt: req.EndsAt,
+			EndsAt:   req.StartsAt,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrEndTimeBeforeStart)
+	})
+
+	t.Run("error - time window conflict", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, conferenceID).
+			Return([]entity.Conference{{ID: uuid.New(), Title: "Other Conference"}}, nil)
+
+		_, err := svc.RequestReschedule(ctx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrTimeWindowConflict)
+	})
+
+	t.Run("error - already requested", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, req.StartsAt, req.EndsAt, conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(rescheduleID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			CreateReschedule(ctx, mock.AnythingOfType("*entity.ConferenceReschedule")).
+			Return(&pgconn.PgError{ConstraintName: "conference_reschedules_conference_id_pending_key"})
+
+		_, err := svc.RequestReschedule(ctx, conferenceID, req)
+		assert.ErrorIs(t, err, errorpkg.ErrRescheduleAlreadyRequested)
+	})
+}
+
+func Test_ConferenceService_GetReschedules(t *testing.T) {
+	hostID := uuid.New()
+	conferenceID := uuid.New()
+	eventID := uuid.New()
+	now := time.Now()
+	conference := &entity.Conference{ID: conferenceID, HostID: hostID, EventID: eventID}
+
+	t.Run("success - host", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", hostID), "user.role", enum.RoleUser)
+
+		reschedules := []entity.ConferenceReschedule{
+			{
+				ID:           uuid.New(),
+				ConferenceID: conferenceID,
+				StartsAt:     now.Add(48 * time.Hour),
+				EndsAt:       now.Add(50 * time.Hour),
+				Status:       enum.ReschedulePending,
+			},
+		}
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetReschedulesByConference(ctx, conferenceID).
+			Return(reschedules, nil)
+
+		result, err := svc.GetReschedules(ctx, conferenceID)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, reschedules[0].ID, result[0].ID)
+	})
+
+	t.Run("error - user is not the host", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", uuid.New()), "user.role", enum.RoleUser)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		_, err := svc.GetReschedules(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
+	})
+
+	t.Run("forbidden - coordinator of another event", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		coordinatorID := uuid.New()
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", coordinatorID), "user.role", enum.RoleEventCoordinator)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, coordinatorID).
+			Return(false, nil)
+
+		_, err := svc.GetReschedules(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotEventCoordinator)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.Background()
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		_, err := svc.GetReschedules(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_ConferenceService_ReviewReschedule(t *testing.T) {
+	reviewerID := uuid.New()
+	conferenceID := uuid.New()
+	rescheduleID := uuid.New()
+	auditID := uuid.New()
+	roomID := uuid.New()
+	now := time.Now()
+	eventID := uuid.New()
+	eventStartsAt := now.Add(-30 * 24 * time.Hour)
+	eventEndsAt := now.Add(30 * 24 * time.Hour)
+	evt := &dto.EventResponse{ID: eventID, StartsAt: &eventStartsAt, EndsAt: &eventEndsAt}
+	ctx := context.WithValue(context.WithValue(context.Background(),
+		"user.id", reviewerID),
+		"user.role", enum.RoleEventCoordinator)
+
+	newConference := func() *entity.Conference {
+		return &entity.Conference{
+			ID:       conferenceID,
+			Title:    "Test Conference",
+			HostID:   uuid.New(),
+			RoomID:   roomID,
+			EventID:  eventID,
+			StartsAt: now.Add(24 * time.Hour),
+			EndsAt:   now.Add(26 * time.Hour),
+			Status:   enum.ConferenceApproved,
+		}
+	}
+
+	newReschedule := func() *entity.ConferenceReschedule {
+		return &entity.ConferenceReschedule{
+			ID:               rescheduleID,
+			ConferenceID:     conferenceID,
+			StartsAt:         now.Add(48 * time.Hour),
+			EndsAt:           now.Add(50 * time.Hour),
+			PreviousStartsAt: now.Add(24 * time.Hour),
+			PreviousEndsAt:   now.Add(26 * time.Hour),
+			Status:           enum.ReschedulePending,
+		}
+	}
+
+	t.Run("success - approve moves the conference", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+		reschedule := newReschedule()
+		previousStartsAt := conference.StartsAt
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(reschedule, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(true, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		// The conflict check runs again at approval
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, reschedule.StartsAt, reschedule.EndsAt, conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			ReviewReschedule(ctx, mock.MatchedBy(func(r *entity.ConferenceReschedule) bool {
+				return r.Status == enum.RescheduleApproved &&
+					*r.ReviewerID == reviewerID &&
+					r.ReviewedAt != nil
+			}), mock.MatchedBy(func(c *entity.Conference) bool {
+				return c.StartsAt.Equal(reschedule.StartsAt) && c.EndsAt.Equal(reschedule.EndsAt)
+			}), mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return audit.Action == enum.AuditConferenceUpdated
+			})).
+			Return(nil, nil)
+
+		mocks.eventBus.EXPECT().
+			Publish(ctx, mock.AnythingOfType("event.RescheduleReviewed")).
+			Return()
+
+		// Registrants are told, which also flags the ones with a clash
+		mocks.eventBus.EXPECT().
+			Publish(ctx, mock.MatchedBy(func(e event.ConferenceRescheduled) bool {
+				return e.Conference.StartsAt.Equal(reschedule.StartsAt) && e.PreviousStartsAt.Equal(previousStartsAt)
+			})).
+			Return()
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - reject keeps the conference", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		notes := "The room is taken that day."
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(newReschedule(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(true, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			ReviewReschedule(ctx, mock.MatchedBy(func(r *entity.ConferenceReschedule) bool {
+				return r.Status == enum.RescheduleRejected && *r.Notes == notes
+			}), (*entity.Conference)(nil), (*entity.ConferenceAudit)(nil)).
+			Return(nil, nil)
+
+		mocks.eventBus.EXPECT().
+			Publish(ctx, mock.AnythingOfType("event.RescheduleReviewed")).
+			Return()
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleRejected,
+			Notes:  &notes,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - reschedule of another conference", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		reschedule := newReschedule()
+		reschedule.ConferenceID = uuid.New()
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(reschedule, nil)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - already reviewed", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		reschedule := newReschedule()
+		reschedule.Status = enum.RescheduleRejected
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(reschedule, nil)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrRescheduleNotPending)
+	})
+
+	t.Run("error - coordinator of another event", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(newReschedule(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(false, nil)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrNotEventCoordinator)
+	})
+
+	t.Run("error - conflict found at approval", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		reschedule := newReschedule()
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(reschedule, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(true, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, reschedule.StartsAt, reschedule.EndsAt, conferenceID).
+			Return([]entity.Conference{{ID: uuid.New(), Title: "Approved Meanwhile"}}, nil)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrTimeWindowConflict)
+	})
+
+	t.Run("error - conference cancelled meanwhile", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		conference := newConference()
+		conference.Status = enum.ConferenceCancelled
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(newReschedule(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(true, nil)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrRescheduleNotApprovedConference)
+	})
+
+	t.Run("error - reviewed concurrently", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(newReschedule(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(true, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			ReviewReschedule(ctx, mock.AnythingOfType("*entity.ConferenceReschedule"),
+				(*entity.Conference)(nil), (*entity.ConferenceAudit)(nil)).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleRejected,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrRescheduleNotPending)
+	})
+
+	t.Run("error - conflict found while saving", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(newReschedule(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(true, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, now.Add(48*time.Hour), now.Add(50*time.Hour),
+				conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		conflict := entity.Conference{
+			ID:       uuid.New(),
+			Title:    "Moved In Meanwhile",
+			StartsAt: now.Add(49 * time.Hour),
+			EndsAt:   now.Add(51 * time.Hour),
+		}
+		mocks.conferenceRepo.EXPECT().
+			ReviewReschedule(ctx, mock.AnythingOfType("*entity.ConferenceReschedule"),
+				mock.AnythingOfType("*entity.Conference"), mock.AnythingOfType("*entity.ConferenceAudit")).
+			Return([]entity.Conference{conflict}, nil)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrTimeWindowConflict)
+		assert.Equal(t, map[string]interface{}{
+			"conferences": []dto.ConferenceResponse{{
+				ID:       conflict.ID,
+				Title:    conflict.Title,
+				StartsAt: &conflict.StartsAt,
+				EndsAt:   &conflict.EndsAt,
+			}},
+		}, errorpkg.ErrTimeWindowConflict.Detail)
+	})
+
+	t.Run("error - cancelled while saving", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(newReschedule(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(true, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, now.Add(48*time.Hour), now.Add(50*time.Hour),
+				conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			ReviewReschedule(ctx, mock.AnythingOfType("*entity.ConferenceReschedule"),
+				mock.AnythingOfType("*entity.Conference"), mock.AnythingOfType("*entity.ConferenceAudit")).
+			Return(nil, contract.ErrConferenceNotApproved)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrRescheduleNotApprovedConference)
+	})
+
+	t.Run("error - started while saving", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetRescheduleByID(ctx, rescheduleID).
+			Return(newReschedule(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(ctx, eventID, reviewerID).
+			Return(true, nil)
+
+		mocks.eventSvc.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(evt, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesConflictingWithTime(ctx, roomID, now.Add(48*time.Hour), now.Add(50*time.Hour),
+				conferenceID).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			ReviewReschedule(ctx, mock.AnythingOfType("*entity.ConferenceReschedule"),
+				mock.AnythingOfType("*entity.Conference"), mock.AnythingOfType("*entity.ConferenceAudit")).
+			Return(nil, contract.ErrConferenceStarted)
+
+		err := svc.ReviewReschedule(ctx, conferenceID, rescheduleID, dto.ReviewRescheduleRequest{
+			Status: enum.RescheduleApproved,
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrRescheduleStartedConference)
+	})
+}
+
+func Test_ConferenceService_UpdateConferenceSpeakers(t *testing.T) {
+	conferenceID := uuid.New()
+	hostID := uuid.New()
+	coSpeakerID := uuid.New()
+	now := time.Now()
+	guestName := "Guest Speaker"
+	guestTitle := "CTO"
+	newConference := func() *entity.Conference {
+		return &entity.Conference{
+			ID:           conferenceID,
+			HostID:       hostID,
+			SpeakerName:  guestName,
+			SpeakerTitle: guestTitle,
+			StartsAt:     now.Add(24 * time.Hour),
+			EndsAt:       now.Add(26 * time.Hour),
+			Status:       enum.ConferencePending,
+		}
+	}
+	current := []entity.ConferenceSpeaker{
+		{ConferenceID: conferenceID, Position: 0, Name: &guestName, Title: &guestTitle},
+	}
+	ctx := context.WithValue(context.WithValue(context.Background(),
+		"user.id", hostID), "user.role", enum.RoleUser)
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return(current, nil)
+
+		coSpeaker := &entity.User{ID: coSpeakerID, Name: "Linked Speaker"}
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, coSpeakerID).
+			Return(coSpeaker, nil)
+
+		auditID := uuid.New()
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(auditID, nil)
+
+		speakers := []entity.ConferenceSpeaker{
+			{ConferenceID: conferenceID, Position: 0, UserID: &coSpeakerID, User: coSpeaker},
+			{ConferenceID: conferenceID, Position: 1, Name: &guestName, Title: &guestTitle},
+		}
+		mocks.conferenceRepo.EXPECT().
+			ReplaceSpeakers(ctx, mock.MatchedBy(func(c *entity.Conference) bool {
+				// The first speaker becomes the headline one
+				return assert.Equal(t, speakers, c.Speakers) &&
+					c.SpeakerName == "Linked Speaker" && c.SpeakerTitle == ""
+			}), mock.MatchedBy(func(audit *entity.ConferenceAudit) bool {
+				return audit.ID == auditID && audit.ConferenceID == conferenceID &&
+					audit.Action == enum.AuditConferenceUpdated && *audit.ActorID == hostID
+			})).
+			Return(nil)
+
+		err := svc.UpdateConferenceSpeakers(ctx, conferenceID, dto.UpdateConferenceSpeakersRequest{
+			Speakers: []dto.ConferenceSpeakerRequest{
+				{UserID: &coSpeakerID},
+				{Name: &guestName, Title: &guestTitle},
+			},
+		})
+		assert.NoError(t, err)
+	})
 
-		err := svc.UpdateConferenceStatus(ctx, conferenceID, enum.ConferenceApproved)
+	t.Run("success - unchanged speakers are not audited", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return(current, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			ReplaceSpeakers(ctx, mock.MatchedBy(func(c *entity.Conference) bool {
+				return assert.Equal(t, current, c.Speakers) && c.SpeakerName == guestName
+			}), (*entity.ConferenceAudit)(nil)).
+			Return(nil)
+
+		err := svc.UpdateConferenceSpeakers(ctx, conferenceID, dto.UpdateConferenceSpeakersRequest{
+			Speakers: []dto.ConferenceSpeakerRequest{{Name: &guestName, Title: &guestTitle}},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.UpdateConferenceSpeakers(ctx, conferenceID, dto.UpdateConferenceSpeakersRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - not the host", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+		ctx := context.WithValue(context.WithValue(context.Background(),
+			"user.id", uuid.New()), "user.role", enum.RoleUser)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		err := svc.UpdateConferenceSpeakers(ctx, conferenceID, dto.UpdateConferenceSpeakersRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
+	})
+
+	t.Run("error - not pending", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		approved := *newConference()
+		approved.Status = enum.ConferenceApproved
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&approved, nil)
+
+		err := svc.UpdateConferenceSpeakers(ctx, conferenceID, dto.UpdateConferenceSpeakersRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrUpdateNotPendingConference)
+	})
+
+	t.Run("error - already ended", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		past := *newConference()
+		past.StartsAt = now.Add(-3 * time.Hour)
+		past.EndsAt = now.Add(-time.Hour)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&past, nil)
+
+		err := svc.UpdateConferenceSpeakers(ctx, conferenceID, dto.UpdateConferenceSpeakersRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrUpdatePastConference)
+	})
+
+	t.Run("error - guest without name", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return(current, nil)
+
+		err := svc.UpdateConferenceSpeakers(ctx, conferenceID, dto.UpdateConferenceSpeakersRequest{
+			Speakers: []dto.ConferenceSpeakerRequest{{Title: &guestTitle}},
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidSpeaker)
+	})
+
+	t.Run("error - replace speakers fails", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(newConference(), nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferenceID}).
+			Return(current, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			ReplaceSpeakers(ctx, mock.MatchedBy(func(c *entity.Conference) bool {
+				return assert.Equal(t, current, c.Speakers) && c.SpeakerName == guestName
+			}), (*entity.ConferenceAudit)(nil)).
+			Return(errors.New("db error"))
+
+		err := svc.UpdateConferenceSpeakers(ctx, conferenceID, dto.UpdateConferenceSpeakersRequest{
+			Speakers: []dto.ConferenceSpeakerRequest{{Name: &guestName, Title: &guestTitle}},
+		})
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_ConferenceService_GetSpeakerConferences(t *testing.T) {
+	speakerID := uuid.New()
+	now := time.Now()
+	ctx := context.WithValue(context.WithValue(context.Background(),
+		"user.id", uuid.New()), "user.role", enum.RoleUser)
+	lazyReq := dto.LazyLoadQuery{Limit: 10}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		conferences := []entity.Conference{
+			{
+				ID:       uuid.New(),
+				Title:    "Test Conference",
+				Status:   enum.ConferenceApproved,
+				StartsAt: now.Add(24 * time.Hour),
+				EndsAt:   now.Add(26 * time.Hour),
+			},
+		}
+		speakers := []entity.ConferenceSpeaker{
+			{
+				ConferenceID: conferences[0].ID,
+				Position:     0,
+				UserID:       &speakerID,
+				User:         &entity.User{ID: speakerID, Name: "Linked Speaker"},
+			},
+		}
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, speakerID).
+			Return(&entity.User{ID: speakerID}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesBySpeaker(ctx, speakerID, false, lazyReq).
+			Return(conferences, dto.LazyLoadResponse{
+				HasMore: true,
+				FirstID: conferences[0].ID,
+				LastID:  conferences[0].ID,
+			}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetSpeakersByConferences(ctx, []uuid.UUID{conferences[0].ID}).
+			Return(speakers, nil)
+
+		result, lazyResp, err := svc.GetSpeakerConferences(ctx, speakerID, false, lazyReq)
+		assert.NoError(t, err)
+		assert.True(t, lazyResp.HasMore)
+		assert.Len(t, result, 1)
+		assert.Equal(t, conferences[0].ID, result[0].ID)
+		assert.Equal(t, []dto.ConferenceSpeakerResponse{
+			{
+				User: &dto.UserResponse{ID: speakerID, Name: "Linked Speaker"},
+				Name: "Linked Speaker",
+			},
+		}, result[0].Speakers)
+	})
+
+	t.Run("success - no conferences", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, speakerID).
+			Return(&entity.User{ID: speakerID}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesBySpeaker(ctx, speakerID, true, lazyReq).
+			Return([]entity.Conference{}, dto.LazyLoadResponse{}, nil)
+
+		result, lazyResp, err := svc.GetSpeakerConferences(ctx, speakerID, true, lazyReq)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		assert.False(t, lazyResp.HasMore)
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, speakerID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		result, _, err := svc.GetSpeakerConferences(ctx, speakerID, false, lazyReq)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - both after_id and before_id", func(t *testing.T) {
+		svc, _ := setupConferenceServiceTest(t)
+
+		result, _, err := svc.GetSpeakerConferences(ctx, speakerID, false, dto.LazyLoadQuery{
+			AfterID:  uuid.New(),
+			BeforeID: uuid.New(),
+			Limit:    10,
+		})
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidPagination)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupConferenceServiceTest(t)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, speakerID).
+			Return(&entity.User{ID: speakerID}, nil)
+
+		mocks.conferenceRepo.EXPECT().
+			GetConferencesBySpeaker(ctx, speakerID, false, lazyReq).
+			Return(nil, dto.LazyLoadResponse{}, errors.New("db error"))
+
+		result, _, err := svc.GetSpeakerConferences(ctx, speakerID, false, lazyReq)
+		assert.Nil(t, result)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 }
@@ -0,0 +1,376 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/app/email/service"
+	"github.com/nathakusuma/conference-backend/pkg/mail"
+	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type emailServiceMocks struct {
+	emailRepo *appmocks.MockIEmailRepository
+	mailer    *pkgmocks.MockIMailer
+	uuid      *pkgmocks.MockIUUID
+}
+
+func setupEmailServiceTest(t *testing.T) (contract.IEmailService, *emailServiceMocks) {
+	mocks := &emailServiceMocks{
+		emailRepo: appmocks.NewMockIEmailRepository(t),
+		mailer:    pkgmocks.NewMockIMailer(t),
+		uuid:      pkgmocks.NewMockIUUID(t),
+	}
+
+	svc := service.NewEmailService(mocks.emailRepo, mocks.mailer, mocks.uuid)
+
+	return svc, mocks
+}
+
+func Test_EmailService_Enqueue(t *testing.T) {
+	ctx := context.Background()
+	emailID := uuid.New()
+	recipient := "test@example.com"
+	subject := "[Conference App] Verify Your Account"
+	templateName := "otp_register_user.html"
+	data := map[string]any{"otp": "123456"}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(emailID, nil)
+
+		mocks.emailRepo.EXPECT().
+			CreateEmail(ctx, mock.MatchedBy(func(email *entity.Email) bool {
+				return email.ID == emailID &&
+					email.Recipient == recipient &&
+					email.Subject == subject &&
+					email.Template == templateName &&
+					string(email.Data) == `{"otp":"123456"}`
+			})).
+			Return(nil)
+
+		err := svc.Enqueue(ctx, recipient, subject, templateName, data)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - generate id fails", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.Nil, errors.New("uuid error"))
+
+		err := svc.Enqueue(ctx, recipient, subject, templateName, data)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - create email fails", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(emailID, nil)
+
+		mocks.emailRepo.EXPECT().
+			CreateEmail(ctx, mock.AnythingOfType("*entity.Email")).
+			Return(errors.New("db error"))
+
+		err := svc.Enqueue(ctx, recipient, subject, templateName, data)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_EmailService_ProcessDueEmails(t *testing.T) {
+	ctx := context.Background()
+	newEmail := func(attempts int) entity.Email {
+		return entity.Email{
+			ID:        uuid.New(),
+			Recipient: "test@example.com",
+			Subject:   "[Conference App] Reset Password",
+			Template:  "otp_reset_password.html",
+			Data:      types.JSONText(`{"otp":"123456"}`),
+			Status:    enum.EmailPending,
+			Attempts:  attempts,
+		}
+	}
+
+	expectClaim := func(mocks *emailServiceMocks, emails ...entity.Email) {
+		mocks.emailRepo.EXPECT().
+			ClaimDueEmails(ctx, 10, 2*time.Minute).
+			Return(emails, nil)
+	}
+
+	expectSend := func(mocks *emailServiceMocks, email entity.Email, err error) {
+		mocks.mailer.EXPECT().
+			Send(email.Recipient, email.Subject, email.Template, map[string]any{"otp": "123456"}).
+			Return(err)
+	}
+
+	t.Run("success - sends and marks as sent", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+		first, second := newEmail(1), newEmail(1)
+
+		expectClaim(mocks, first, second)
+		expectSend(mocks, first, nil)
+		expectSend(mocks, second, nil)
+
+		mocks.emailRepo.EXPECT().
+			MarkEmailSent(ctx, first.ID).
+			Return(nil)
+		mocks.emailRepo.EXPECT().
+			MarkEmailSent(ctx, second.ID).
+			Return(nil)
+
+		claimed, err := svc.ProcessDueEmails(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, claimed)
+	})
+
+	t.Run("success - nothing due", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		expectClaim(mocks)
+
+		claimed, err := svc.ProcessDueEmails(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, claimed)
+	})
+
+	t.Run("transient failure - retried with exponential backoff", func(t *testing.T) {
+		for attempts, retryIn := range map[int]time.Duration{
+			1: 30 * time.Second,
+			2: time.Minute,
+			4: 4 * time.Minute,
+			7: 32 * time.Minute,
+		} {
+			t.Run(fmt.Sprintf("attempt %d", attempts), func(t *testing.T) {
+				svc, mocks := setupEmailServiceTest(t)
+				email := newEmail(attempts)
+
+				expectClaim(mocks, email)
+				expectSend(mocks, email, errors.New("connection refused"))
+
+				mocks.emailRepo.EXPECT().
+					MarkEmailRetry(ctx, email.ID, retryIn, "connection refused").
+					Return(nil)
+
+				claimed, err := svc.ProcessDueEmails(ctx)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, claimed)
+			})
+		}
+	})
+
+	t.Run("permanent failure - dead-lettered right away", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+		email := newEmail(1)
+		sendErr := fmt.Errorf("%w: 550 mailbox unavailable", mail.ErrPermanent)
+
+		expectClaim(mocks, email)
+		expectSend(mocks, email, sendErr)
+
+		mocks.emailRepo.EXPECT().
+			MarkEmailFailed(ctx, email.ID, sendErr.Error()).
+			Return(nil)
+
+		claimed, err := svc.ProcessDueEmails(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, claimed)
+	})
+
+	t.Run("transient failure - dead-lettered after the last attempt", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+		email := newEmail(8)
+
+		expectClaim(mocks, email)
+		expectSend(mocks, email, errors.New("connection refused"))
+
+		mocks.emailRepo.EXPECT().
+			MarkEmailFailed(ctx, email.ID, "connection refused").
+			Return(nil)
+
+		claimed, err := svc.ProcessDueEmails(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, claimed)
+	})
+
+	t.Run("invalid data - dead-lettered without sending", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+		email := newEmail(1)
+		email.Data = types.JSONText(`not json`)
+
+		expectClaim(mocks, email)
+
+		mocks.emailRepo.EXPECT().
+			MarkEmailFailed(ctx, email.ID, mock.AnythingOfType("string")).
+			Return(nil)
+
+		claimed, err := svc.ProcessDueEmails(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, claimed)
+	})
+
+	t.Run("mark sent fails - keeps processing the batch", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+		first, second := newEmail(1), newEmail(1)
+
+		expectClaim(mocks, first, second)
+		expectSend(mocks, first, nil)
+		expectSend(mocks, second, nil)
+
+		mocks.emailRepo.EXPECT().
+			MarkEmailSent(ctx, first.ID).
+			Return(errors.New("db error"))
+		mocks.emailRepo.EXPECT().
+			MarkEmailSent(ctx, second.ID).
+			Return(nil)
+
+		claimed, err := svc.ProcessDueEmails(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, claimed)
+	})
+
+	t.Run("error - claim fails", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.emailRepo.EXPECT().
+			ClaimDueEmails(ctx, 10, 2*time.Minute).
+			Return(nil, errors.New("db error"))
+
+		claimed, err := svc.ProcessDueEmails(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+		assert.Equal(t, 0, claimed)
+	})
+}
+
+func Test_EmailService_GetEmails(t *testing.T) {
+	ctx := context.Background()
+	lazyReq := dto.LazyLoadQuery{Limit: 10}
+	lastError := "550 mailbox unavailable"
+
+	t.Run("success - leaves out template data", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+		email := entity.Email{
+			ID:        uuid.New(),
+			Recipient: "test@example.com",
+			Subject:   "[Conference App] Reset Password",
+			Template:  "otp_reset_password.html",
+			Data:      types.JSONText(`{"otp":"123456"}`),
+			Status:    enum.EmailFailed,
+			Attempts:  1,
+			LastError: &lastError,
+		}
+		lazyResp := dto.LazyLoadResponse{FirstID: email.ID, LastID: email.ID}
+
+		mocks.emailRepo.EXPECT().
+			GetEmails(ctx, enum.EmailFailed, lazyReq).
+			Return([]entity.Email{email}, lazyResp, nil)
+
+		emails, gotLazyResp, err := svc.GetEmails(ctx, enum.EmailFailed, lazyReq)
+		assert.NoError(t, err)
+		assert.Equal(t, lazyResp, gotLazyResp)
+		assert.Equal(t, []dto.EmailResponse{{
+			ID:        email.ID,
+			Recipient: email.Recipient,
+			Subject:   email.Subject,
+			Template:  email.Template,
+			Status:    enum.EmailFailed,
+			Attempts:  1,
+			LastError: &lastError,
+		}}, emails)
+	})
+
+	t.Run("error - repository fails", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.emailRepo.EXPECT().
+			GetEmails(ctx, enum.EmailStatus(""), lazyReq).
+			Return(nil, dto.LazyLoadResponse{}, errors.New("db error"))
+
+		_, _, err := svc.GetEmails(ctx, "", lazyReq)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_EmailService_GetQueueStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success - missing statuses count as zero", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.emailRepo.EXPECT().
+			CountEmailsByStatus(ctx).
+			Return(map[enum.EmailStatus]int{enum.EmailPending: 3, enum.EmailFailed: 1}, nil)
+
+		stats, err := svc.GetQueueStats(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, dto.EmailQueueStats{Pending: 3, Failed: 1}, stats)
+	})
+
+	t.Run("error - repository fails", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.emailRepo.EXPECT().
+			CountEmailsByStatus(ctx).
+			Return(nil, errors.New("db error"))
+
+		_, err := svc.GetQueueStats(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_EmailService_RetryEmail(t *testing.T) {
+	ctx := context.Background()
+	emailID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.emailRepo.EXPECT().
+			RetryFailedEmail(ctx, emailID).
+			Return(nil)
+
+		err := svc.RetryEmail(ctx, emailID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not a failed email", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.emailRepo.EXPECT().
+			RetryFailedEmail(ctx, emailID).
+			Return(sql.ErrNoRows)
+
+		err := svc.RetryEmail(ctx, emailID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - repository fails", func(t *testing.T) {
+		svc, mocks := setupEmailServiceTest(t)
+
+		mocks.emailRepo.EXPECT().
+			RetryFailedEmail(ctx, emailID).
+			Return(errors.New("db error"))
+
+		err := svc.RetryEmail(ctx, emailID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
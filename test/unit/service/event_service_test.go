@@ -0,0 +1,595 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/app/event/service"
+	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type eventServiceMocks struct {
+	eventRepo *appmocks.MockIEventRepository
+	userSvc   *appmocks.MockIUserService
+	uuid      *pkgmocks.MockIUUID
+}
+
+func setupEventServiceTest(t *testing.T) (contract.IEventService, *eventServiceMocks) {
+	mocks := &eventServiceMocks{
+		eventRepo: appmocks.NewMockIEventRepository(t),
+		userSvc:   appmocks.NewMockIUserService(t),
+		uuid:      pkgmocks.NewMockIUUID(t),
+	}
+
+	svc := service.NewEventService(mocks.eventRepo, mocks.userSvc, mocks.uuid)
+
+	return svc, mocks
+}
+
+func newTestEvent(id uuid.UUID) *entity.Event {
+	now := time.Now()
+	return &entity.Event{
+		ID:          id,
+		Name:        "Backend Week",
+		StartsAt:    now.Add(30 * 24 * time.Hour),
+		EndsAt:      now.Add(35 * 24 * time.Hour),
+		CFPOpensAt:  now,
+		CFPClosesAt: now.Add(14 * 24 * time.Hour),
+	}
+}
+
+func Test_EventService_CreateEvent(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	event := newTestEvent(eventID)
+
+	req := dto.CreateEventRequest{
+		Name:        event.Name,
+		StartsAt:    event.StartsAt,
+		EndsAt:      event.EndsAt,
+		CFPOpensAt:  event.CFPOpensAt,
+		CFPClosesAt: event.CFPClosesAt,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(eventID, nil)
+
+		mocks.eventRepo.EXPECT().
+			CreateEvent(ctx, event).
+			Return(nil)
+
+		id, err := svc.CreateEvent(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, eventID, id)
+	})
+
+	t.Run("error - ends before it starts", func(t *testing.T) {
+		svc, _ := setupEventServiceTest(t)
+		badReq := req
+		badReq.EndsAt = req.StartsAt.Add(-time.Hour)
+
+		id, err := svc.CreateEvent(ctx, badReq)
+		assert.Equal(t, uuid.Nil, id)
+		assert.ErrorIs(t, err, errorpkg.ErrEndTimeBeforeStart)
+	})
+
+	t.Run("error - CFP closes before it opens", func(t *testing.T) {
+		svc, _ := setupEventServiceTest(t)
+		badReq := req
+		badReq.CFPClosesAt = req.CFPOpensAt.Add(-time.Hour)
+
+		id, err := svc.CreateEvent(ctx, badReq)
+		assert.Equal(t, uuid.Nil, id)
+		assert.ErrorIs(t, err, errorpkg.ErrCFPClosesBeforeOpens)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(eventID, nil)
+
+		mocks.eventRepo.EXPECT().
+			CreateEvent(ctx, mock.AnythingOfType("*entity.Event")).
+			Return(errors.New("database error"))
+
+		id, err := svc.CreateEvent(ctx, req)
+		assert.Equal(t, uuid.Nil, id)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_EventService_GetEventByID(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(newTestEvent(eventID), nil)
+
+		event, err := svc.GetEventByID(ctx, eventID)
+		assert.NoError(t, err)
+		assert.Equal(t, eventID, event.ID)
+		assert.Equal(t, "Backend Week", event.Name)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(nil, sql.ErrNoRows)
+
+		event, err := svc.GetEventByID(ctx, eventID)
+		assert.Nil(t, event)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_EventService_UpdateEvent(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+
+	t.Run("success - rename", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		name := "Backend Days"
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(newTestEvent(eventID), nil)
+
+		mocks.eventRepo.EXPECT().
+			UpdateEvent(ctx, mock.MatchedBy(func(e *entity.Event) bool {
+				return e.Name == name
+			})).
+			Return(nil)
+
+		err := svc.UpdateEvent(ctx, eventID, dto.UpdateEventRequest{Name: &name})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - move dates around its conferences", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		endsAt := event.EndsAt.Add(24 * time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		mocks.eventRepo.EXPECT().
+			HasConferencesOutside(ctx, eventID, event.StartsAt, endsAt).
+			Return(false, nil)
+
+		mocks.eventRepo.EXPECT().
+			UpdateEvent(ctx, event).
+			Return(nil)
+
+		err := svc.UpdateEvent(ctx, eventID, dto.UpdateEventRequest{EndsAt: &endsAt})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - dates exclude conferences", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		endsAt := event.StartsAt.Add(time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		mocks.eventRepo.EXPECT().
+			HasConferencesOutside(ctx, eventID, event.StartsAt, endsAt).
+			Return(true, nil)
+
+		err := svc.UpdateEvent(ctx, eventID, dto.UpdateEventRequest{EndsAt: &endsAt})
+		assert.ErrorIs(t, err, errorpkg.ErrEventDatesExcludeConferences)
+	})
+
+	t.Run("error - ends before it starts", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		endsAt := event.StartsAt.Add(-time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		err := svc.UpdateEvent(ctx, eventID, dto.UpdateEventRequest{EndsAt: &endsAt})
+		assert.ErrorIs(t, err, errorpkg.ErrEndTimeBeforeStart)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.UpdateEvent(ctx, eventID, dto.UpdateEventRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_EventService_DeleteEvent(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			HasConferences(ctx, eventID).
+			Return(false, nil)
+
+		mocks.eventRepo.EXPECT().
+			DeleteEvent(ctx, eventID).
+			Return(nil)
+
+		err := svc.DeleteEvent(ctx, eventID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - event in use", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			HasConferences(ctx, eventID).
+			Return(true, nil)
+
+		err := svc.DeleteEvent(ctx, eventID)
+		assert.ErrorIs(t, err, errorpkg.ErrEventInUse)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			HasConferences(ctx, eventID).
+			Return(false, nil)
+
+		mocks.eventRepo.EXPECT().
+			DeleteEvent(ctx, eventID).
+			Return(sql.ErrNoRows)
+
+		err := svc.DeleteEvent(ctx, eventID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_EventService_AddCoordinator(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(newTestEvent(eventID), nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(&entity.User{ID: userID, Role: enum.RoleEventCoordinator}, nil)
+
+		mocks.eventRepo.EXPECT().
+			AddCoordinator(ctx, eventID, userID).
+			Return(nil)
+
+		err := svc.AddCoordinator(ctx, eventID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - user is not a coordinator", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(newTestEvent(eventID), nil)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(&entity.User{ID: userID, Role: enum.RoleUser}, nil)
+
+		err := svc.AddCoordinator(ctx, eventID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrUserNotCoordinator)
+	})
+
+	t.Run("error - event not found", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.AddCoordinator(ctx, eventID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_EventService_RemoveCoordinator(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			RemoveCoordinator(ctx, eventID, userID).
+			Return(nil)
+
+		err := svc.RemoveCoordinator(ctx, eventID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not a coordinator of the event", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			RemoveCoordinator(ctx, eventID, userID).
+			Return(sql.ErrNoRows)
+
+		err := svc.RemoveCoordinator(ctx, eventID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_EventService_GetCFP(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+
+	t.Run("success - open", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		event.CFPOpensAt = time.Now().Add(-time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		cfp, err := svc.GetCFP(ctx, eventID)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.CFPOpen, cfp.Status)
+		// Nothing can start before the event does
+		assert.Equal(t, event.StartsAt, *cfp.EarliestStartsAt)
+	})
+
+	t.Run("success - upcoming", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		event.CFPOpensAt = time.Now().Add(time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		cfp, err := svc.GetCFP(ctx, eventID)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.CFPUpcoming, cfp.Status)
+		assert.Nil(t, cfp.EarliestStartsAt)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(nil, sql.ErrNoRows)
+
+		cfp, err := svc.GetCFP(ctx, eventID)
+		assert.Nil(t, cfp)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_EventService_UpdateCFP(t *testing.T) {
+	coordinatorID := uuid.New()
+	ctx := context.WithValue(context.WithValue(context.Background(),
+		"user.id", coordinatorID),
+		"user.role", enum.RoleEventCoordinator)
+	eventID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		leadHours := 72
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(newTestEvent(eventID), nil)
+
+		mocks.eventRepo.EXPECT().
+			IsCoordinator(ctx, eventID, coordinatorID).
+			Return(true, nil)
+
+		mocks.eventRepo.EXPECT().
+			UpdateCFP(ctx, mock.MatchedBy(func(e *entity.Event) bool {
+				return e.CFPMinLeadHours == leadHours
+			})).
+			Return(nil)
+
+		err := svc.UpdateCFP(ctx, eventID, dto.UpdateCFPRequest{MinLeadHours: &leadHours})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - closes before it opens", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		closesAt := event.CFPOpensAt.Add(-time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		mocks.eventRepo.EXPECT().
+			IsCoordinator(ctx, eventID, coordinatorID).
+			Return(true, nil)
+
+		err := svc.UpdateCFP(ctx, eventID, dto.UpdateCFPRequest{ClosesAt: &closesAt})
+		assert.ErrorIs(t, err, errorpkg.ErrCFPClosesBeforeOpens)
+	})
+
+	t.Run("error - coordinator of another event", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(newTestEvent(eventID), nil)
+
+		mocks.eventRepo.EXPECT().
+			IsCoordinator(ctx, eventID, coordinatorID).
+			Return(false, nil)
+
+		err := svc.UpdateCFP(ctx, eventID, dto.UpdateCFPRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrNotEventCoordinator)
+	})
+}
+
+func Test_EventService_OpenCFP(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user.role", enum.RoleAdmin)
+	eventID := uuid.New()
+
+	t.Run("success - opens an upcoming call now", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		event.CFPOpensAt = time.Now().Add(24 * time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		mocks.eventRepo.EXPECT().
+			UpdateCFP(ctx, mock.MatchedBy(func(e *entity.Event) bool {
+				return !e.CFPOpensAt.After(time.Now())
+			})).
+			Return(nil)
+
+		err := svc.OpenCFP(ctx, eventID, dto.OpenCFPRequest{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - already open", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		event.CFPOpensAt = time.Now().Add(-time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		err := svc.OpenCFP(ctx, eventID, dto.OpenCFPRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrCFPAlreadyOpen)
+	})
+
+	t.Run("error - reopening without a new closing time", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		event.CFPOpensAt = time.Now().Add(-48 * time.Hour)
+		event.CFPClosesAt = time.Now().Add(-24 * time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		err := svc.OpenCFP(ctx, eventID, dto.OpenCFPRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrTimeAlreadyPassed)
+	})
+}
+
+func Test_EventService_CloseCFP(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user.role", enum.RoleAdmin)
+	eventID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		event.CFPOpensAt = time.Now().Add(-time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		mocks.eventRepo.EXPECT().
+			UpdateCFP(ctx, mock.MatchedBy(func(e *entity.Event) bool {
+				return !e.CFPClosesAt.After(time.Now()) && !e.CFPClosesAt.Before(e.CFPOpensAt)
+			})).
+			Return(nil)
+
+		err := svc.CloseCFP(ctx, eventID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - already closed", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		event.CFPOpensAt = time.Now().Add(-48 * time.Hour)
+		event.CFPClosesAt = time.Now().Add(-24 * time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		err := svc.CloseCFP(ctx, eventID)
+		assert.ErrorIs(t, err, errorpkg.ErrCFPClosed)
+	})
+}
+
+func Test_EventService_ExtendCFP(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user.role", enum.RoleAdmin)
+	eventID := uuid.New()
+
+	t.Run("success - reopens a closed call", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+		event.CFPOpensAt = time.Now().Add(-48 * time.Hour)
+		event.CFPClosesAt = time.Now().Add(-24 * time.Hour)
+		closesAt := time.Now().Add(24 * time.Hour)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		mocks.eventRepo.EXPECT().
+			UpdateCFP(ctx, mock.MatchedBy(func(e *entity.Event) bool {
+				return e.CFPClosesAt.Equal(closesAt)
+			})).
+			Return(nil)
+
+		err := svc.ExtendCFP(ctx, eventID, dto.ExtendCFPRequest{ClosesAt: closesAt})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not later than the current closing time", func(t *testing.T) {
+		svc, mocks := setupEventServiceTest(t)
+		event := newTestEvent(eventID)
+
+		mocks.eventRepo.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(event, nil)
+
+		err := svc.ExtendCFP(ctx, eventID, dto.ExtendCFPRequest{ClosesAt: event.CFPClosesAt.Add(-time.Hour)})
+		assert.ErrorIs(t, err, errorpkg.ErrCFPExtendNotLater)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/nathakusuma/conference-backend/domain/contract"
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
 	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
 	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
@@ -22,6 +23,7 @@ type feedbackServiceMocks struct {
 	feedbackRepo    *appmocks.MockIFeedbackRepository
 	registrationSvc *appmocks.MockIRegistrationService
 	conferenceSvc   *appmocks.MockIConferenceService
+	eventSvc        *appmocks.MockIEventService
 	uuidGen         *pkgmocks.MockIUUID
 }
 
@@ -30,6 +32,7 @@ func setupFeedbackServiceTest(t *testing.T) (contract.IFeedbackService, *feedbac
 		feedbackRepo:    appmocks.NewMockIFeedbackRepository(t),
 		registrationSvc: appmocks.NewMockIRegistrationService(t),
 		conferenceSvc:   appmocks.NewMockIConferenceService(t),
+		eventSvc:        appmocks.NewMockIEventService(t),
 		uuidGen:         pkgmocks.NewMockIUUID(t),
 	}
 
@@ -37,6 +40,7 @@ func setupFeedbackServiceTest(t *testing.T) (contract.IFeedbackService, *feedbac
 		mocks.feedbackRepo,
 		mocks.registrationSvc,
 		mocks.conferenceSvc,
+		mocks.eventSvc,
 		mocks.uuidGen,
 	)
 
@@ -203,6 +207,34 @@ func Test_FeedbackService_CreateFeedback(t *testing.T) {
 		assert.Equal(t, uuid.Nil, id)
 	})
 
+	t.Run("error - conference cancelled", func(t *testing.T) {
+		svc, mocks := setupFeedbackServiceTest(t)
+
+		mocks.registrationSvc.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(true, nil)
+
+		// Mock IsFeedbackGiven
+		mocks.feedbackRepo.EXPECT().
+			IsFeedbackGiven(ctx, userID, conferenceID).
+			Return(false, nil)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&dto.ConferenceResponse{
+				ID: conferenceID,
+				Host: &dto.UserResponse{
+					ID: hostID,
+				},
+				EndsAt: &pastTime,
+				Status: enum.ConferenceCancelled,
+			}, nil)
+
+		id, err := svc.CreateFeedback(ctx, userID, conferenceID, comment)
+		assert.ErrorIs(t, err, errorpkg.ErrConferenceCancelled)
+		assert.Equal(t, uuid.Nil, id)
+	})
+
 	t.Run("error - conference not ended", func(t *testing.T) {
 		svc, mocks := setupFeedbackServiceTest(t)
 		futureTime := time.Now().Add(24 * time.Hour)
@@ -408,4 +440,63 @@ func Test_FeedbackService_DeleteFeedback(t *testing.T) {
 		err := svc.DeleteFeedback(ctx, feedbackID)
 		assert.Error(t, err)
 	})
+
+	coordinatorID := uuid.New()
+	conferenceID := uuid.New()
+	eventID := uuid.New()
+	coordinatorCtx := context.WithValue(context.WithValue(context.Background(),
+		"user.id", coordinatorID), "user.role", enum.RoleEventCoordinator)
+
+	t.Run("success - coordinator of the event", func(t *testing.T) {
+		svc, mocks := setupFeedbackServiceTest(t)
+
+		mocks.feedbackRepo.EXPECT().
+			GetFeedbackByID(coordinatorCtx, feedbackID).
+			Return(&entity.Feedback{ID: feedbackID, ConferenceID: conferenceID}, nil)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(coordinatorCtx, conferenceID).
+			Return(&dto.ConferenceResponse{ID: conferenceID, Event: &dto.EventResponse{ID: eventID}}, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(coordinatorCtx, eventID, coordinatorID).
+			Return(true, nil)
+
+		mocks.feedbackRepo.EXPECT().
+			DeleteFeedback(coordinatorCtx, feedbackID).
+			Return(nil)
+
+		err := svc.DeleteFeedback(coordinatorCtx, feedbackID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("forbidden - coordinator of another event", func(t *testing.T) {
+		svc, mocks := setupFeedbackServiceTest(t)
+
+		mocks.feedbackRepo.EXPECT().
+			GetFeedbackByID(coordinatorCtx, feedbackID).
+			Return(&entity.Feedback{ID: feedbackID, ConferenceID: conferenceID}, nil)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(coordinatorCtx, conferenceID).
+			Return(&dto.ConferenceResponse{ID: conferenceID, Event: &dto.EventResponse{ID: eventID}}, nil)
+
+		mocks.eventSvc.EXPECT().
+			IsEventCoordinator(coordinatorCtx, eventID, coordinatorID).
+			Return(false, nil)
+
+		err := svc.DeleteFeedback(coordinatorCtx, feedbackID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotEventCoordinator)
+	})
+
+	t.Run("error - coordinator deleting missing feedback", func(t *testing.T) {
+		svc, mocks := setupFeedbackServiceTest(t)
+
+		mocks.feedbackRepo.EXPECT().
+			GetFeedbackByID(coordinatorCtx, feedbackID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.DeleteFeedback(coordinatorCtx, feedbackID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
 }
@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/domain/event"
+	"github.com/nathakusuma/conference-backend/internal/app/notification/service"
+	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type notificationServiceMocks struct {
+	notificationRepo *appmocks.MockINotificationRepository
+	userSvc          *appmocks.MockIUserService
+	registrationSvc  *appmocks.MockIRegistrationService
+	emailSvc         *appmocks.MockIEmailService
+	uuid             *pkgmocks.MockIUUID
+}
+
+func setupNotificationServiceTest(t *testing.T) (contract.INotificationService, *notificationServiceMocks) {
+	mocks := &notificationServiceMocks{
+		notificationRepo: appmocks.NewMockINotificationRepository(t),
+		userSvc:          appmocks.NewMockIUserService(t),
+		registrationSvc:  appmocks.NewMockIRegistrationService(t),
+		emailSvc:         appmocks.NewMockIEmailService(t),
+		uuid:             pkgmocks.NewMockIUUID(t),
+	}
+
+	svc := service.NewNotificationService(mocks.notificationRepo, mocks.userSvc, mocks.registrationSvc,
+		mocks.emailSvc, mocks.uuid)
+
+	return svc, mocks
+}
+
+func Test_NotificationService_HandleEvent(t *testing.T) {
+	ctx := context.Background()
+	notificationID := uuid.New()
+	startsAt := time.Now().Add(24 * time.Hour)
+
+	host := &entity.User{
+		ID:    uuid.New(),
+		Name:  "Host User",
+		Email: "host@example.com",
+	}
+
+	conference := entity.Conference{
+		ID:       uuid.New(),
+		Title:    "Test Conference",
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(2 * time.Hour),
+		HostID:   host.ID,
+	}
+
+	attendees := []entity.User{
+		{ID: uuid.New(), Name: "Attendee One", Email: "one@example.com"},
+		{ID: uuid.New(), Name: "Attendee Two", Email: "two@example.com"},
+	}
+
+	t.Run("success - approved conference notifies host", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, host.ID).
+			Return(host, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.MatchedBy(func(notifications []entity.Notification) bool {
+				return len(notifications) == 1 &&
+					notifications[0].ID == notificationID &&
+					notifications[0].UserID == host.ID &&
+					notifications[0].Type == event.NameConferenceApproved &&
+					*notifications[0].ConferenceID == conference.ID
+			})).
+			Return(nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, host.Email, "[Conference App] Your Conference Was Approved", "conference_approved.html",
+				mock.MatchedBy(func(data map[string]any) bool {
+					return data["name"] == host.Name && data["title"] == conference.Title
+				})).
+			Return(nil)
+
+		err := svc.HandleEvent(ctx, event.ConferenceApproved{Conference: conference})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - rejected conference notifies host", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+		reason := enum.RejectionInsufficientDetail
+		notes := "Please add an agenda."
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, host.ID).
+			Return(host, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.MatchedBy(func(notifications []entity.Notification) bool {
+				return len(notifications) == 1 &&
+					notifications[0].Type == event.NameConferenceRejected &&
+					strings.HasSuffix(notifications[0].Message, "Reason: The proposal lacks detail.")
+			})).
+			Return(nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, host.Email, "[Conference App] Your Conference Was Rejected", "conference_rejected.html",
+				mock.MatchedBy(func(data map[string]any) bool {
+					return data["reason"] == "The proposal lacks detail" && data["notes"] == notes
+				})).
+			Return(nil)
+
+		err := svc.HandleEvent(ctx, event.ConferenceRejected{
+			Conference: conference,
+			Review:     entity.ConferenceReview{Reason: &reason, Notes: &notes},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - cancelled conference notifies every attendee", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+
+		mocks.registrationSvc.EXPECT().
+			GetAttendeesByConference(ctx, conference.ID).
+			Return(attendees, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil).Times(2)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.MatchedBy(func(notifications []entity.Notification) bool {
+				return len(notifications) == 2 &&
+					notifications[0].UserID == attendees[0].ID &&
+					notifications[1].UserID == attendees[1].ID &&
+					notifications[0].Type == event.NameConferenceCancelled &&
+					strings.HasSuffix(notifications[0].Message, "Reason: The speaker is ill.")
+			})).
+			Return(nil)
+
+		for _, attendee := range attendees {
+			mocks.emailSvc.EXPECT().
+				Enqueue(ctx, attendee.Email, "[Conference App] Conference Cancelled", "conference_cancelled.html",
+					mock.MatchedBy(func(data map[string]any) bool {
+						return data["name"] == attendee.Name && data["reason"] == "The speaker is ill."
+					})).
+				Return(nil)
+		}
+
+		err := svc.HandleEvent(ctx, event.ConferenceCancelled{Conference: conference, Reason: "The speaker is ill."})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - rescheduled conference includes previous start", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+		previousStartsAt := startsAt.Add(-time.Hour)
+
+		mocks.registrationSvc.EXPECT().
+			GetAttendeesByConference(ctx, conference.ID).
+			Return(attendees[:1], nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.AnythingOfType("[]entity.Notification")).
+			Return(nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, attendees[0].Email, "[Conference App] Conference Rescheduled", "conference_rescheduled.html",
+				mock.MatchedBy(func(data map[string]any) bool {
+					return data["previous_starts_at"] == previousStartsAt.Format(time.RFC1123)
+				})).
+			Return(nil)
+
+		err := svc.HandleEvent(ctx, event.ConferenceRescheduled{
+			Conference:       conference,
+			PreviousStartsAt: previousStartsAt,
+			PreviousEndsAt:   previousStartsAt.Add(2 * time.Hour),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - conference without attendees", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+
+		mocks.registrationSvc.EXPECT().
+			GetAttendeesByConference(ctx, conference.ID).
+			Return([]entity.User{}, nil)
+
+		err := svc.HandleEvent(ctx, event.ConferenceCancelled{Conference: conference})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - registration from waitlist uses promotion email", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+		user := &attendees[0]
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, user.ID).
+			Return(user, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.MatchedBy(func(notifications []entity.Notification) bool {
+				return len(notifications) == 1 && notifications[0].Type == event.NameRegistrationConfirmed
+			})).
+			Return(nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, user.Email, "[Conference App] You Got a Seat", "waitlist_promoted.html",
+				mock.AnythingOfType("map[string]interface {}")).
+			Return(nil)
+
+		err := svc.HandleEvent(ctx, event.RegistrationConfirmed{
+			ConferenceID:    conference.ID,
+			ConferenceTitle: conference.Title,
+			StartsAt:        conference.StartsAt,
+			UserID:          user.ID,
+			FromWaitlist:    true,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - rejected reschedule notifies host", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+		notes := "The hall is booked that week."
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, host.ID).
+			Return(host, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.MatchedBy(func(notifications []entity.Notification) bool {
+				return len(notifications) == 1 &&
+					notifications[0].UserID == host.ID &&
+					notifications[0].Type == event.NameRescheduleReviewed &&
+					notifications[0].Title == "Reschedule rejected"
+			})).
+			Return(nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, host.Email, "[Conference App] Your Reschedule Was Rejected", "reschedule_reviewed.html",
+				mock.MatchedBy(func(data map[string]any) bool {
+					return data["approved"] == false && data["notes"] == notes
+				})).
+			Return(nil)
+
+		err := svc.HandleEvent(ctx, event.RescheduleReviewed{
+			Conference: conference,
+			Reschedule: entity.ConferenceReschedule{
+				StartsAt: startsAt.Add(48 * time.Hour),
+				Status:   enum.RescheduleRejected,
+				Notes:    &notes,
+			},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - registration conflict lists clashing conferences", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+		user := &attendees[0]
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, user.ID).
+			Return(user, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.MatchedBy(func(notifications []entity.Notification) bool {
+				return len(notifications) == 1 &&
+					notifications[0].Type == event.NameRegistrationConflict &&
+					strings.Contains(notifications[0].Message, "clashes with Go Meetup, Rust Meetup")
+			})).
+			Return(nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, user.Email, "[Conference App] Schedule Conflict", "registration_conflict.html",
+				mock.MatchedBy(func(data map[string]any) bool {
+					return data["conflicts"] == "Go Meetup, Rust Meetup"
+				})).
+			Return(nil)
+
+		err := svc.HandleEvent(ctx, event.RegistrationConflict{
+			ConferenceID:      conference.ID,
+			ConferenceTitle:   conference.Title,
+			StartsAt:          conference.StartsAt,
+			UserID:            user.ID,
+			ConflictingTitles: []string{"Go Meetup", "Rust Meetup"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - queue email fails does not fail the event", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+		user := &attendees[0]
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, user.ID).
+			Return(user, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.AnythingOfType("[]entity.Notification")).
+			Return(nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, user.Email, "[Conference App] Registration Confirmed", "registration_confirmed.html",
+				mock.AnythingOfType("map[string]interface {}")).
+			Return(errorpkg.ErrInternalServer)
+
+		err := svc.HandleEvent(ctx, event.RegistrationConfirmed{
+			ConferenceID:    conference.ID,
+			ConferenceTitle: conference.Title,
+			StartsAt:        conference.StartsAt,
+			UserID:          user.ID,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - host not found", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, host.ID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		err := svc.HandleEvent(ctx, event.ConferenceApproved{Conference: conference})
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - create notifications fails", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+
+		mocks.userSvc.EXPECT().
+			GetUserByID(ctx, host.ID).
+			Return(host, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(notificationID, nil)
+
+		mocks.notificationRepo.EXPECT().
+			CreateNotifications(ctx, mock.AnythingOfType("[]entity.Notification")).
+			Return(errors.New("db error"))
+
+		err := svc.HandleEvent(ctx, event.ConferenceApproved{Conference: conference})
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_NotificationService_GetNotificationsByUser(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	lazyReq := dto.LazyLoadQuery{Limit: 10}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+
+		notifications := []entity.Notification{
+			{ID: uuid.New(), UserID: userID, Type: event.NameConferenceApproved, Title: "Conference proposal approved"},
+		}
+		lazyResp := dto.LazyLoadResponse{FirstID: notifications[0].ID, LastID: notifications[0].ID}
+
+		mocks.notificationRepo.EXPECT().
+			GetNotificationsByUser(ctx, userID, lazyReq).
+			Return(notifications, lazyResp, nil)
+
+		resp, gotLazy, err := svc.GetNotificationsByUser(ctx, userID, lazyReq)
+		assert.NoError(t, err)
+		assert.Equal(t, lazyResp, gotLazy)
+		assert.Len(t, resp, 1)
+		assert.Equal(t, notifications[0].ID, resp[0].ID)
+		assert.Equal(t, notifications[0].Title, resp[0].Title)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupNotificationServiceTest(t)
+
+		mocks.notificationRepo.EXPECT().
+			GetNotificationsByUser(ctx, userID, lazyReq).
+			Return(nil, dto.LazyLoadResponse{}, errors.New("db error"))
+
+		_, _, err := svc.GetNotificationsByUser(ctx, userID, lazyReq)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
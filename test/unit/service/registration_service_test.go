@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/nathakusuma/conference-backend/domain/enum"
 	"github.com/nathakusuma/conference-backend/internal/app/registration/service"
 	"testing"
@@ -12,23 +15,30 @@ import (
 	"github.com/nathakusuma/conference-backend/domain/dto"
 	"github.com/nathakusuma/conference-backend/domain/entity"
 	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/domain/event"
 	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
 	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type registrationServiceMocks struct {
 	registrationRepo *appmocks.MockIRegistrationRepository
 	conferenceSvc    *appmocks.MockIConferenceService
+	eventBus         *pkgmocks.MockIEventBus
+	uuid             *pkgmocks.MockIUUID
 }
 
 func setupRegistrationServiceTest(t *testing.T) (contract.IRegistrationService, *registrationServiceMocks) {
 	mocks := &registrationServiceMocks{
 		registrationRepo: appmocks.NewMockIRegistrationRepository(t),
 		conferenceSvc:    appmocks.NewMockIConferenceService(t),
+		eventBus:         pkgmocks.NewMockIEventBus(t),
+		uuid:             pkgmocks.NewMockIUUID(t),
 	}
 
-	svc := service.NewRegistrationService(mocks.registrationRepo, mocks.conferenceSvc)
+	svc := service.NewRegistrationService(mocks.registrationRepo, mocks.conferenceSvc, mocks.eventBus, mocks.uuid)
 
 	return svc, mocks
 }
@@ -83,6 +93,15 @@ func Test_RegistrationService_Register(t *testing.T) {
 			}).
 			Return(nil)
 
+		// Mock announcing the registration
+		mocks.eventBus.EXPECT().
+			Publish(ctx, event.RegistrationConfirmed{
+				ConferenceID: conferenceID,
+				StartsAt:     now,
+				UserID:       userID,
+			}).
+			Return()
+
 		err := svc.Register(ctx, conferenceID, userID)
 		assert.NoError(t, err)
 	})
@@ -139,6 +158,27 @@ func Test_RegistrationService_Register(t *testing.T) {
 		assert.ErrorIs(t, err, errorpkg.ErrConferenceEnded)
 	})
 
+	t.Run("error - conference cancelled", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		conference := &dto.ConferenceResponse{
+			ID: conferenceID,
+			Host: &dto.UserResponse{
+				ID: hostID,
+			},
+			StartsAt: &futureTime,
+			EndsAt:   &futureTime,
+			Status:   enum.ConferenceCancelled,
+		}
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		err := svc.Register(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrConferenceCancelled)
+	})
+
 	t.Run("error - already registered", func(t *testing.T) {
 		svc, mocks := setupRegistrationServiceTest(t)
 
@@ -354,6 +394,90 @@ func Test_RegistrationService_Register(t *testing.T) {
 		err := svc.Register(ctx, conferenceID, userID)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
+
+	t.Run("error - last seat taken concurrently", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		conference := &dto.ConferenceResponse{
+			ID: conferenceID,
+			Host: &dto.UserResponse{
+				ID: hostID,
+			},
+			Seats:    100,
+			StartsAt: &now,
+			EndsAt:   &futureTime,
+		}
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, userID, *conference.StartsAt, *conference.EndsAt).
+			Return([]entity.Conference{}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CreateRegistration(ctx, &entity.Registration{
+				ConferenceID: conferenceID,
+				UserID:       userID,
+			}).
+			Return(sql.ErrNoRows)
+
+		err := svc.Register(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrConferenceFull)
+	})
+
+	t.Run("error - duplicate registration created concurrently", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		conference := &dto.ConferenceResponse{
+			ID: conferenceID,
+			Host: &dto.UserResponse{
+				ID: hostID,
+			},
+			Seats:    100,
+			StartsAt: &now,
+			EndsAt:   &futureTime,
+		}
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(50, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, userID, *conference.StartsAt, *conference.EndsAt).
+			Return([]entity.Conference{}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CreateRegistration(ctx, &entity.Registration{
+				ConferenceID: conferenceID,
+				UserID:       userID,
+			}).
+			Return(&pgconn.PgError{
+				Code:           "23505",
+				ConstraintName: "registrations_user_id_conference_id_active_key",
+			})
+
+		err := svc.Register(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrUserAlreadyRegisteredToConference)
+	})
+
 }
 
 func Test_RegistrationService_GetRegisteredUsersByConference(t *testing.T) {
@@ -587,10 +711,10 @@ func Test_RegistrationService_GetRegisteredConferencesByUser(t *testing.T) {
 		}
 
 		mocks.registrationRepo.EXPECT().
-			GetRegisteredConferencesByUser(ctx, userID, true, lazyReq).
+			GetRegisteredConferencesByUser(ctx, userID, true, (*uuid.UUID)(nil), lazyReq).
 			Return(conferences, lazyResp, nil)
 
-		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, true, lazyReq)
+		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, true, nil, lazyReq)
 		assert.NoError(t, err)
 		assert.Equal(t, len(conferences), len(result))
 		assert.Equal(t, lazyResp, resultLazy)
@@ -623,15 +747,46 @@ func Test_RegistrationService_GetRegisteredConferencesByUser(t *testing.T) {
 		}
 
 		mocks.registrationRepo.EXPECT().
-			GetRegisteredConferencesByUser(ctx, userID, false, lazyReq).
+			GetRegisteredConferencesByUser(ctx, userID, false, (*uuid.UUID)(nil), lazyReq).
 			Return(conferences, lazyResp, nil)
 
-		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, lazyReq)
+		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, nil, lazyReq)
 		assert.NoError(t, err)
 		assert.Equal(t, len(conferences), len(result))
 		assert.Equal(t, lazyResp, resultLazy)
 	})
 
+	t.Run("success - filtered by event", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		ctx := context.WithValue(context.Background(), "user.id", userID)
+		ctx = context.WithValue(ctx, "user.role", enum.RoleUser)
+
+		eventID := uuid.New()
+		lazyReq := dto.LazyLoadQuery{
+			Limit: 10,
+		}
+
+		conferences := []entity.Conference{
+			{
+				ID:      uuid.New(),
+				Title:   "Conference 1",
+				EventID: eventID,
+				Event:   entity.Event{ID: eventID, Name: "Backend Week"},
+			},
+		}
+
+		mocks.registrationRepo.EXPECT().
+			GetRegisteredConferencesByUser(ctx, userID, false, &eventID, lazyReq).
+			Return(conferences, dto.LazyLoadResponse{}, nil)
+
+		result, _, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, &eventID, lazyReq)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, eventID, result[0].Event.ID)
+		assert.Equal(t, "Backend Week", result[0].Event.Name)
+	})
+
 	t.Run("error - invalid pagination", func(t *testing.T) {
 		svc, _ := setupRegistrationServiceTest(t)
 
@@ -644,7 +799,7 @@ func Test_RegistrationService_GetRegisteredConferencesByUser(t *testing.T) {
 			BeforeID: uuid.New(),
 		}
 
-		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, lazyReq)
+		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, nil, lazyReq)
 		assert.ErrorIs(t, err, errorpkg.ErrInvalidPagination)
 		assert.Empty(t, result)
 		assert.Empty(t, resultLazy)
@@ -660,7 +815,7 @@ func Test_RegistrationService_GetRegisteredConferencesByUser(t *testing.T) {
 			Limit: 10,
 		}
 
-		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, lazyReq)
+		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, nil, lazyReq)
 		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
 		assert.Empty(t, result)
 		assert.Empty(t, resultLazy)
@@ -677,10 +832,10 @@ func Test_RegistrationService_GetRegisteredConferencesByUser(t *testing.T) {
 		}
 
 		mocks.registrationRepo.EXPECT().
-			GetRegisteredConferencesByUser(ctx, userID, false, lazyReq).
+			GetRegisteredConferencesByUser(ctx, userID, false, (*uuid.UUID)(nil), lazyReq).
 			Return(nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer)
 
-		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, lazyReq)
+		result, resultLazy, err := svc.GetRegisteredConferencesByUser(ctx, userID, false, nil, lazyReq)
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 		assert.Empty(t, result)
 		assert.Empty(t, resultLazy)
@@ -733,3 +888,943 @@ func Test_RegistrationService_IsUserRegisteredToConference(t *testing.T) {
 		assert.False(t, ok)
 	})
 }
+
+func Test_RegistrationService_CancelRegistration(t *testing.T) {
+	conferenceID := uuid.New()
+	userID := uuid.New()
+	now := time.Now()
+	startsAt := now.Add(24 * time.Hour)
+	endsAt := now.Add(26 * time.Hour)
+
+	ctx := context.WithValue(context.Background(), "user.id", userID)
+	ctx = context.WithValue(ctx, "user.role", enum.RoleUser)
+
+	conference := &dto.ConferenceResponse{
+		ID: conferenceID,
+		Host: &dto.UserResponse{
+			ID: uuid.New(),
+		},
+		Seats:    100,
+		StartsAt: &startsAt,
+		EndsAt:   &endsAt,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CancelRegistration(ctx, conferenceID, userID, userID).
+			Return(nil)
+
+		// Freed seat is offered to the waitlist
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.CancelRegistration(ctx, conferenceID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - before cancellation cutoff", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		cutoff := now.Add(time.Hour)
+		withCutoff := *conference
+		withCutoff.CancellationCutoff = &cutoff
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&withCutoff, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CancelRegistration(ctx, conferenceID, userID, userID).
+			Return(nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.CancelRegistration(ctx, conferenceID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		err := svc.CancelRegistration(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - conference started", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		pastTime := now.Add(-time.Hour)
+		startedConference := *conference
+		startedConference.StartsAt = &pastTime
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&startedConference, nil)
+
+		err := svc.CancelRegistration(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrConferenceStarted)
+	})
+
+	t.Run("error - cancellation cutoff passed", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		cutoff := now.Add(-time.Hour)
+		withCutoff := *conference
+		withCutoff.CancellationCutoff = &cutoff
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&withCutoff, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetRegistration(ctx, conferenceID, userID).
+			Return(&entity.Registration{ConferenceID: conferenceID, UserID: userID}, nil)
+
+		err := svc.CancelRegistration(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrCancellationCutoffPassed)
+	})
+
+	t.Run("success - cutoff passed but flagged by a reschedule", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		cutoff := now.Add(-time.Hour)
+		withCutoff := *conference
+		withCutoff.CancellationCutoff = &cutoff
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&withCutoff, nil)
+
+		flaggedAt := now.Add(-time.Minute)
+		mocks.registrationRepo.EXPECT().
+			GetRegistration(ctx, conferenceID, userID).
+			Return(&entity.Registration{
+				ConferenceID:      conferenceID,
+				UserID:            userID,
+				ConflictFlaggedAt: &flaggedAt,
+			}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CancelRegistration(ctx, conferenceID, userID, userID).
+			Return(nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.CancelRegistration(ctx, conferenceID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not registered", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CancelRegistration(ctx, conferenceID, userID, userID).
+			Return(sql.ErrNoRows)
+
+		err := svc.CancelRegistration(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrUserNotRegisteredToConference)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CancelRegistration(ctx, conferenceID, userID, userID).
+			Return(errors.New("db error"))
+
+		err := svc.CancelRegistration(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_RegistrationService_KeepRegistration(t *testing.T) {
+	conferenceID := uuid.New()
+	userID := uuid.New()
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			ClearScheduleConflict(ctx, conferenceID, userID).
+			Return(nil)
+
+		err := svc.KeepRegistration(ctx, conferenceID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not flagged", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			ClearScheduleConflict(ctx, conferenceID, userID).
+			Return(sql.ErrNoRows)
+
+		err := svc.KeepRegistration(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrNoScheduleConflict)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			ClearScheduleConflict(ctx, conferenceID, userID).
+			Return(errors.New("db error"))
+
+		err := svc.KeepRegistration(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_RegistrationService_HandleEvent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	conference := entity.Conference{
+		ID:       uuid.New(),
+		Title:    "Moved Conference",
+		StartsAt: now.Add(24 * time.Hour),
+		EndsAt:   now.Add(26 * time.Hour),
+	}
+	rescheduled := event.ConferenceRescheduled{
+		Conference:       conference,
+		PreviousStartsAt: now.Add(48 * time.Hour),
+		PreviousEndsAt:   now.Add(50 * time.Hour),
+	}
+	clashing := entity.User{ID: uuid.New(), Name: "Clashing"}
+	free := entity.User{ID: uuid.New(), Name: "Free"}
+
+	t.Run("success - flags and tells registrants with a clash", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			GetAttendeesByConference(ctx, conference.ID).
+			Return([]entity.User{clashing, free}, nil)
+
+		// The moved conference itself always shows up as a conflict
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, clashing.ID, conference.StartsAt, conference.EndsAt).
+			Return([]entity.Conference{
+				{ID: conference.ID, Title: conference.Title},
+				{ID: uuid.New(), Title: "Other Conference"},
+			}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, free.ID, conference.StartsAt, conference.EndsAt).
+			Return([]entity.Conference{{ID: conference.ID, Title: conference.Title}}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			FlagScheduleConflicts(ctx, conference.ID, []uuid.UUID{clashing.ID}).
+			Return(nil)
+
+		mocks.eventBus.EXPECT().
+			Publish(ctx, event.RegistrationConflict{
+				ConferenceID:      conference.ID,
+				ConferenceTitle:   conference.Title,
+				StartsAt:          conference.StartsAt,
+				UserID:            clashing.ID,
+				ConflictingTitles: []string{"Other Conference"},
+			}).
+			Return()
+
+		err := svc.HandleEvent(ctx, rescheduled)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - no clash clears earlier flags", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			GetAttendeesByConference(ctx, conference.ID).
+			Return([]entity.User{free}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, free.ID, conference.StartsAt, conference.EndsAt).
+			Return([]entity.Conference{{ID: conference.ID, Title: conference.Title}}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			FlagScheduleConflicts(ctx, conference.ID, []uuid.UUID(nil)).
+			Return(nil)
+
+		err := svc.HandleEvent(ctx, rescheduled)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - other events are ignored", func(t *testing.T) {
+		svc, _ := setupRegistrationServiceTest(t)
+
+		err := svc.HandleEvent(ctx, event.ConferenceCancelled{Conference: conference})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - get conflicting registrations fails", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			GetAttendeesByConference(ctx, conference.ID).
+			Return([]entity.User{clashing}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, clashing.ID, conference.StartsAt, conference.EndsAt).
+			Return(nil, errors.New("db error"))
+
+		err := svc.HandleEvent(ctx, rescheduled)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_RegistrationService_JoinWaitlist(t *testing.T) {
+	conferenceID := uuid.New()
+	userID := uuid.New()
+	hostID := uuid.New()
+	entryID := uuid.New()
+	now := time.Now()
+	futureTime := now.Add(24 * time.Hour)
+
+	ctx := context.Background()
+
+	conference := &dto.ConferenceResponse{
+		ID: conferenceID,
+		Host: &dto.UserResponse{
+			ID: hostID,
+		},
+		Seats:    100,
+		StartsAt: &now,
+		EndsAt:   &futureTime,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserInWaitlist(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(100, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, userID, *conference.StartsAt, *conference.EndsAt).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(entryID, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CreateWaitlistEntry(ctx, &entity.WaitlistEntry{
+				ID:           entryID,
+				ConferenceID: conferenceID,
+				UserID:       userID,
+			}).
+			Return(nil)
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - host attempting to join", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		err := svc.JoinWaitlist(ctx, conferenceID, hostID)
+		assert.ErrorIs(t, err, errorpkg.ErrHostCannotRegister)
+	})
+
+	t.Run("error - conference ended", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		pastTime := now.Add(-48 * time.Hour)
+		endedConference := *conference
+		endedConference.StartsAt = &pastTime
+		endedConference.EndsAt = &now
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&endedConference, nil)
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrConferenceEnded)
+	})
+
+	t.Run("error - already registered", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(true, nil)
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrUserAlreadyRegisteredToConference)
+	})
+
+	t.Run("error - already in waitlist", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserInWaitlist(ctx, conferenceID, userID).
+			Return(true, nil)
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrUserAlreadyInWaitlist)
+	})
+
+	t.Run("error - conference not full", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserInWaitlist(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrConferenceNotFull)
+	})
+
+	t.Run("error - conflicting registrations", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserInWaitlist(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(100, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, userID, *conference.StartsAt, *conference.EndsAt).
+			Return([]entity.Conference{{ID: uuid.New()}}, nil)
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrConflictingRegistrations)
+	})
+
+	t.Run("error - fail to check waitlist", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserInWaitlist(ctx, conferenceID, userID).
+			Return(false, errors.New("db error"))
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - fail to create entry", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserRegisteredToConference(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			IsUserInWaitlist(ctx, conferenceID, userID).
+			Return(false, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(100, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, userID, *conference.StartsAt, *conference.EndsAt).
+			Return([]entity.Conference{}, nil)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(entryID, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CreateWaitlistEntry(ctx, mock.AnythingOfType("*entity.WaitlistEntry")).
+			Return(errors.New("db error"))
+
+		err := svc.JoinWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_RegistrationService_LeaveWaitlist(t *testing.T) {
+	conferenceID := uuid.New()
+	userID := uuid.New()
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			DeleteWaitlistEntry(ctx, conferenceID, userID).
+			Return(nil)
+
+		err := svc.LeaveWaitlist(ctx, conferenceID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not in waitlist", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			DeleteWaitlistEntry(ctx, conferenceID, userID).
+			Return(sql.ErrNoRows)
+
+		err := svc.LeaveWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrUserNotInWaitlist)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.registrationRepo.EXPECT().
+			DeleteWaitlistEntry(ctx, conferenceID, userID).
+			Return(errors.New("db error"))
+
+		err := svc.LeaveWaitlist(ctx, conferenceID, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_RegistrationService_GetWaitlistByConference(t *testing.T) {
+	conferenceID := uuid.New()
+	userID := uuid.New()
+	hostID := uuid.New()
+
+	conference := &dto.ConferenceResponse{
+		ID: conferenceID,
+		Host: &dto.UserResponse{
+			ID: hostID,
+		},
+	}
+
+	lazyReq := dto.LazyLoadQuery{
+		Limit: 10,
+	}
+
+	t.Run("success - host accessing own conference", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		ctx := context.WithValue(context.Background(), "user.id", hostID)
+		ctx = context.WithValue(ctx, "user.role", enum.RoleUser)
+
+		entries := []entity.WaitlistEntry{
+			{
+				ID:           uuid.New(),
+				UserID:       userID,
+				ConferenceID: conferenceID,
+				User: &entity.User{
+					ID:   userID,
+					Name: "User 1",
+				},
+			},
+		}
+
+		lazyResp := dto.LazyLoadResponse{
+			HasMore: false,
+			FirstID: entries[0].ID,
+			LastID:  entries[0].ID,
+		}
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetWaitlistByConference(ctx, conferenceID, lazyReq).
+			Return(entries, lazyResp, nil)
+
+		result, resultLazy, err := svc.GetWaitlistByConference(ctx, conferenceID, lazyReq)
+		assert.NoError(t, err)
+		assert.Equal(t, lazyResp, resultLazy)
+		assert.Len(t, result, 1)
+		assert.Equal(t, entries[0].ID, result[0].ID)
+		assert.Equal(t, userID, result[0].User.ID)
+		assert.Equal(t, "User 1", result[0].User.Name)
+	})
+
+	t.Run("error - invalid pagination", func(t *testing.T) {
+		svc, _ := setupRegistrationServiceTest(t)
+
+		ctx := context.Background()
+		invalidReq := dto.LazyLoadQuery{
+			AfterID:  uuid.New(),
+			BeforeID: uuid.New(),
+			Limit:    10,
+		}
+
+		result, _, err := svc.GetWaitlistByConference(ctx, conferenceID, invalidReq)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrInvalidPagination)
+	})
+
+	t.Run("error - non-host user", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		ctx := context.WithValue(context.Background(), "user.id", userID)
+		ctx = context.WithValue(ctx, "user.role", enum.RoleUser)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		result, _, err := svc.GetWaitlistByConference(ctx, conferenceID, lazyReq)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrForbiddenUser)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		ctx := context.WithValue(context.Background(), "user.id", userID)
+		ctx = context.WithValue(ctx, "user.role", enum.RoleEventCoordinator)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetWaitlistByConference(ctx, conferenceID, lazyReq).
+			Return(nil, dto.LazyLoadResponse{}, errors.New("db error"))
+
+		result, _, err := svc.GetWaitlistByConference(ctx, conferenceID, lazyReq)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_RegistrationService_PromoteFromWaitlist(t *testing.T) {
+	conferenceID := uuid.New()
+	hostID := uuid.New()
+	now := time.Now()
+	startsAt := now.Add(24 * time.Hour)
+	endsAt := now.Add(26 * time.Hour)
+
+	ctx := context.Background()
+
+	conference := &dto.ConferenceResponse{
+		ID:    conferenceID,
+		Title: "Test Conference",
+		Host: &dto.UserResponse{
+			ID: hostID,
+		},
+		Seats:    100,
+		StartsAt: &startsAt,
+		EndsAt:   &endsAt,
+	}
+
+	newEntry := func() *entity.WaitlistEntry {
+		userID := uuid.New()
+		return &entity.WaitlistEntry{
+			ID:           uuid.New(),
+			UserID:       userID,
+			ConferenceID: conferenceID,
+			User: &entity.User{
+				ID:    userID,
+				Name:  "Waiting User",
+				Email: "waiting@example.com",
+			},
+		}
+	}
+
+	t.Run("success - promote first entry", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+		entry := newEntry()
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(entry, nil).Once()
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, entry.UserID, startsAt, endsAt).
+			Return([]entity.Conference{}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			PromoteWaitlistEntry(ctx, entry).
+			Return(nil)
+
+		mocks.eventBus.EXPECT().
+			Publish(ctx, event.RegistrationConfirmed{
+				ConferenceID:    conferenceID,
+				ConferenceTitle: "Test Conference",
+				StartsAt:        startsAt,
+				UserID:          entry.UserID,
+				FromWaitlist:    true,
+			}).
+			Return()
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - empty waitlist", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - no free seat", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(100, nil)
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - conference ended", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		pastTime := now.Add(-time.Hour)
+		endedConference := *conference
+		endedConference.EndsAt = &pastTime
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(&endedConference, nil)
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - skip entry with conflicting registrations", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+		conflicted := newEntry()
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(conflicted, nil).Once()
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, conflicted.UserID, startsAt, endsAt).
+			Return([]entity.Conference{{ID: uuid.New()}}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			DeleteWaitlistEntry(ctx, conferenceID, conflicted.UserID).
+			Return(nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(nil, sql.ErrNoRows).Once()
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - entry or seat taken concurrently", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+		entry := newEntry()
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(entry, nil).Once()
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, entry.UserID, startsAt, endsAt).
+			Return([]entity.Conference{}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			PromoteWaitlistEntry(ctx, entry).
+			Return(sql.ErrNoRows)
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - conference not found", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(nil, errorpkg.ErrNotFound)
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - fail to get first entry", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(nil, errors.New("db error"))
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+
+	t.Run("error - fail to promote", func(t *testing.T) {
+		svc, mocks := setupRegistrationServiceTest(t)
+		entry := newEntry()
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferenceByID(ctx, conferenceID).
+			Return(conference, nil)
+
+		mocks.registrationRepo.EXPECT().
+			CountRegistrationsByConference(ctx, conferenceID).
+			Return(99, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetFirstWaitlistEntry(ctx, conferenceID).
+			Return(entry, nil)
+
+		mocks.registrationRepo.EXPECT().
+			GetConflictingRegistrations(ctx, entry.UserID, startsAt, endsAt).
+			Return([]entity.Conference{}, nil)
+
+		mocks.registrationRepo.EXPECT().
+			PromoteWaitlistEntry(ctx, entry).
+			Return(errors.New("db error"))
+
+		err := svc.PromoteFromWaitlist(ctx, conferenceID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
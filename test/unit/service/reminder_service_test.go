@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/enum"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/app/reminder/service"
+	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type reminderServiceMocks struct {
+	reminderRepo    *appmocks.MockIReminderRepository
+	conferenceSvc   *appmocks.MockIConferenceService
+	registrationSvc *appmocks.MockIRegistrationService
+	emailSvc        *appmocks.MockIEmailService
+}
+
+func setupReminderServiceTest(t *testing.T, windows ...time.Duration) (contract.IReminderService,
+	*reminderServiceMocks) {
+
+	mocks := &reminderServiceMocks{
+		reminderRepo:    appmocks.NewMockIReminderRepository(t),
+		conferenceSvc:   appmocks.NewMockIConferenceService(t),
+		registrationSvc: appmocks.NewMockIRegistrationService(t),
+		emailSvc:        appmocks.NewMockIEmailService(t),
+	}
+
+	if len(windows) == 0 {
+		windows = []time.Duration{24 * time.Hour, time.Hour}
+	}
+
+	svc := service.NewReminderService(mocks.reminderRepo, mocks.conferenceSvc, mocks.registrationSvc,
+		mocks.emailSvc, windows)
+
+	return svc, mocks
+}
+
+// startingWithin matches the conference query of the window ending the given duration from now.
+func startingWithin(window time.Duration) interface{} {
+	return mock.MatchedBy(func(query *dto.GetConferenceQuery) bool {
+		return query.Status == enum.ConferenceApproved &&
+			query.StartsBefore != nil &&
+			time.Until(*query.StartsBefore).Round(time.Minute) == window
+	})
+}
+
+func Test_ReminderService_SendDueReminders(t *testing.T) {
+	ctx := context.Background()
+	startsAt := time.Now().Add(30 * time.Minute)
+
+	conference := dto.ConferenceResponse{
+		ID:       uuid.New(),
+		Title:    "Test Conference",
+		StartsAt: &startsAt,
+	}
+
+	users := []dto.UserResponse{
+		{ID: uuid.New(), Name: "First User", Email: "first@example.com"},
+		{ID: uuid.New(), Name: "Second User", Email: "second@example.com"},
+	}
+
+	t.Run("success - queues reminders not sent yet", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferences(ctx, startingWithin(time.Hour)).
+			Return([]dto.ConferenceResponse{conference}, dto.LazyLoadResponse{}, nil)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferences(ctx, startingWithin(24*time.Hour)).
+			Return([]dto.ConferenceResponse{}, dto.LazyLoadResponse{}, nil)
+
+		mocks.registrationSvc.EXPECT().
+			GetRegisteredUsersByConference(ctx, conference.ID, dto.LazyLoadQuery{Limit: 100}).
+			Return(users, dto.LazyLoadResponse{}, nil)
+
+		// The first user already got it, or opted out
+		mocks.reminderRepo.EXPECT().
+			ClaimReminder(ctx, conference.ID, users[0].ID, "1h").
+			Return(false, nil)
+
+		mocks.reminderRepo.EXPECT().
+			ClaimReminder(ctx, conference.ID, users[1].ID, "1h").
+			Return(true, nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, users[1].Email, "[Conference App] Conference Reminder", "conference_reminder.html",
+				mock.MatchedBy(func(data map[string]any) bool {
+					return data["name"] == users[1].Name &&
+						data["title"] == conference.Title &&
+						data["starts_in"] == "1 hour"
+				})).
+			Return(nil)
+
+		queued, err := svc.SendDueReminders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, queued)
+	})
+
+	t.Run("success - registrants are read page by page", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t, time.Hour)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferences(ctx, startingWithin(time.Hour)).
+			Return([]dto.ConferenceResponse{conference}, dto.LazyLoadResponse{}, nil)
+
+		mocks.registrationSvc.EXPECT().
+			GetRegisteredUsersByConference(ctx, conference.ID, dto.LazyLoadQuery{Limit: 100}).
+			Return(users[:1], dto.LazyLoadResponse{HasMore: true}, nil)
+
+		mocks.registrationSvc.EXPECT().
+			GetRegisteredUsersByConference(ctx, conference.ID, dto.LazyLoadQuery{AfterID: users[0].ID, Limit: 100}).
+			Return(users[1:], dto.LazyLoadResponse{}, nil)
+
+		mocks.reminderRepo.EXPECT().
+			ClaimReminder(ctx, conference.ID, mock.AnythingOfType("uuid.UUID"), "1h").
+			Return(true, nil).Times(2)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, mock.AnythingOfType("string"), "[Conference App] Conference Reminder",
+				"conference_reminder.html", mock.AnythingOfType("map[string]interface {}")).
+			Return(nil).Times(2)
+
+		queued, err := svc.SendDueReminders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, queued)
+	})
+
+	t.Run("success - queue email fails gives the claim back", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t, time.Hour)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferences(ctx, startingWithin(time.Hour)).
+			Return([]dto.ConferenceResponse{conference}, dto.LazyLoadResponse{}, nil)
+
+		mocks.registrationSvc.EXPECT().
+			GetRegisteredUsersByConference(ctx, conference.ID, dto.LazyLoadQuery{Limit: 100}).
+			Return(users[:1], dto.LazyLoadResponse{}, nil)
+
+		mocks.reminderRepo.EXPECT().
+			ClaimReminder(ctx, conference.ID, users[0].ID, "1h").
+			Return(true, nil)
+
+		mocks.emailSvc.EXPECT().
+			Enqueue(ctx, users[0].Email, "[Conference App] Conference Reminder", "conference_reminder.html",
+				mock.AnythingOfType("map[string]interface {}")).
+			Return(errorpkg.ErrInternalServer)
+
+		mocks.reminderRepo.EXPECT().
+			ReleaseReminder(ctx, conference.ID, users[0].ID, "1h").
+			Return(nil)
+
+		queued, err := svc.SendDueReminders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, queued)
+	})
+
+	t.Run("error - get conferences fails", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t)
+
+		mocks.conferenceSvc.EXPECT().
+			GetConferences(ctx, startingWithin(time.Hour)).
+			Return(nil, dto.LazyLoadResponse{}, errorpkg.ErrInternalServer)
+
+		_, err := svc.SendDueReminders(ctx)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_ReminderService_GetPreferences(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t, time.Hour, 24*time.Hour, 90*time.Minute)
+
+		mocks.reminderRepo.EXPECT().
+			GetOptOutsByUser(ctx, userID).
+			Return([]string{"1h"}, nil)
+
+		preferences, err := svc.GetPreferences(ctx, userID)
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.ReminderPreference{
+			{Type: "24h", Enabled: true},
+			{Type: "1h30m", Enabled: true},
+			{Type: "1h", Enabled: false},
+		}, preferences)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t)
+
+		mocks.reminderRepo.EXPECT().
+			GetOptOutsByUser(ctx, userID).
+			Return(nil, errors.New("db error"))
+
+		_, err := svc.GetPreferences(ctx, userID)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_ReminderService_UpdatePreference(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("success - opt out", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t)
+
+		mocks.reminderRepo.EXPECT().
+			CreateOptOut(ctx, userID, "24h").
+			Return(nil)
+
+		err := svc.UpdatePreference(ctx, userID, "24h", false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - opt back in", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t)
+
+		mocks.reminderRepo.EXPECT().
+			DeleteOptOut(ctx, userID, "1h").
+			Return(nil)
+
+		err := svc.UpdatePreference(ctx, userID, "1h", true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - unknown reminder type", func(t *testing.T) {
+		svc, _ := setupReminderServiceTest(t)
+
+		err := svc.UpdatePreference(ctx, userID, "2h", false)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupReminderServiceTest(t)
+
+		mocks.reminderRepo.EXPECT().
+			CreateOptOut(ctx, userID, "24h").
+			Return(errors.New("db error"))
+
+		err := svc.UpdatePreference(ctx, userID, "24h", false)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
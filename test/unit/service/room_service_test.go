@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nathakusuma/conference-backend/domain/contract"
+	"github.com/nathakusuma/conference-backend/domain/dto"
+	"github.com/nathakusuma/conference-backend/domain/entity"
+	"github.com/nathakusuma/conference-backend/domain/errorpkg"
+	"github.com/nathakusuma/conference-backend/internal/app/room/service"
+	appmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/app"
+	pkgmocks "github.com/nathakusuma/conference-backend/test/unit/mocks/pkg"
+	_ "github.com/nathakusuma/conference-backend/test/unit/setup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type roomServiceMocks struct {
+	roomRepo *appmocks.MockIRoomRepository
+	uuid     *pkgmocks.MockIUUID
+}
+
+func setupRoomServiceTest(t *testing.T) (contract.IRoomService, *roomServiceMocks) {
+	mocks := &roomServiceMocks{
+		roomRepo: appmocks.NewMockIRoomRepository(t),
+		uuid:     pkgmocks.NewMockIUUID(t),
+	}
+
+	svc := service.NewRoomService(mocks.roomRepo, mocks.uuid)
+
+	return svc, mocks
+}
+
+func Test_RoomService_CreateRoom(t *testing.T) {
+	ctx := context.Background()
+	roomID := uuid.New()
+	track := "Backend"
+
+	req := dto.CreateRoomRequest{
+		Name:     "Room A",
+		Capacity: 80,
+		Track:    &track,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(roomID, nil)
+
+		mocks.roomRepo.EXPECT().
+			CreateRoom(ctx, &entity.Room{
+				ID:       roomID,
+				Name:     req.Name,
+				Capacity: req.Capacity,
+				Track:    req.Track,
+			}).
+			Return(nil)
+
+		id, err := svc.CreateRoom(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, roomID, id)
+	})
+
+	t.Run("error - name taken", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(roomID, nil)
+
+		mocks.roomRepo.EXPECT().
+			CreateRoom(ctx, mock.AnythingOfType("*entity.Room")).
+			Return(&pgconn.PgError{ConstraintName: "rooms_name_key"})
+
+		id, err := svc.CreateRoom(ctx, req)
+		assert.Equal(t, uuid.Nil, id)
+		assert.ErrorIs(t, err, errorpkg.ErrRoomNameTaken)
+	})
+
+	t.Run("error - UUID generation fails", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.uuid.EXPECT().
+			NewV7().
+			Return(uuid.Nil, errors.New("uuid error"))
+
+		id, err := svc.CreateRoom(ctx, req)
+		assert.Equal(t, uuid.Nil, id)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_RoomService_GetRoomByID(t *testing.T) {
+	ctx := context.Background()
+	roomID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.roomRepo.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(&entity.Room{ID: roomID, Name: "Room A", Capacity: 80}, nil)
+
+		room, err := svc.GetRoomByID(ctx, roomID)
+		assert.NoError(t, err)
+		assert.Equal(t, roomID, room.ID)
+		assert.Equal(t, 80, room.Capacity)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.roomRepo.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(nil, sql.ErrNoRows)
+
+		room, err := svc.GetRoomByID(ctx, roomID)
+		assert.Nil(t, room)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_RoomService_GetRooms(t *testing.T) {
+	ctx := context.Background()
+	track := "Backend"
+	query := dto.GetRoomsQuery{Track: &track}
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.roomRepo.EXPECT().
+			GetRooms(ctx, query).
+			Return([]entity.Room{
+				{ID: uuid.New(), Name: "Room A", Capacity: 80, Track: &track},
+				{ID: uuid.New(), Name: "Room B", Capacity: 40, Track: &track},
+			}, nil)
+
+		rooms, err := svc.GetRooms(ctx, query)
+		assert.NoError(t, err)
+		assert.Len(t, rooms, 2)
+		assert.Equal(t, "Room B", rooms[1].Name)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.roomRepo.EXPECT().
+			GetRooms(ctx, query).
+			Return(nil, errors.New("db error"))
+
+		rooms, err := svc.GetRooms(ctx, query)
+		assert.Nil(t, rooms)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_RoomService_UpdateRoom(t *testing.T) {
+	ctx := context.Background()
+	roomID := uuid.New()
+
+	newRoom := func() *entity.Room {
+		return &entity.Room{ID: roomID, Name: "Room A", Capacity: 80}
+	}
+
+	t.Run("success - rename", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+		name := "Room Z"
+
+		mocks.roomRepo.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(newRoom(), nil)
+
+		mocks.roomRepo.EXPECT().
+			UpdateRoom(ctx, &entity.Room{ID: roomID, Name: name, Capacity: 80}).
+			Return(nil)
+
+		err := svc.UpdateRoom(ctx, roomID, dto.UpdateRoomRequest{Name: &name})
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - shrink above the largest conference", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+		capacity := 50
+
+		mocks.roomRepo.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(newRoom(), nil)
+
+		mocks.roomRepo.EXPECT().
+			GetLargestUpcomingSeats(ctx, roomID).
+			Return(50, nil)
+
+		mocks.roomRepo.EXPECT().
+			UpdateRoom(ctx, &entity.Room{ID: roomID, Name: "Room A", Capacity: capacity}).
+			Return(nil)
+
+		err := svc.UpdateRoom(ctx, roomID, dto.UpdateRoomRequest{Capacity: &capacity})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - shrink below the largest conference", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+		capacity := 50
+
+		mocks.roomRepo.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(newRoom(), nil)
+
+		mocks.roomRepo.EXPECT().
+			GetLargestUpcomingSeats(ctx, roomID).
+			Return(60, nil)
+
+		err := svc.UpdateRoom(ctx, roomID, dto.UpdateRoomRequest{Capacity: &capacity})
+		assert.ErrorIs(t, err, errorpkg.ErrRoomCapacityBelowSeats)
+	})
+
+	t.Run("error - name taken", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+		name := "Room B"
+
+		mocks.roomRepo.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(newRoom(), nil)
+
+		mocks.roomRepo.EXPECT().
+			UpdateRoom(ctx, mock.AnythingOfType("*entity.Room")).
+			Return(&pgconn.PgError{ConstraintName: "rooms_name_key"})
+
+		err := svc.UpdateRoom(ctx, roomID, dto.UpdateRoomRequest{Name: &name})
+		assert.ErrorIs(t, err, errorpkg.ErrRoomNameTaken)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.roomRepo.EXPECT().
+			GetRoomByID(ctx, roomID).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.UpdateRoom(ctx, roomID, dto.UpdateRoomRequest{})
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
+
+func Test_RoomService_DeleteRoom(t *testing.T) {
+	ctx := context.Background()
+	roomID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.roomRepo.EXPECT().
+			HasUpcomingConferences(ctx, roomID).
+			Return(false, nil)
+
+		mocks.roomRepo.EXPECT().
+			DeleteRoom(ctx, roomID).
+			Return(nil)
+
+		err := svc.DeleteRoom(ctx, roomID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - room in use", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.roomRepo.EXPECT().
+			HasUpcomingConferences(ctx, roomID).
+			Return(true, nil)
+
+		err := svc.DeleteRoom(ctx, roomID)
+		assert.ErrorIs(t, err, errorpkg.ErrRoomInUse)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		svc, mocks := setupRoomServiceTest(t)
+
+		mocks.roomRepo.EXPECT().
+			HasUpcomingConferences(ctx, roomID).
+			Return(false, nil)
+
+		mocks.roomRepo.EXPECT().
+			DeleteRoom(ctx, roomID).
+			Return(sql.ErrNoRows)
+
+		err := svc.DeleteRoom(ctx, roomID)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+}
@@ -34,7 +34,8 @@ func setupUserServiceTest(t *testing.T) (contract.IUserService, *userServiceMock
 		bcrypt:   pkgmocks.NewMockIBcrypt(t),
 	}
 
-	svc := service.NewUserService(mocks.userRepo, mocks.bcrypt, mocks.uuid)
+	svc := service.NewUserService(mocks.userRepo, mocks.bcrypt, mocks.uuid,
+		map[enum.UserRole]int{enum.RoleUser: 3})
 
 	return svc, mocks
 }
@@ -611,3 +612,169 @@ func Test_UserService_DeleteUser(t *testing.T) {
 		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
 	})
 }
+
+func Test_UserService_GetProposalQuota(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success - role quota", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			GetUserByField(ctx, "id", id.String()).
+			Return(&entity.User{ID: id, Role: enum.RoleUser}, nil)
+
+		mocks.userRepo.EXPECT().
+			GetProposalQuota(ctx, id).
+			Return(0, sql.ErrNoRows)
+
+		quota, err := svc.GetProposalQuota(ctx, id)
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.ProposalQuotaResponse{UserID: id, Quota: 3}, quota)
+	})
+
+	t.Run("success - default quota for unlisted role", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			GetUserByField(ctx, "id", id.String()).
+			Return(&entity.User{ID: id, Role: enum.RoleEventCoordinator}, nil)
+
+		mocks.userRepo.EXPECT().
+			GetProposalQuota(ctx, id).
+			Return(0, sql.ErrNoRows)
+
+		quota, err := svc.GetProposalQuota(ctx, id)
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.ProposalQuotaResponse{UserID: id, Quota: 1}, quota)
+	})
+
+	t.Run("success - overridden quota", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			GetUserByField(ctx, "id", id.String()).
+			Return(&entity.User{ID: id, Role: enum.RoleUser}, nil)
+
+		mocks.userRepo.EXPECT().
+			GetProposalQuota(ctx, id).
+			Return(5, nil)
+
+		quota, err := svc.GetProposalQuota(ctx, id)
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.ProposalQuotaResponse{UserID: id, Quota: 5, Overridden: true}, quota)
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			GetUserByField(ctx, "id", id.String()).
+			Return(nil, sql.ErrNoRows)
+
+		quota, err := svc.GetProposalQuota(ctx, id)
+		assert.Nil(t, quota)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			GetUserByField(ctx, "id", id.String()).
+			Return(&entity.User{ID: id, Role: enum.RoleUser}, nil)
+
+		mocks.userRepo.EXPECT().
+			GetProposalQuota(ctx, id).
+			Return(0, errors.New("db error"))
+
+		quota, err := svc.GetProposalQuota(ctx, id)
+		assert.Nil(t, quota)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_UserService_SetProposalQuota(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	quota := 4
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			GetUserByField(ctx, "id", id.String()).
+			Return(&entity.User{ID: id, Role: enum.RoleUser}, nil)
+
+		mocks.userRepo.EXPECT().
+			SetProposalQuota(ctx, id, quota).
+			Return(nil)
+
+		err := svc.SetProposalQuota(ctx, id, dto.SetProposalQuotaRequest{Quota: &quota})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			GetUserByField(ctx, "id", id.String()).
+			Return(nil, sql.ErrNoRows)
+
+		err := svc.SetProposalQuota(ctx, id, dto.SetProposalQuotaRequest{Quota: &quota})
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			GetUserByField(ctx, "id", id.String()).
+			Return(&entity.User{ID: id, Role: enum.RoleUser}, nil)
+
+		mocks.userRepo.EXPECT().
+			SetProposalQuota(ctx, id, quota).
+			Return(errors.New("db error"))
+
+		err := svc.SetProposalQuota(ctx, id, dto.SetProposalQuotaRequest{Quota: &quota})
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}
+
+func Test_UserService_ResetProposalQuota(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			DeleteProposalQuota(ctx, id).
+			Return(nil)
+
+		err := svc.ResetProposalQuota(ctx, id)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - no override", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			DeleteProposalQuota(ctx, id).
+			Return(sql.ErrNoRows)
+
+		err := svc.ResetProposalQuota(ctx, id)
+		assert.ErrorIs(t, err, errorpkg.ErrNotFound)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		svc, mocks := setupUserServiceTest(t)
+
+		mocks.userRepo.EXPECT().
+			DeleteProposalQuota(ctx, id).
+			Return(errors.New("db error"))
+
+		err := svc.ResetProposalQuota(ctx, id)
+		assert.ErrorIs(t, err, errorpkg.ErrInternalServer)
+	})
+}